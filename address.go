@@ -35,7 +35,7 @@ type Address struct {
 
 // PickerIter represents an address picker iterator
 type PickerIter struct {
-	*iter
+	Iter
 }
 
 // Address returns the current address on the iterator.
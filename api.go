@@ -0,0 +1,13 @@
+package onfido
+
+import "github.com/mbowman100/go-onfido/onfidoapi"
+
+// NewAPIClient creates a client of the operations generated from the API
+// specification, configured like NewClient. It covers the operations and
+// fields which OnfidoClient doesn't, without its iterators and helpers.
+func NewAPIClient(token string, opts ...ClientOption) *onfidoapi.Client {
+	c := NewClient(token, opts...).(*client)
+	api := onfidoapi.NewClient(c.endpoint, c.token.String(), c.httpClient)
+	api.UserAgent = "Go-Onfido/" + ClientVersion
+	return api
+}
@@ -0,0 +1,35 @@
+package onfido
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewAPIClient(t *testing.T) {
+	m := mux.NewRouter()
+	m.HandleFunc("/applicants/{id}", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Token token=123", r.Header.Get("Authorization"))
+		assert.Equal(t, "Go-Onfido/"+ClientVersion, r.Header.Get("User-Agent"))
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"id":           mux.Vars(r)["id"],
+			"phone_number": "+447700900000",
+		})
+	}).Methods("GET")
+	srv := httptest.NewServer(m)
+	defer srv.Close()
+
+	api := NewAPIClient("123", WithEndpoint(srv.URL+"/"))
+	a, err := api.FindApplicant(context.Background(), "applicant-id")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "applicant-id", a.ID)
+	assert.Equal(t, "+447700900000", a.PhoneNumber)
+}
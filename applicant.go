@@ -76,6 +76,17 @@ func (c *client) DeleteApplicant(ctx context.Context, id string) error {
 	return err
 }
 
+// RestoreApplicant restores an applicant scheduled for deletion.
+// see https://documentation.onfido.com/#restore-applicant
+func (c *client) RestoreApplicant(ctx context.Context, id string) error {
+	req, err := c.newRequest("POST", "/applicants/"+id+"/restore", nil)
+	if err != nil {
+		return err
+	}
+	_, err = c.do(ctx, req, nil)
+	return err
+}
+
 // GetApplicant retrieves an applicant by its id.
 // see https://documentation.onfido.com/?shell#retrieve-applicant
 func (c *client) GetApplicant(ctx context.Context, id string) (*Applicant, error) {
@@ -91,7 +102,7 @@ func (c *client) GetApplicant(ctx context.Context, id string) (*Applicant, error
 
 // ApplicantIter represents an applicant iterator
 type ApplicantIter struct {
-	*iter
+	Iter
 }
 
 // Applicant returns the current applicant on the iterator.
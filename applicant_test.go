@@ -115,6 +115,24 @@ func TestDeleteApplicant_ValidRequest(t *testing.T) {
 	}
 }
 
+func TestRestoreApplicant(t *testing.T) {
+	expected := "65643"
+
+	m := mux.NewRouter()
+	m.HandleFunc("/applicants/{id}/restore", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, expected, mux.Vars(r)["id"])
+		w.WriteHeader(http.StatusNoContent)
+	}).Methods("POST")
+	srv := httptest.NewServer(m)
+	defer srv.Close()
+
+	client := NewClient("123").(*client)
+	client.endpoint = srv.URL
+
+	assert.NoError(t, client.RestoreApplicant(context.Background(), expected))
+	assert.Error(t, client.RestoreApplicant(context.Background(), "unknown/id"))
+}
+
 func TestGetApplicant_NonOKResponse(t *testing.T) {
 	m := mux.NewRouter()
 	m.HandleFunc("/applicants/{id}", func(w http.ResponseWriter, r *http.Request) {
@@ -30,36 +30,75 @@ const (
 )
 
 // CheckRequest represents a check request to Onfido API
+// see https://documentation.onfido.com/#create-check
 type CheckRequest struct {
-	ApplicantID             string   `json:"applicant_id"`
-	ReportNames             []string `json:"report_names"`
-	RedirectURI             string   `json:"redirect_uri,omitempty"`
-	Tags                    []string `json:"tags,omitempty"`
-	SupressFormEmails       bool     `json:"suppress_form_emails,omitempty"`
-	Async                   bool     `json:"asynchronous,omitempty"`
-	ChargeApplicantForCheck bool     `json:"charge_applicant_for_check,omitempty"`
+	ApplicantID             string       `json:"applicant_id"`
+	ReportNames             []ReportName `json:"report_names"`
+	DocumentIDs             []string     `json:"document_ids,omitempty"` // Documents to use, defaults to the most recently uploaded
+	RedirectURI             string       `json:"redirect_uri,omitempty"`
+	Tags                    []string     `json:"tags,omitempty"`
+	WebhookIDs              []string     `json:"webhook_ids,omitempty"` // Webhooks to notify, defaults to all
+	SupressFormEmails       bool         `json:"suppress_form_emails,omitempty"`
+	Async                   bool         `json:"asynchronous,omitempty"`
+	ChargeApplicantForCheck bool         `json:"charge_applicant_for_check,omitempty"`
+	// PrivacyNoticesReadConsentGiven must be true for reports which require the applicant's
+	// consent, such as US identity reports.
+	PrivacyNoticesReadConsentGiven bool `json:"privacy_notices_read_consent_given,omitempty"`
 	// Consider is used for Sandbox Testing of multiple report scenarios.
 	// see https://documentation.onfido.com/#sandbox-responses
-	Consider              []ReportName `json:"consider,omitempty"`
-	ApplicantProvidesData bool         `json:"applicant_provides_data"`
+	Consider []ReportName `json:"consider,omitempty"`
+	// ApplicantProvidesData sends the applicant a form to provide their own data and
+	// documents, instead of using the data already uploaded. Only sent when true.
+	ApplicantProvidesData bool `json:"applicant_provides_data,omitempty"`
+	// USDrivingLicence provides driving licence data for the document report's
+	// US driving licence (DMV) sub-report.
+	USDrivingLicence *USDrivingLicence `json:"us_driving_licence,omitempty"`
+}
+
+// USDrivingLicence represents the US driving licence data sent with a check request
+type USDrivingLicence struct {
+	IDNumber         string `json:"id_number"`
+	IssueState       string `json:"issue_state"`
+	AddressLine1     string `json:"address_line_1,omitempty"`
+	AddressLine2     string `json:"address_line_2,omitempty"`
+	City             string `json:"city,omitempty"`
+	DateOfBirth      string `json:"date_of_birth,omitempty"`
+	DocumentCategory string `json:"document_category,omitempty"`
+	ExpirationDate   string `json:"expiration_date,omitempty"`
+	EyeColorCode     string `json:"eye_color_code,omitempty"`
+	FirstName        string `json:"first_name,omitempty"`
+	Gender           string `json:"gender,omitempty"`
+	IssueDate        string `json:"issue_date,omitempty"`
+	LastName         string `json:"last_name,omitempty"`
+	MiddleName       string `json:"middle_name,omitempty"`
+	NameSuffix       string `json:"name_suffix,omitempty"`
+	PostalCode       string `json:"postal_code,omitempty"`
+	WeightMeasure    string `json:"weight_measure,omitempty"`
+	WeightPounds     int    `json:"weight_pounds,omitempty"`
 }
 
 // Check represents a check in Onfido API
 type Check struct {
-	ID                    string      `json:"id,omitempty"`
-	CreatedAt             *time.Time  `json:"created_at,omitempty"`
-	Href                  string      `json:"href,omitempty"`
-	Type                  CheckType   `json:"type,omitempty"`
-	Status                CheckStatus `json:"status,omitempty"`
-	Result                CheckResult `json:"result,omitempty"`
-	DownloadURI           string      `json:"download_uri,omitempty"`
-	FormURI               string      `json:"form_uri,omitempty"`
-	RedirectURI           string      `json:"redirect_uri,omitempty"`
-	ResultsURI            string      `json:"results_uri,omitempty"`
-	Reports               []*Report   `json:"reports,omitempty"`
-	Tags                  []string    `json:"tags,omitempty"`
-	ApplicantID           string      `json:"applicant_id,omitempty"`
-	ApplicantProvidesData bool        `json:"applicant_provides_data"`
+	ID                             string          `json:"id,omitempty"`
+	CreatedAt                      *time.Time      `json:"created_at,omitempty"`
+	Href                           string          `json:"href,omitempty"`
+	Type                           CheckType       `json:"type,omitempty"`
+	Status                         CheckStatus     `json:"status,omitempty"`
+	Result                         CheckResult     `json:"result,omitempty"`
+	SubResult                      ReportSubResult `json:"sub_result,omitempty"`
+	DownloadURI                    string          `json:"download_uri,omitempty"`
+	FormURI                        string          `json:"form_uri,omitempty"`
+	RedirectURI                    string          `json:"redirect_uri,omitempty"`
+	ResultsURI                     string          `json:"results_uri,omitempty"`
+	Reports                        []*Report       `json:"reports,omitempty"`
+	Tags                           []string        `json:"tags,omitempty"`
+	ApplicantID                    string          `json:"applicant_id,omitempty"`
+	ApplicantProvidesData          bool            `json:"applicant_provides_data"`
+	WebhookIDs                     []string        `json:"webhook_ids,omitempty"`
+	PrivacyNoticesReadConsentGiven bool            `json:"privacy_notices_read_consent_given,omitempty"`
+	Sandbox                        bool            `json:"sandbox,omitempty"`
+	Paused                         bool            `json:"paused,omitempty"`
+	Version                        string          `json:"version,omitempty"`
 }
 
 // CheckRetrieved represents a check in the Onfido API which has been retrieved.
@@ -67,20 +106,26 @@ type Check struct {
 // is just a string of Report IDs, not fully expanded Report objects.
 // See https://documentation.onfido.com/?shell#check-object (Shell)
 type CheckRetrieved struct {
-	ID                    string      `json:"id,omitempty"`
-	CreatedAt             *time.Time  `json:"created_at,omitempty"`
-	Href                  string      `json:"href,omitempty"`
-	Type                  CheckType   `json:"type,omitempty"`
-	Status                CheckStatus `json:"status,omitempty"`
-	Result                CheckResult `json:"result,omitempty"`
-	DownloadURI           string      `json:"download_uri,omitempty"`
-	FormURI               string      `json:"form_uri,omitempty"`
-	RedirectURI           string      `json:"redirect_uri,omitempty"`
-	ResultsURI            string      `json:"results_uri,omitempty"`
-	Reports               []string    `json:"report_ids,omitempty"`
-	Tags                  []string    `json:"tags,omitempty"`
-	ApplicantID           string      `json:"applicant_id,omitempty"`
-	ApplicantProvidesData bool        `json:"applicant_provides_data"`
+	ID                             string          `json:"id,omitempty"`
+	CreatedAt                      *time.Time      `json:"created_at,omitempty"`
+	Href                           string          `json:"href,omitempty"`
+	Type                           CheckType       `json:"type,omitempty"`
+	Status                         CheckStatus     `json:"status,omitempty"`
+	Result                         CheckResult     `json:"result,omitempty"`
+	SubResult                      ReportSubResult `json:"sub_result,omitempty"`
+	DownloadURI                    string          `json:"download_uri,omitempty"`
+	FormURI                        string          `json:"form_uri,omitempty"`
+	RedirectURI                    string          `json:"redirect_uri,omitempty"`
+	ResultsURI                     string          `json:"results_uri,omitempty"`
+	Reports                        []string        `json:"report_ids,omitempty"`
+	Tags                           []string        `json:"tags,omitempty"`
+	ApplicantID                    string          `json:"applicant_id,omitempty"`
+	ApplicantProvidesData          bool            `json:"applicant_provides_data"`
+	WebhookIDs                     []string        `json:"webhook_ids,omitempty"`
+	PrivacyNoticesReadConsentGiven bool            `json:"privacy_notices_read_consent_given,omitempty"`
+	Sandbox                        bool            `json:"sandbox,omitempty"`
+	Paused                         bool            `json:"paused,omitempty"`
+	Version                        string          `json:"version,omitempty"`
 }
 
 // Checks represents a list of checks in Onfido API
@@ -131,21 +176,7 @@ func (c *client) GetCheckExpanded(ctx context.Context, id string) (*Check, error
 	}
 
 	// Build a regular Check object, this is what will be returned assuming there is no error.
-	check := Check{
-		ApplicantID: chkRetrieved.ApplicantID,
-		CreatedAt:   chkRetrieved.CreatedAt,
-		DownloadURI: chkRetrieved.DownloadURI,
-		FormURI:     chkRetrieved.FormURI,
-		Href:        chkRetrieved.Href,
-		ID:          chkRetrieved.ID,
-		RedirectURI: chkRetrieved.RedirectURI,
-		Reports:     make([]*Report, len(chkRetrieved.Reports)),
-		Result:      chkRetrieved.Result,
-		ResultsURI:  chkRetrieved.ResultsURI,
-		Status:      chkRetrieved.Status,
-		Tags:        chkRetrieved.Tags,
-		Type:        chkRetrieved.Type,
-	}
+	check := unexpandedCheck(chkRetrieved)
 
 	// For each Report ID in the CheckRetrieved object, fetch (expand) the Report
 	// into the returned Check object.
@@ -156,10 +187,38 @@ func (c *client) GetCheckExpanded(ctx context.Context, id string) (*Check, error
 		}
 		check.Reports[i] = rep
 	}
-	return &check, nil
+	return check, nil
+}
+
+// unexpandedCheck returns the Check of a CheckRetrieved, with room for its
+// Reports to be expanded.
+func unexpandedCheck(chkRetrieved *CheckRetrieved) *Check {
+	return &Check{
+		ApplicantID:                    chkRetrieved.ApplicantID,
+		ApplicantProvidesData:          chkRetrieved.ApplicantProvidesData,
+		CreatedAt:                      chkRetrieved.CreatedAt,
+		DownloadURI:                    chkRetrieved.DownloadURI,
+		FormURI:                        chkRetrieved.FormURI,
+		Href:                           chkRetrieved.Href,
+		ID:                             chkRetrieved.ID,
+		Paused:                         chkRetrieved.Paused,
+		PrivacyNoticesReadConsentGiven: chkRetrieved.PrivacyNoticesReadConsentGiven,
+		RedirectURI:                    chkRetrieved.RedirectURI,
+		Reports:                        make([]*Report, len(chkRetrieved.Reports)),
+		Result:                         chkRetrieved.Result,
+		ResultsURI:                     chkRetrieved.ResultsURI,
+		Sandbox:                        chkRetrieved.Sandbox,
+		Status:                         chkRetrieved.Status,
+		SubResult:                      chkRetrieved.SubResult,
+		Tags:                           chkRetrieved.Tags,
+		Type:                           chkRetrieved.Type,
+		Version:                        chkRetrieved.Version,
+		WebhookIDs:                     chkRetrieved.WebhookIDs,
+	}
 }
 
-// ResumeCheck resumes a paused check by its ID.
+// ResumeCheck resumes a paused check by its ID. Onfido replies with no content,
+// so the resumed check is then retrieved with GetCheckExpanded.
 // see https://documentation.onfido.com/?shell#resume-check
 func (c *client) ResumeCheck(ctx context.Context, id string) (*Check, error) {
 	req, err := c.newRequest("POST", "/checks/"+id+"/resume", nil)
@@ -167,14 +226,15 @@ func (c *client) ResumeCheck(ctx context.Context, id string) (*Check, error) {
 		return nil, err
 	}
 
-	var resp Check
-	_, err = c.do(ctx, req, &resp)
-	return &resp, err
+	if _, err := c.do(ctx, req, nil); err != nil {
+		return nil, err
+	}
+	return c.GetCheckExpanded(ctx, id)
 }
 
 // CheckIter represents a check iterator
 type CheckIter struct {
-	*iter
+	Iter
 }
 
 // Check returns the current item in the iterator as a Check.
@@ -0,0 +1,166 @@
+package onfido
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrInvalidCheckRequest means that a CheckBuilder was given an invalid combination of options
+var ErrInvalidCheckRequest = errors.New("invalid check request")
+
+// CheckBuilder builds a CheckRequest, validating the combination of reports on Build.
+//
+//	cr, err := onfido.NewCheckBuilder(applicantID).
+//		Reports(onfido.ReportNameDocument, onfido.ReportNameFacialSimilarityPhoto).
+//		Documents(documentID).
+//		Build()
+type CheckBuilder struct {
+	cr CheckRequest
+}
+
+// NewCheckBuilder creates a CheckBuilder for the given applicant.
+func NewCheckBuilder(applicantID string) *CheckBuilder {
+	return &CheckBuilder{cr: CheckRequest{ApplicantID: applicantID}}
+}
+
+// Reports adds reports to the check.
+func (b *CheckBuilder) Reports(names ...ReportName) *CheckBuilder {
+	b.cr.ReportNames = append(b.cr.ReportNames, names...)
+	return b
+}
+
+// Documents pins the documents used by the check's reports.
+func (b *CheckBuilder) Documents(ids ...string) *CheckBuilder {
+	b.cr.DocumentIDs = append(b.cr.DocumentIDs, ids...)
+	return b
+}
+
+// Webhooks restricts the webhooks notified about the check.
+func (b *CheckBuilder) Webhooks(ids ...string) *CheckBuilder {
+	b.cr.WebhookIDs = append(b.cr.WebhookIDs, ids...)
+	return b
+}
+
+// Tags adds tags to the check.
+func (b *CheckBuilder) Tags(tags ...string) *CheckBuilder {
+	b.cr.Tags = append(b.cr.Tags, tags...)
+	return b
+}
+
+// Consider sets the reports which return the sandbox's "consider" result.
+func (b *CheckBuilder) Consider(names ...ReportName) *CheckBuilder {
+	b.cr.Consider = append(b.cr.Consider, names...)
+	return b
+}
+
+// RedirectURI sets the URI the applicant is redirected to after providing their data.
+func (b *CheckBuilder) RedirectURI(uri string) *CheckBuilder {
+	b.cr.RedirectURI = uri
+	return b
+}
+
+// ApplicantProvidesData asks the applicant to provide their own data through a form.
+func (b *CheckBuilder) ApplicantProvidesData() *CheckBuilder {
+	b.cr.ApplicantProvidesData = true
+	return b
+}
+
+// Async creates the check asynchronously, its result is delivered by webhook.
+func (b *CheckBuilder) Async() *CheckBuilder {
+	b.cr.Async = true
+	return b
+}
+
+// PrivacyNoticesReadConsentGiven records the applicant's consent to the privacy notices.
+func (b *CheckBuilder) PrivacyNoticesReadConsentGiven() *CheckBuilder {
+	b.cr.PrivacyNoticesReadConsentGiven = true
+	return b
+}
+
+// USDrivingLicence sets the driving licence data of the document report.
+func (b *CheckBuilder) USDrivingLicence(dl USDrivingLicence) *CheckBuilder {
+	b.cr.USDrivingLicence = &dl
+	return b
+}
+
+// Build validates the options and returns the CheckRequest.
+// Errors wrap ErrInvalidCheckRequest.
+func (b *CheckBuilder) Build() (CheckRequest, error) {
+	if err := b.validate(); err != nil {
+		return CheckRequest{}, err
+	}
+	return b.cr, nil
+}
+
+// checkReportGroups are reports of which a check can only include one variant.
+var checkReportGroups = []struct {
+	name    string
+	reports []ReportName
+}{
+	{"document", []ReportName{
+		ReportNameDocument,
+		ReportNameDocumentWithAddress,
+		ReportNameDocumentWithDrivingLicense,
+	}},
+	{"facial similarity", []ReportName{
+		ReportNameFacialSimilarityPhoto,
+		ReportNameFacialSimilarityPhotoFullyAuto,
+		ReportNameFacialSimilarityVideo,
+		ReportNameFacialSimilarityMotion,
+	}},
+	{"watchlist", []ReportName{
+		ReportNameWatchlistEnhanced,
+		ReportNameWatchlistStandard,
+		ReportNameWatchlistPepsOnly,
+		ReportNameWatchlistSanctionsOnly,
+		ReportNameWatchlistAML,
+	}},
+}
+
+func (b *CheckBuilder) validate() error {
+	cr := b.cr
+	if cr.ApplicantID == "" {
+		return fmt.Errorf("%w: missing applicant id", ErrInvalidCheckRequest)
+	}
+	if len(cr.ReportNames) == 0 {
+		return fmt.Errorf("%w: at least one report is required", ErrInvalidCheckRequest)
+	}
+
+	reports := make(map[ReportName]bool, len(cr.ReportNames))
+	for _, r := range cr.ReportNames {
+		if reports[r] {
+			return fmt.Errorf("%w: duplicate report %s", ErrInvalidCheckRequest, r)
+		}
+		reports[r] = true
+	}
+
+	hasGroup := make(map[string]bool, len(checkReportGroups))
+	for _, g := range checkReportGroups {
+		var found []ReportName
+		for _, r := range g.reports {
+			if reports[r] {
+				found = append(found, r)
+			}
+		}
+		if len(found) > 1 {
+			return fmt.Errorf("%w: only one %s report is allowed, got %v", ErrInvalidCheckRequest, g.name, found)
+		}
+		hasGroup[g.name] = len(found) == 1
+	}
+
+	if hasGroup["facial similarity"] && !hasGroup["document"] {
+		return fmt.Errorf("%w: facial similarity reports require a document report", ErrInvalidCheckRequest)
+	}
+	if reports[ReportNameKnownFaces] && !hasGroup["facial similarity"] {
+		return fmt.Errorf("%w: known faces report requires a facial similarity report", ErrInvalidCheckRequest)
+	}
+	if cr.USDrivingLicence != nil && !hasGroup["document"] {
+		return fmt.Errorf("%w: us driving licence data requires a document report", ErrInvalidCheckRequest)
+	}
+	for _, r := range cr.Consider {
+		if !reports[r] {
+			return fmt.Errorf("%w: consider %s is not one of the check's reports", ErrInvalidCheckRequest, r)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package onfido
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckBuilder_Build(t *testing.T) {
+	cr, err := NewCheckBuilder("541d040b-89f8-444b-8921-16b1333bf1c6").
+		Reports(ReportNameDocument, ReportNameFacialSimilarityPhoto).
+		Documents("doc-1").
+		Webhooks("wh-1").
+		Tags("my-tag").
+		Consider(ReportNameDocument).
+		PrivacyNoticesReadConsentGiven().
+		USDrivingLicence(USDrivingLicence{IDNumber: "12345", IssueState: "GA"}).
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := json.Marshal(cr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var body map[string]interface{}
+	if err := json.Unmarshal(b, &body); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []interface{}{"document", "facial_similarity_photo"}, body["report_names"])
+	assert.Equal(t, []interface{}{"doc-1"}, body["document_ids"])
+	assert.Equal(t, []interface{}{"wh-1"}, body["webhook_ids"])
+	assert.Equal(t, []interface{}{"document"}, body["consider"])
+	assert.Equal(t, true, body["privacy_notices_read_consent_given"])
+	assert.Equal(t, map[string]interface{}{"id_number": "12345", "issue_state": "GA"}, body["us_driving_licence"])
+	assert.NotContains(t, body, "applicant_provides_data")
+}
+
+func TestCheckBuilder_Validation(t *testing.T) {
+	tests := map[string]*CheckBuilder{
+		"missing applicant": NewCheckBuilder("").Reports(ReportNameDocument),
+		"no reports":        NewCheckBuilder("app"),
+		"duplicate report":  NewCheckBuilder("app").Reports(ReportNameDocument, ReportNameDocument),
+		"two document reports": NewCheckBuilder("app").
+			Reports(ReportNameDocument, ReportNameDocumentWithAddress),
+		"two facial similarity reports": NewCheckBuilder("app").
+			Reports(ReportNameDocument, ReportNameFacialSimilarityPhoto, ReportNameFacialSimilarityVideo),
+		"two watchlist reports": NewCheckBuilder("app").
+			Reports(ReportNameWatchlistStandard, ReportNameWatchlistAML),
+		"facial similarity without document": NewCheckBuilder("app").
+			Reports(ReportNameFacialSimilarityMotion),
+		"known faces without facial similarity": NewCheckBuilder("app").
+			Reports(ReportNameDocument, ReportNameKnownFaces),
+		"driving licence without document": NewCheckBuilder("app").
+			Reports(ReportNameIdentityEnhanced).
+			USDrivingLicence(USDrivingLicence{IDNumber: "1", IssueState: "GA"}),
+		"consider unknown report": NewCheckBuilder("app").
+			Reports(ReportNameDocument).
+			Consider(ReportNameWatchlistStandard),
+	}
+	for name, b := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := b.Build()
+			assert.True(t, errors.Is(err, ErrInvalidCheckRequest), "got %v", err)
+		})
+	}
+}
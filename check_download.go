@@ -0,0 +1,198 @@
+package onfido
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"path"
+	"strings"
+)
+
+// ErrUnexpectedContentType means that a download returned a different content type than expected
+var ErrUnexpectedContentType = errors.New("unexpected content type")
+
+// DownloadCheck streams the PDF report of a check into w.
+// see https://documentation.onfido.com/#download-check
+func (c *client) DownloadCheck(ctx context.Context, id string, w io.Writer) error {
+	req, err := c.newRequest(http.MethodGet, "/checks/"+id+"/download", nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/pdf")
+
+	resp, err := c.send(ctx, req)
+	if err != nil {
+		return fmt.Errorf("failed to download check: %w", err)
+	}
+	defer resp.Body.Close()
+
+	ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	if err != nil || ct != "application/pdf" {
+		return fmt.Errorf("failed to download check: %w %q", ErrUnexpectedContentType, resp.Header.Get("Content-Type"))
+	}
+
+	if _, err := io.Copy(w, resp.Body); err != nil {
+		return fmt.Errorf("failed to download check: %w", err)
+	}
+	return nil
+}
+
+// ArchiveCheck writes a ZIP archive of a check into w, for compliance records.
+// The archive is laid out as:
+//
+//	check.json                 the check with its expanded reports
+//	check.pdf                  the check's PDF report
+//	documents/<id>.json        and documents/<id><ext> for each applicant document
+//	live_photos/<id>.json      and live_photos/<id><ext> for each live photo
+//	live_videos/<id>.json      and live_videos/<id><ext> for each live video
+//	id_photos/<id>.json        and id_photos/<id><ext> for each ID photo
+//	motion_captures/<id>.json  and motion_captures/<id><ext> for each motion capture
+//
+// Documents and media are those of the check's applicant.
+func ArchiveCheck(ctx context.Context, c OnfidoClient, checkID string, w io.Writer) error {
+	check, err := c.GetCheckExpanded(ctx, checkID)
+	if err != nil {
+		return err
+	}
+
+	a := &checkArchive{zip: zip.NewWriter(w)}
+	if err := a.writeJSON("check.json", check); err != nil {
+		return err
+	}
+	f, err := a.zip.Create("check.pdf")
+	if err != nil {
+		return err
+	}
+	if err := c.DownloadCheck(ctx, checkID, f); err != nil {
+		return err
+	}
+
+	docs := c.ListDocuments(check.ApplicantID)
+	for docs.Next(ctx) {
+		d := docs.Document()
+		if err := a.writeMedia(ctx, "documents", d.ID, d.FileName, d, func(w io.Writer) error {
+			dl, err := c.DownloadDocument(ctx, d.ID)
+			if err != nil {
+				return err
+			}
+			_, err = w.Write(dl.Data)
+			return err
+		}); err != nil {
+			return err
+		}
+	}
+	if err := docs.Err(); err != nil {
+		return err
+	}
+
+	photos := c.ListLivePhotos(check.ApplicantID)
+	for photos.Next(ctx) {
+		p := photos.LivePhoto()
+		if err := a.writeMedia(ctx, "live_photos", p.ID, p.FileName, p, func(w io.Writer) error {
+			dl, err := c.DownloadLivePhoto(ctx, p.ID)
+			if err != nil {
+				return err
+			}
+			_, err = w.Write(dl.Data)
+			return err
+		}); err != nil {
+			return err
+		}
+	}
+	if err := photos.Err(); err != nil {
+		return err
+	}
+
+	videos := c.ListLiveVideos(check.ApplicantID)
+	for videos.Next(ctx) {
+		v := videos.LiveVideo()
+		if err := a.writeMedia(ctx, "live_videos", v.ID, v.FileName, v, func(w io.Writer) error {
+			dl, err := c.DownloadLiveVideo(ctx, v.ID)
+			if err != nil {
+				return err
+			}
+			_, err = w.Write(dl.Data)
+			return err
+		}); err != nil {
+			return err
+		}
+	}
+	if err := videos.Err(); err != nil {
+		return err
+	}
+
+	idPhotos := c.ListIDPhotos(check.ApplicantID)
+	for idPhotos.Next(ctx) {
+		p := idPhotos.IDPhoto()
+		if err := a.writeMedia(ctx, "id_photos", p.ID, p.FileName, p, func(w io.Writer) error {
+			return c.DownloadIDPhoto(ctx, p.ID, w)
+		}); err != nil {
+			return err
+		}
+	}
+	if err := idPhotos.Err(); err != nil {
+		return err
+	}
+
+	captures := c.ListMotionCaptures(check.ApplicantID)
+	for captures.Next(ctx) {
+		mc := captures.MotionCapture()
+		if err := a.writeMedia(ctx, "motion_captures", mc.ID, mc.FileName, mc, func(w io.Writer) error {
+			dl, err := c.DownloadMotionCapture(ctx, mc.ID)
+			if err != nil {
+				return err
+			}
+			_, err = w.Write(dl.Data)
+			return err
+		}); err != nil {
+			return err
+		}
+	}
+	if err := captures.Err(); err != nil {
+		return err
+	}
+
+	return a.zip.Close()
+}
+
+type checkArchive struct {
+	zip *zip.Writer
+}
+
+func (a *checkArchive) writeJSON(name string, v interface{}) error {
+	b, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	f, err := a.zip.Create(name)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(f, bytes.NewReader(b))
+	return err
+}
+
+func (a *checkArchive) writeMedia(ctx context.Context, dir, id, fileName string, meta interface{}, download func(io.Writer) error) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	// IDs come from the API, but make sure they can't escape the directory.
+	id = strings.NewReplacer("/", "_", "\\", "_", "..", "_").Replace(id)
+	if err := a.writeJSON(path.Join(dir, id+".json"), meta); err != nil {
+		return err
+	}
+	f, err := a.zip.Create(path.Join(dir, id+path.Ext(path.Base(fileName))))
+	if err != nil {
+		return err
+	}
+	if err := download(f); err != nil {
+		return fmt.Errorf("failed to archive %s %s: %w", dir, id, err)
+	}
+	return nil
+}
@@ -0,0 +1,131 @@
+package onfido
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDownloadCheck_PDFStreamed(t *testing.T) {
+	m := mux.NewRouter()
+	m.HandleFunc("/checks/{id}/download", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "check-1", mux.Vars(r)["id"])
+		assert.Equal(t, "application/pdf", r.Header.Get("Accept"))
+		w.Header().Set("Content-Type", "application/pdf")
+		_, wErr := w.Write([]byte("%PDF-1.4"))
+		assert.NoError(t, wErr)
+	}).Methods("GET")
+	srv := httptest.NewServer(m)
+	defer srv.Close()
+
+	client := NewClient("123").(*client)
+	client.endpoint = srv.URL
+
+	var buf bytes.Buffer
+	if err := client.DownloadCheck(context.Background(), "check-1", &buf); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "%PDF-1.4", buf.String())
+}
+
+func TestDownloadCheck_UnexpectedContentType(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		_, wErr := w.Write([]byte("<html></html>"))
+		assert.NoError(t, wErr)
+	}))
+	defer srv.Close()
+
+	client := NewClient("123").(*client)
+	client.endpoint = srv.URL
+
+	var buf bytes.Buffer
+	err := client.DownloadCheck(context.Background(), "check-1", &buf)
+	if !errors.Is(err, ErrUnexpectedContentType) {
+		t.Fatalf("expected ErrUnexpectedContentType but got %v", err)
+	}
+	assert.Zero(t, buf.Len())
+}
+
+func TestArchiveCheck(t *testing.T) {
+	m := mux.NewRouter()
+	jsonBody := func(body string) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, wErr := w.Write([]byte(body))
+			assert.NoError(t, wErr)
+		}
+	}
+	binaryBody := func(ct, body string) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", ct)
+			_, wErr := w.Write([]byte(body))
+			assert.NoError(t, wErr)
+		}
+	}
+	m.HandleFunc("/checks/check-1", jsonBody(`{"id": "check-1", "applicant_id": "applicant-1", "report_ids": ["report-1"]}`))
+	m.HandleFunc("/reports/report-1", jsonBody(`{"id": "report-1", "name": "document"}`))
+	m.HandleFunc("/checks/check-1/download", binaryBody("application/pdf", "%PDF"))
+	m.HandleFunc("/documents", jsonBody(`{"documents": [{"id": "doc-1", "file_name": "passport.jpg"}]}`))
+	m.HandleFunc("/documents/doc-1/download", binaryBody("image/jpeg", "doc"))
+	m.HandleFunc("/live_photos", jsonBody(`{"live_photos": [{"id": "photo-1", "file_name": "selfie.png"}]}`))
+	m.HandleFunc("/live_photos/photo-1/download", binaryBody("image/png", "photo"))
+	m.HandleFunc("/live_videos", jsonBody(`{"live_videos": []}`))
+	m.HandleFunc("/id_photos", jsonBody(`{"id_photos": []}`))
+	m.HandleFunc("/motion_captures", jsonBody(`{"motion_captures": [{"id": "mc-1", "file_name": "motion.mp4"}]}`))
+	m.HandleFunc("/motion_captures/mc-1/download", binaryBody("video/mp4", "video"))
+	srv := httptest.NewServer(m)
+	defer srv.Close()
+
+	client := NewClient("123").(*client)
+	client.endpoint = srv.URL
+
+	var buf bytes.Buffer
+	if err := ArchiveCheck(context.Background(), client, "check-1", &buf); err != nil {
+		t.Fatal(err)
+	}
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	files := make(map[string]string)
+	var names []string
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, err := ioutil.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		files[f.Name] = string(b)
+		names = append(names, f.Name)
+	}
+	sort.Strings(names)
+
+	assert.Equal(t, []string{
+		"check.json",
+		"check.pdf",
+		"documents/doc-1.jpg",
+		"documents/doc-1.json",
+		"live_photos/photo-1.json",
+		"live_photos/photo-1.png",
+		"motion_captures/mc-1.json",
+		"motion_captures/mc-1.mp4",
+	}, names)
+	assert.Equal(t, "%PDF", files["check.pdf"])
+	assert.Equal(t, "doc", files["documents/doc-1.jpg"])
+	assert.Contains(t, files["check.json"], `"report-1"`)
+}
@@ -69,7 +69,7 @@ func TestCreateCheck_CheckCreated(t *testing.T) {
 
 	c, err := client.CreateCheck(context.Background(), CheckRequest{
 		RedirectURI:       expected.RedirectURI,
-		ReportNames:       []string{string(ReportNameDocument)},
+		ReportNames:       []ReportName{ReportNameDocument},
 		Tags:              expected.Tags,
 		SupressFormEmails: true,
 		ApplicantID:       expected.ApplicantID,
@@ -446,17 +446,23 @@ func TestResumeCheck_CheckCreated(t *testing.T) {
 		t.Fatal(err)
 	}
 
+	resumed := false
 	m := mux.NewRouter()
 	m.HandleFunc("/checks/{id}/resume", func(w http.ResponseWriter, r *http.Request) {
 		vars := mux.Vars(r)
 		if vars["id"] != expected.ID {
 			t.Fatal("expected check id was not in the request")
 		}
+		resumed = true
+		w.WriteHeader(http.StatusNoContent)
+	}).Methods("POST")
+	m.HandleFunc("/checks/{id}", func(w http.ResponseWriter, r *http.Request) {
+		assert.True(t, resumed, "the check is retrieved once resumed")
 		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(http.StatusOK)
 		_, wErr := w.Write(expectedJSON)
 		assert.NoError(t, wErr)
-	}).Methods("POST")
+	}).Methods("GET")
 	srv := httptest.NewServer(m)
 	defer srv.Close()
 
@@ -0,0 +1,164 @@
+package main
+
+import (
+	"flag"
+
+	"github.com/mbowman100/go-onfido"
+)
+
+var applicantColumns = []string{"id", "first_name", "last_name", "email", "dob", "created_at"}
+
+// applicantFlags adds the flags setting the fields of an applicant, which
+// apply sets on a, after the fields read from --from-file.
+func applicantFlags(e *env, fs *flag.FlagSet) (apply func(a *onfido.Applicant) error) {
+	fromFile := fs.String("from-file", "", "read the applicant from a JSON `file`, - for stdin")
+	fields := map[string]*string{
+		"first-name": fs.String("first-name", "", "first name"),
+		"last-name":  fs.String("last-name", "", "last name"),
+		"email":      fs.String("email", "", "email address"),
+		"dob":        fs.String("dob", "", "date of birth, YYYY-MM-DD"),
+	}
+	return func(a *onfido.Applicant) error {
+		if *fromFile != "" {
+			if err := e.readJSON(*fromFile, a); err != nil {
+				return err
+			}
+		}
+		targets := map[string]*string{
+			"first-name": &a.FirstName,
+			"last-name":  &a.LastName,
+			"email":      &a.Email,
+			"dob":        &a.DOB,
+		}
+		for name, v := range fields {
+			if isSet(fs, name) {
+				*targets[name] = *v
+			}
+		}
+		return nil
+	}
+}
+
+var applicantsCreate = &command{
+	resource: "applicants",
+	verb:     "create",
+	summary:  "Create an applicant.",
+	run: func(e *env, args []string) error {
+		fs := e.flagSet()
+		apply := applicantFlags(e, fs)
+		if _, err := e.parse(fs, args, 0); err != nil {
+			return err
+		}
+
+		var a onfido.Applicant
+		if err := apply(&a); err != nil {
+			return err
+		}
+		created, err := e.client.CreateApplicant(e.ctx, a)
+		if err != nil {
+			return err
+		}
+		return e.out.object(created)
+	},
+}
+
+var applicantsGet = &command{
+	resource: "applicants",
+	verb:     "get",
+	args:     "<applicant-id>",
+	summary:  "Retrieve an applicant.",
+	run: func(e *env, args []string) error {
+		args, err := e.parse(e.flagSet(), args, 1)
+		if err != nil {
+			return err
+		}
+		a, err := e.client.GetApplicant(e.ctx, args[0])
+		if err != nil {
+			return err
+		}
+		return e.out.object(a)
+	},
+}
+
+var applicantsUpdate = &command{
+	resource:    "applicants",
+	verb:        "update",
+	args:        "<applicant-id>",
+	summary:     "Update the given fields of an applicant.",
+	destructive: true,
+	run: func(e *env, args []string) error {
+		fs := e.flagSet()
+		apply := applicantFlags(e, fs)
+		args, err := e.parse(fs, args, 1)
+		if err != nil {
+			return err
+		}
+
+		// Only the given fields are sent, the API keeps the others.
+		var a onfido.Applicant
+		if err := apply(&a); err != nil {
+			return err
+		}
+		a.ID = args[0]
+		updated, err := e.client.UpdateApplicant(e.ctx, a)
+		if err != nil {
+			return err
+		}
+		return e.out.object(updated)
+	},
+}
+
+var applicantsDelete = &command{
+	resource:    "applicants",
+	verb:        "delete",
+	args:        "<applicant-id>",
+	summary:     "Schedule an applicant for deletion.",
+	destructive: true,
+	run: func(e *env, args []string) error {
+		args, err := e.parse(e.flagSet(), args, 1)
+		if err != nil {
+			return err
+		}
+		if err := e.client.DeleteApplicant(e.ctx, args[0]); err != nil {
+			return err
+		}
+		e.logf("applicant %s scheduled for deletion", args[0])
+		return nil
+	},
+}
+
+var applicantsRestore = &command{
+	resource: "applicants",
+	verb:     "restore",
+	args:     "<applicant-id>",
+	summary:  "Restore an applicant scheduled for deletion.",
+	run: func(e *env, args []string) error {
+		args, err := e.parse(e.flagSet(), args, 1)
+		if err != nil {
+			return err
+		}
+		if err := e.client.RestoreApplicant(e.ctx, args[0]); err != nil {
+			return err
+		}
+		e.logf("applicant %s restored", args[0])
+		return nil
+	},
+}
+
+var applicantsList = &command{
+	resource: "applicants",
+	verb:     "list",
+	summary:  "List applicants, newest first.",
+	run: func(e *env, args []string) error {
+		fs := e.flagSet()
+		limit := limitFlag(fs)
+		if _, err := e.parse(fs, args, 0); err != nil {
+			return err
+		}
+		items, err := collect(e.ctx, e.client.ListApplicants(), *limit)
+		if err != nil {
+			return err
+		}
+		return e.out.list(applicantColumns, items)
+	},
+}
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/mbowman100/go-onfido"
+	"github.com/mbowman100/go-onfido/onfidotest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplicants(t *testing.T) {
+	srv := onfidotest.NewServer()
+	defer srv.Close()
+
+	var a onfido.Applicant
+	decode(t, onfidoCLI(srv, onfidotest.Token, "", "-output", "json", "applicants", "create",
+		"--first-name", "Jane", "--last-name", "Doe", "--email", "jane@example.com"), &a)
+	assert.NotEmpty(t, a.ID)
+	assert.Equal(t, "jane@example.com", a.Email)
+
+	var updated onfido.Applicant
+	decode(t, onfidoCLI(srv, onfidotest.Token, "", "-output", "json", "applicants", "update", a.ID, "--dob", "1990-01-31"), &updated)
+	assert.Equal(t, "1990-01-31", updated.DOB)
+	assert.Equal(t, "Jane", updated.FirstName, "fields which aren't given are kept")
+	assert.Equal(t, "jane@example.com", updated.Email)
+
+	r := onfidoCLI(srv, onfidotest.Token, "", "applicants", "list")
+	assert.Equal(t, 0, r.code, r.stderr)
+	assert.Contains(t, r.stdout, "FIRST NAME")
+	assert.Contains(t, r.stdout, a.ID)
+
+	r = onfidoCLI(srv, onfidotest.Token, "", "applicants", "delete", a.ID)
+	assert.Equal(t, 0, r.code, r.stderr)
+	assert.Contains(t, r.stderr, "scheduled for deletion")
+	r = onfidoCLI(srv, onfidotest.Token, "", "applicants", "get", a.ID)
+	assert.Equal(t, 1, r.code)
+
+	r = onfidoCLI(srv, onfidotest.Token, "", "applicants", "restore", a.ID)
+	assert.Equal(t, 0, r.code, r.stderr)
+	r = onfidoCLI(srv, onfidotest.Token, "", "applicants", "get", a.ID)
+	assert.Equal(t, 0, r.code, r.stderr)
+	assert.Contains(t, r.stdout, "first_name:")
+}
+
+func TestApplicantsCreate_FromFile(t *testing.T) {
+	srv := onfidotest.NewServer()
+	defer srv.Close()
+
+	var a onfido.Applicant
+	decode(t, onfidoCLI(srv, onfidotest.Token, `{"first_name": "Jane", "last_name": "Doe"}`,
+		"applicants", "create", "--from-file", "-", "--last-name", "Smith", "--output", "json"), &a)
+	assert.Equal(t, "Jane", a.FirstName)
+	assert.Equal(t, "Smith", a.LastName, "flags override the file")
+
+	path := filepath.Join(t.TempDir(), "applicant.json")
+	if err := os.WriteFile(path, []byte(`{"first_name": "Jane", "surname": "Doe"}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	r := onfidoCLI(srv, onfidotest.Token, "", "applicants", "create", "--from-file", path)
+	assert.Equal(t, 1, r.code)
+	assert.Contains(t, r.stderr, `unknown field "surname"`)
+}
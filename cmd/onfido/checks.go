@@ -0,0 +1,177 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/mbowman100/go-onfido"
+)
+
+var checkColumns = []string{"id", "status", "result", "tags", "created_at"}
+
+var checksCreate = &command{
+	resource: "checks",
+	verb:     "create",
+	summary:  "Create a check for an applicant.",
+	run: func(e *env, args []string) error {
+		fs := e.flagSet()
+		fromFile := fs.String("from-file", "", "read the check request from a JSON `file`, - for stdin")
+		applicantID := fs.String("applicant", "", "applicant `id`")
+		reports := fs.String("reports", "", "comma separated report names, e.g. document,facial_similarity_photo")
+		documents := fs.String("documents", "", "comma separated document ids, defaults to the most recently uploaded")
+		tags := fs.String("tags", "", "comma separated tags")
+		consider := fs.String("consider", "", "comma separated reports to return a consider result in the sandbox")
+		redirectURI := fs.String("redirect-uri", "", "redirect URI of the applicant form")
+		async := fs.Bool("async", false, "create the check asynchronously")
+		if _, err := e.parse(fs, args, 0); err != nil {
+			return err
+		}
+
+		var cr onfido.CheckRequest
+		if *fromFile != "" {
+			if err := e.readJSON(*fromFile, &cr); err != nil {
+				return err
+			}
+		}
+		if *applicantID != "" {
+			cr.ApplicantID = *applicantID
+		}
+		if *reports != "" {
+			cr.ReportNames = reportNames(*reports)
+		}
+		if *documents != "" {
+			cr.DocumentIDs = splitList(*documents)
+		}
+		if *tags != "" {
+			cr.Tags = splitList(*tags)
+		}
+		if *consider != "" {
+			cr.Consider = reportNames(*consider)
+		}
+		if *redirectURI != "" {
+			cr.RedirectURI = *redirectURI
+		}
+		if *async {
+			cr.Async = true
+		}
+		if cr.ApplicantID == "" || len(cr.ReportNames) == 0 {
+			fs.Usage()
+			return errUsage
+		}
+
+		chk, err := e.client.CreateCheck(e.ctx, cr)
+		if err != nil {
+			return err
+		}
+		return e.out.object(chk)
+	},
+}
+
+func reportNames(s string) []onfido.ReportName {
+	var names []onfido.ReportName
+	for _, n := range splitList(s) {
+		names = append(names, onfido.ReportName(n))
+	}
+	return names
+}
+
+var checksGet = &command{
+	resource: "checks",
+	verb:     "get",
+	args:     "<check-id>",
+	summary:  "Retrieve a check.",
+	run: func(e *env, args []string) error {
+		args, err := e.parse(e.flagSet(), args, 1)
+		if err != nil {
+			return err
+		}
+		chk, err := e.client.GetCheck(e.ctx, args[0])
+		if err != nil {
+			return err
+		}
+		return e.out.object(chk)
+	},
+}
+
+var checksWait = &command{
+	resource: "checks",
+	verb:     "wait",
+	args:     "<check-id>",
+	summary:  "Wait for a check to be complete or withdrawn, and print it.",
+	run: func(e *env, args []string) error {
+		fs := e.flagSet()
+		interval := fs.Duration("interval", 5*time.Second, "polling interval")
+		timeout := fs.Duration("timeout", 10*time.Minute, "maximum time to wait, 0 for no limit")
+		args, err := e.parse(fs, args, 1)
+		if err != nil {
+			return err
+		}
+
+		ctx := e.ctx
+		if *timeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, *timeout)
+			defer cancel()
+		}
+		chk, err := waitForCheck(ctx, e, args[0], *interval)
+		if err != nil {
+			return err
+		}
+		return e.out.object(chk)
+	},
+}
+
+// waitForCheck polls the check until it's complete or withdrawn,
+// logging the changes of status.
+func waitForCheck(ctx context.Context, e *env, id string, interval time.Duration) (*onfido.CheckRetrieved, error) {
+	var status onfido.CheckStatus
+	for {
+		chk, err := e.client.GetCheck(ctx, id)
+		if err != nil {
+			if ctx.Err() == context.DeadlineExceeded {
+				return nil, fmt.Errorf("timed out waiting for check %s, last status %q", id, status)
+			}
+			return nil, err
+		}
+		if chk.Status != status {
+			status = chk.Status
+			e.logf("check %s is %s", id, status)
+		}
+		switch chk.Status {
+		case onfido.CheckStatusComplete, onfido.CheckStatusWithdrawn:
+			return chk, nil
+		}
+
+		t := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			if ctx.Err() == context.DeadlineExceeded {
+				return nil, fmt.Errorf("timed out waiting for check %s, last status %q", id, status)
+			}
+			return nil, ctx.Err()
+		case <-t.C:
+		}
+	}
+}
+
+var checksList = &command{
+	resource: "checks",
+	verb:     "list",
+	args:     "<applicant-id>",
+	summary:  "List the checks of an applicant.",
+	run: func(e *env, args []string) error {
+		fs := e.flagSet()
+		limit := limitFlag(fs)
+		args, err := e.parse(fs, args, 1)
+		if err != nil {
+			return err
+		}
+		items, err := collect(e.ctx, e.client.ListChecks(args[0]), *limit)
+		if err != nil {
+			return err
+		}
+		return e.out.list(checkColumns, items)
+	},
+}
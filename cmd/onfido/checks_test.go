@@ -0,0 +1,61 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/mbowman100/go-onfido"
+	"github.com/mbowman100/go-onfido/onfidotest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChecks(t *testing.T) {
+	srv := onfidotest.NewServer(onfidotest.WithCheckDelay(50 * time.Millisecond))
+	defer srv.Close()
+	a, err := srv.Client().CreateApplicant(context.Background(), onfido.Applicant{FirstName: "Jane", LastName: "Doe"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := onfidoCLI(srv, onfidotest.Token, "", "checks", "create", "--applicant", a.ID)
+	assert.Equal(t, 2, r.code, "--reports is required")
+
+	var chk onfido.CheckRetrieved
+	decode(t, onfidoCLI(srv, onfidotest.Token, "", "checks", "create", "--applicant", a.ID,
+		"--reports", "watchlist_standard", "--tags", "cli,test", "--consider", "watchlist_standard", "--output", "json"), &chk)
+	assert.Equal(t, onfido.CheckStatusInProgress, chk.Status)
+	assert.Equal(t, []string{"cli", "test"}, chk.Tags)
+
+	var done onfido.CheckRetrieved
+	r = onfidoCLI(srv, onfidotest.Token, "", "checks", "wait", chk.ID, "--interval", "10ms", "--output", "json")
+	decode(t, r, &done)
+	assert.Equal(t, onfido.CheckStatusComplete, done.Status)
+	assert.Equal(t, onfido.CheckResultConsider, done.Result)
+	assert.Contains(t, r.stderr, "is complete")
+
+	r = onfidoCLI(srv, onfidotest.Token, "", "checks", "list", a.ID, "--output", "yaml")
+	assert.Equal(t, 0, r.code, r.stderr)
+	assert.Contains(t, r.stdout, "status: complete")
+}
+
+func TestChecksWait_Timeout(t *testing.T) {
+	srv := onfidotest.NewServer(onfidotest.WithCheckDelay(-1))
+	defer srv.Close()
+	c := srv.Client()
+	a, err := c.CreateApplicant(context.Background(), onfido.Applicant{FirstName: "Jane", LastName: "Doe"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	chk, err := c.CreateCheck(context.Background(), onfido.CheckRequest{
+		ApplicantID: a.ID,
+		ReportNames: []onfido.ReportName{onfido.ReportNameWatchlistStandard},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := onfidoCLI(srv, onfidotest.Token, "", "checks", "wait", chk.ID, "--interval", "10ms", "--timeout", "50ms")
+	assert.Equal(t, 1, r.code)
+	assert.Contains(t, r.stderr, `timed out waiting for check `+chk.ID+`, last status "in_progress"`)
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"os"
+
+	"github.com/mbowman100/go-onfido"
+)
+
+var documentColumns = []string{"id", "type", "side", "file_name", "file_size", "created_at"}
+
+var documentsUpload = &command{
+	resource: "documents",
+	verb:     "upload",
+	args:     "<file>",
+	summary:  "Upload a document image or PDF for an applicant.",
+	run: func(e *env, args []string) error {
+		fs := e.flagSet()
+		applicantID := fs.String("applicant", "", "applicant `id` (required)")
+		typ := fs.String("type", string(onfido.DocumentTypePassport), "document type, e.g. passport, national_identity_card, driving_licence")
+		side := fs.String("side", "", "document side: front or back")
+		args, err := e.parse(fs, args, 1)
+		if err != nil {
+			return err
+		}
+		if *applicantID == "" {
+			fs.Usage()
+			return errUsage
+		}
+
+		f, err := os.Open(args[0])
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		doc, err := e.client.UploadDocument(e.ctx, onfido.DocumentRequest{
+			ApplicantID: *applicantID,
+			File:        f,
+			Type:        onfido.DocumentType(*typ),
+			Side:        onfido.DocumentSide(*side),
+		})
+		if err != nil {
+			return err
+		}
+		return e.out.object(doc)
+	},
+}
+
+var documentsDownload = &command{
+	resource: "documents",
+	verb:     "download",
+	args:     "<document-id>",
+	summary:  "Download a document, to stdout unless --out is given.",
+	run: func(e *env, args []string) error {
+		fs := e.flagSet()
+		out := fs.String("out", "", "write the document to `file`")
+		args, err := e.parse(fs, args, 1)
+		if err != nil {
+			return err
+		}
+
+		dl, err := e.client.DownloadDocument(e.ctx, args[0])
+		if err != nil {
+			return err
+		}
+		if *out == "" {
+			_, err = e.stdout.Write(dl.Data)
+			return err
+		}
+		if err := os.WriteFile(*out, dl.Data, 0o600); err != nil {
+			return err
+		}
+		e.logf("document %s written to %s", args[0], *out)
+		return nil
+	},
+}
+
+var documentsList = &command{
+	resource: "documents",
+	verb:     "list",
+	args:     "<applicant-id>",
+	summary:  "List the documents of an applicant.",
+	run: func(e *env, args []string) error {
+		fs := e.flagSet()
+		limit := limitFlag(fs)
+		args, err := e.parse(fs, args, 1)
+		if err != nil {
+			return err
+		}
+		items, err := collect(e.ctx, e.client.ListDocuments(args[0]), *limit)
+		if err != nil {
+			return err
+		}
+		return e.out.list(documentColumns, items)
+	},
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/mbowman100/go-onfido"
+	"github.com/mbowman100/go-onfido/onfidotest"
+	"github.com/stretchr/testify/assert"
+)
+
+// pngData is a file sniffed as a PNG image.
+var pngData = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
+func TestDocuments(t *testing.T) {
+	srv := onfidotest.NewServer()
+	defer srv.Close()
+	a, err := srv.Client().CreateApplicant(context.Background(), onfido.Applicant{FirstName: "Jane", LastName: "Doe"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir := t.TempDir()
+	path := filepath.Join(dir, "passport.png")
+	if err := os.WriteFile(path, pngData, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	r := onfidoCLI(srv, onfidotest.Token, "", "documents", "upload", path)
+	assert.Equal(t, 2, r.code, "--applicant is required")
+
+	var doc onfido.Document
+	decode(t, onfidoCLI(srv, onfidotest.Token, "", "documents", "upload", "--applicant", a.ID,
+		"--type", "passport", "--side", "front", path, "--output", "json"), &doc)
+	assert.Equal(t, onfido.DocumentTypePassport, doc.Type)
+	assert.Equal(t, a.ID, doc.ApplicantID)
+
+	r = onfidoCLI(srv, onfidotest.Token, "", "documents", "list", a.ID)
+	assert.Equal(t, 0, r.code, r.stderr)
+	assert.Contains(t, r.stdout, doc.ID)
+	assert.Contains(t, r.stdout, "passport")
+
+	r = onfidoCLI(srv, onfidotest.Token, "", "documents", "download", doc.ID)
+	assert.Equal(t, 0, r.code, r.stderr)
+	assert.Equal(t, string(pngData), r.stdout)
+
+	out := filepath.Join(dir, "download.png")
+	r = onfidoCLI(srv, onfidotest.Token, "", "documents", "download", doc.ID, "--out", out)
+	assert.Equal(t, 0, r.code, r.stderr)
+	data, err := os.ReadFile(out)
+	if assert.NoError(t, err) {
+		assert.Equal(t, pngData, data)
+	}
+}
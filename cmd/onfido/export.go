@@ -0,0 +1,91 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/mbowman100/go-onfido/onfidoexport"
+)
+
+var reportsExport = &command{
+	resource: "reports",
+	verb:     "export",
+	summary:  "Export the reports of all the applicants' finished checks as NDJSON or CSV.",
+	run: func(e *env, args []string) error {
+		fs := e.flagSet()
+		format := fs.String("format", onfidoexport.FormatNDJSON, "export format: ndjson or csv")
+		columns := fs.String("columns", strings.Join(onfidoexport.DefaultColumns, ","),
+			"comma separated columns, from "+strings.Join(onfidoexport.ColumnNames(), ", ")+" or breakdown.<path>")
+		since := fs.String("since", "", "only export the checks created after this RFC 3339 `time`")
+		checkpoint := fs.String("checkpoint", "", "incremental export: resume from the checkpoint in `file` and save the next one to it")
+		concurrency := fs.Int("concurrency", onfidoexport.DefaultConcurrency, "number of applicants exported concurrently")
+		includePending := fs.Bool("include-pending", false, "also export the checks which aren't complete or withdrawn")
+		includeEmpty := fs.Bool("include-empty", false, "also export a row per applicant without checks and per check without reports")
+		redact := fs.Bool("redact", false, "redact names, email and date of birth")
+		out := fs.String("out", "", "write the export to `file` instead of stdout")
+		if _, err := e.parse(fs, args, 0); err != nil {
+			return err
+		}
+
+		ex := &onfidoexport.Exporter{
+			Client:         e.client,
+			Columns:        splitList(*columns),
+			Concurrency:    *concurrency,
+			IncludePending: *includePending,
+			IncludeEmpty:   *includeEmpty,
+			Redact:         *redact,
+		}
+		if *checkpoint != "" {
+			cp, err := onfidoexport.LoadCheckpoint(*checkpoint)
+			if err != nil {
+				return err
+			}
+			ex.Checkpoint = cp
+		}
+		if *since != "" {
+			t, err := time.Parse(time.RFC3339, *since)
+			if err != nil {
+				return fmt.Errorf("invalid --since: %w", err)
+			}
+			ex.Checkpoint = onfidoexport.Checkpoint{Since: t}
+		}
+
+		var (
+			w io.Writer = e.stdout
+			f *os.File
+		)
+		if *out != "" {
+			var err error
+			if f, err = os.Create(*out); err != nil {
+				return err
+			}
+			defer f.Close()
+			w = f
+		}
+		ew, err := onfidoexport.NewWriter(*format, w)
+		if err != nil {
+			return err
+		}
+		res, err := ex.Export(e.ctx, ew)
+		if err != nil {
+			return err
+		}
+		if f != nil {
+			if err := f.Close(); err != nil {
+				return err
+			}
+		}
+		e.logf("exported %d reports of %d checks from %d applicants, skipped %d pending checks", res.Reports, res.Checks, res.Applicants, res.Pending)
+
+		if *checkpoint != "" {
+			if err := onfidoexport.SaveCheckpoint(*checkpoint, res.Checkpoint); err != nil {
+				return err
+			}
+			e.logf("next export since %s, with %d pending checks", res.Checkpoint.Since.Format(time.RFC3339), len(res.Checkpoint.Pending))
+		}
+		return nil
+	},
+}
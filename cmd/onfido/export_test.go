@@ -0,0 +1,73 @@
+package main
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/mbowman100/go-onfido"
+	"github.com/mbowman100/go-onfido/onfidoexport"
+	"github.com/mbowman100/go-onfido/onfidotest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReportsExport(t *testing.T) {
+	srv := onfidotest.NewServer()
+	defer srv.Close()
+	c := srv.Client()
+	ctx := context.Background()
+	a, err := c.CreateApplicant(ctx, onfido.Applicant{FirstName: "Jane", LastName: "Consider"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	chk, err := c.CreateCheck(ctx, onfido.CheckRequest{
+		ApplicantID: a.ID,
+		ReportNames: []onfido.ReportName{onfido.ReportNameWatchlistStandard},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dir := t.TempDir()
+	checkpoint := filepath.Join(dir, "checkpoint.json")
+	r := onfidoCLI(srv, onfidotest.Token, "", "reports", "export", "--format", "csv",
+		"--columns", "check_id,report_name,report_result,last_name", "--redact", "--checkpoint", checkpoint)
+	assert.Equal(t, 0, r.code, r.stderr)
+	assert.Equal(t, "check_id,report_name,report_result,last_name\n"+chk.ID+",watchlist_standard,consider,[redacted]\n", r.stdout)
+	assert.Contains(t, r.stderr, "exported 1 reports of 1 checks from 1 applicants")
+
+	cp, err := onfidoexport.LoadCheckpoint(checkpoint)
+	if assert.NoError(t, err) {
+		assert.True(t, cp.Since.Equal(*chk.CreatedAt))
+		assert.Equal(t, []string{chk.ID}, cp.Seen)
+	}
+
+	out := filepath.Join(dir, "export.ndjson")
+	r = onfidoCLI(srv, onfidotest.Token, "", "reports", "export", "--checkpoint", checkpoint, "--out", out)
+	assert.Equal(t, 0, r.code, r.stderr)
+	data, err := os.ReadFile(out)
+	if assert.NoError(t, err) {
+		assert.Empty(t, data, "the check was exported by the previous run")
+	}
+
+	r = onfidoCLI(srv, onfidotest.Token, "", "reports", "export", "--since", "2000-01-01T00:00:00Z", "--columns", "report_result")
+	assert.Equal(t, 0, r.code, r.stderr)
+	assert.Equal(t, `{"report_result":"consider"}`+"\n", r.stdout)
+
+	b, err := c.CreateApplicant(ctx, onfido.Applicant{FirstName: "John", LastName: "Doe"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	r = onfidoCLI(srv, onfidotest.Token, "", "reports", "export", "--format", "csv", "--columns", "applicant_id,check_id", "--include-empty")
+	assert.Equal(t, 0, r.code, r.stderr)
+	assert.Contains(t, r.stdout, b.ID+",\n")
+
+	r = onfidoCLI(srv, onfidotest.Token, "", "reports", "export", "--columns", "nope")
+	assert.Equal(t, 1, r.code)
+	assert.Contains(t, r.stderr, `unknown column "nope"`)
+
+	r = onfidoCLI(srv, onfidotest.Token, "", "reports", "export", "--since", "yesterday")
+	assert.Equal(t, 1, r.code)
+	assert.Contains(t, r.stderr, "invalid --since")
+}
@@ -0,0 +1,200 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/mbowman100/go-onfido"
+)
+
+var webhooksListen = &command{
+	resource: "webhooks",
+	verb:     "listen",
+	summary:  "Receive webhook events locally, verify and print them, and optionally forward them.",
+	offline:  true,
+	run: func(e *env, args []string) error {
+		fs := e.flagSet()
+		port := fs.Int("port", 8080, "local `port` to listen on, 0 for any")
+		token := fs.String("webhook-token", "", "webhook token verifying the signatures, defaults to $"+onfido.WebhookTokenEnv)
+		fetch := fs.Bool("fetch", false, "fetch and print the check or report of each event, with $"+onfido.TokenEnv)
+		forwardTo := fs.String("forward-to", "", "forward verified events to `url`, e.g. http://localhost:3000/webhooks")
+		forwardToken := fs.String("forward-token", "", "webhook token re-signing forwarded events, defaults to --webhook-token")
+		if _, err := e.parse(fs, args, 0); err != nil {
+			return err
+		}
+		if *token == "" {
+			*token = e.getenv(onfido.WebhookTokenEnv)
+		}
+		if *token == "" {
+			return fmt.Errorf("webhook token not found in --webhook-token or environmental variable `%s`", onfido.WebhookTokenEnv)
+		}
+		if *fetch && e.client == nil {
+			return fmt.Errorf("--fetch needs an API token in environmental variable `%s`", onfido.TokenEnv)
+		}
+		if *forwardToken == "" {
+			*forwardToken = *token
+		}
+
+		l := &listener{
+			e:            e,
+			webhook:      onfido.NewWebhook(*token),
+			fetch:        *fetch,
+			forwardTo:    *forwardTo,
+			forwardToken: *forwardToken,
+			httpClient:   &http.Client{Timeout: 30 * time.Second},
+		}
+		return l.listenAndServe(net.JoinHostPort("localhost", strconv.Itoa(*port)))
+	},
+}
+
+// listener receives webhook events, like the endpoint of a webhook consumer.
+type listener struct {
+	e            *env
+	webhook      onfido.Webhook
+	fetch        bool
+	forwardTo    string
+	forwardToken string
+	httpClient   *http.Client
+
+	// mu serialises the output of concurrent events.
+	mu sync.Mutex
+}
+
+// listenAndServe serves events on addr until the context is done.
+func (l *listener) listenAndServe(addr string) error {
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	srv := &http.Server{Handler: l, ReadHeaderTimeout: 10 * time.Second}
+	l.e.logf("listening for webhook events on http://%s", ln.Addr())
+	if l.forwardTo != "" {
+		l.e.logf("forwarding verified events to %s", l.forwardTo)
+	}
+
+	errc := make(chan error, 1)
+	go func() { errc <- srv.Serve(ln) }()
+	select {
+	case err := <-errc:
+		return err
+	case <-l.e.ctx.Done():
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := srv.Shutdown(ctx); err != nil {
+		return err
+	}
+	if err := <-errc; !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+func (l *listener) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	// The body is kept to be forwarded as received.
+	body, err := ioutil.ReadAll(io.LimitReader(r.Body, onfido.DefaultWebhookMaxBodySize+1))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	r.Body = ioutil.NopCloser(bytes.NewReader(body))
+	wr, err := l.webhook.ParseFromRequest(r)
+	if err != nil {
+		l.e.logf("rejected event: %v", err)
+		switch {
+		case errors.Is(err, onfido.ErrWebhookBodyTooLarge):
+			http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+		case errors.Is(err, onfido.ErrInvalidWebhookSignature), r.Header.Get(onfido.WebhookSignatureHeader) == "":
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+		default:
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+		return
+	}
+
+	var resource interface{}
+	if l.fetch {
+		if resource, err = wr.Fetch(r.Context(), l.e.client); err != nil {
+			l.e.logf("failed to fetch %s %s: %v", wr.Payload.ResourceType, wr.Payload.Object.ID, err)
+			resource = nil
+		}
+	}
+	if err := l.print(wr, resource); err != nil {
+		l.e.logf("failed to print event: %v", err)
+	}
+
+	status := http.StatusOK
+	if l.forwardTo != "" {
+		status = l.forward(r.Context(), body)
+	}
+	w.WriteHeader(status)
+}
+
+// print prints an event, followed by its resource if it was fetched. In a
+// table an event is a line with the action, object id and status.
+func (l *listener) print(wr *onfido.WebhookRequest, resource interface{}) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.e.out.format == formatTable {
+		p := wr.Payload
+		if _, err := fmt.Fprintf(l.e.stdout, "%s  %s  %s  %s\n", time.Now().Format("15:04:05"), p.Action, p.Object.ID, p.Object.Status); err != nil {
+			return err
+		}
+	} else if err := l.e.out.object(wr); err != nil {
+		return err
+	}
+	if resource == nil {
+		return nil
+	}
+	return l.e.out.object(resource)
+}
+
+// forward posts the event to the forward URL, re-signed with the forward
+// token, and returns the status to respond with: the forward URL's status,
+// so that failures are retried by the sender, or 502 if it can't be reached.
+func (l *listener) forward(ctx context.Context, body []byte) int {
+	req, err := http.NewRequest(http.MethodPost, l.forwardTo, bytes.NewReader(body))
+	if err != nil {
+		l.e.logf("failed to forward event: %v", err)
+		return http.StatusBadGateway
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(onfido.WebhookSignatureHeader, signWebhook(l.forwardToken, body))
+
+	resp, err := l.httpClient.Do(req)
+	if err != nil {
+		l.e.logf("failed to forward event: %v", err)
+		return http.StatusBadGateway
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(ioutil.Discard, resp.Body)
+	l.e.logf("forwarded event to %s: %s", l.forwardTo, resp.Status)
+	return resp.StatusCode
+}
+
+// signWebhook returns the signature header of a webhook body, see onfido.Webhook.ValidateSignature.
+func signWebhook(token string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(token))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,173 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/mbowman100/go-onfido"
+	"github.com/mbowman100/go-onfido/onfidotest"
+	"github.com/stretchr/testify/assert"
+)
+
+// syncBuffer is a buffer written by the listener's handlers and read by the test.
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+var listeningRe = regexp.MustCompile(`listening for webhook events on (http://\S+)`)
+
+// listen starts the listener and returns its URL and output, it stops when the test ends.
+func listen(t *testing.T, env map[string]string, args ...string) (url string, stdout, stderr *syncBuffer) {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	stdout, stderr = &syncBuffer{}, &syncBuffer{}
+	done := make(chan int)
+	go func() {
+		done <- run(ctx, append([]string{"webhooks", "listen", "--port", "0"}, args...), strings.NewReader(""), stdout, stderr, func(k string) string { return env[k] })
+	}()
+	t.Cleanup(func() {
+		cancel()
+		assert.Equal(t, 0, <-done, stderr.String())
+	})
+
+	for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); time.Sleep(5 * time.Millisecond) {
+		if m := listeningRe.FindStringSubmatch(stderr.String()); m != nil {
+			return m[1], stdout, stderr
+		}
+	}
+	t.Fatalf("listener didn't start: %s", stderr.String())
+	return "", nil, nil
+}
+
+func postEvent(t *testing.T, url, token string, body []byte) int {
+	t.Helper()
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token != "" {
+		req.Header.Set(onfido.WebhookSignatureHeader, signWebhook(token, body))
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	return resp.StatusCode
+}
+
+func checkEvent(id string) []byte {
+	return []byte(`{"payload":{"resource_type":"check","action":"check.completed","object":{"id":"` + id +
+		`","status":"complete","completed_at_iso8601":"2024-01-31T10:00:00Z","href":"https://api.eu.onfido.com/v3.5/checks/` + id + `"}}}`)
+}
+
+func TestWebhooksListen(t *testing.T) {
+	var (
+		mu        sync.Mutex
+		forwarded []*onfido.WebhookRequest
+		status    = http.StatusOK
+	)
+	consumer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		wr, err := onfido.NewWebhook("forward-token").ParseFromRequest(r)
+		mu.Lock()
+		defer mu.Unlock()
+		if err != nil {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		forwarded = append(forwarded, wr)
+		w.WriteHeader(status)
+	}))
+	defer consumer.Close()
+
+	url, stdout, stderr := listen(t, map[string]string{onfido.WebhookTokenEnv: "webhook-token"},
+		"--forward-to", consumer.URL, "--forward-token", "forward-token")
+
+	assert.Equal(t, http.StatusOK, postEvent(t, url, "webhook-token", checkEvent("check-1")))
+	assert.Contains(t, stdout.String(), "check.completed  check-1  complete")
+	mu.Lock()
+	if assert.Len(t, forwarded, 1, "the event is re-signed with the forward token") {
+		assert.Equal(t, "check-1", forwarded[0].Payload.Object.ID)
+	}
+	status = http.StatusInternalServerError
+	mu.Unlock()
+
+	assert.Equal(t, http.StatusInternalServerError, postEvent(t, url, "webhook-token", checkEvent("check-2")),
+		"the consumer's status is returned so that the sender retries")
+	assert.Contains(t, stderr.String(), "forwarded event to "+consumer.URL+": 500 Internal Server Error")
+
+	assert.Equal(t, http.StatusUnauthorized, postEvent(t, url, "wrong-token", checkEvent("check-3")))
+	assert.Equal(t, http.StatusUnauthorized, postEvent(t, url, "", checkEvent("check-4")))
+	assert.Equal(t, http.StatusBadRequest, postEvent(t, url, "webhook-token", []byte("not json")))
+	assert.Contains(t, stderr.String(), "rejected event: "+onfido.ErrInvalidWebhookSignature.Error())
+	mu.Lock()
+	assert.Len(t, forwarded, 2, "rejected events aren't forwarded")
+	mu.Unlock()
+	assert.NotContains(t, stdout.String(), "check-3")
+}
+
+func TestWebhooksListen_Fetch(t *testing.T) {
+	srv := onfidotest.NewServer()
+	defer srv.Close()
+	c := srv.Client()
+	ctx := context.Background()
+	a, err := c.CreateApplicant(ctx, onfido.Applicant{FirstName: "Jane", LastName: "Doe"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	chk, err := c.CreateCheck(ctx, onfido.CheckRequest{
+		ApplicantID: a.ID,
+		ReportNames: []onfido.ReportName{onfido.ReportNameWatchlistStandard},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	url, stdout, _ := listen(t, map[string]string{onfido.TokenEnv: onfidotest.Token},
+		"--endpoint", srv.Endpoint(), "--webhook-token", "webhook-token", "--fetch", "--output", "json")
+	// The check is fetched from its href, so on the fake.
+	event := strings.Replace(string(checkEvent(chk.ID)), "https://api.eu.onfido.com", srv.URL, 1)
+	assert.Equal(t, http.StatusOK, postEvent(t, url, "webhook-token", []byte(event)))
+
+	dec := json.NewDecoder(strings.NewReader(stdout.String()))
+	var wr onfido.WebhookRequest
+	var check onfido.Check
+	if assert.NoError(t, dec.Decode(&wr)) && assert.NoError(t, dec.Decode(&check)) {
+		assert.Equal(t, "check.completed", wr.Payload.Action)
+		assert.Equal(t, chk.ID, check.ID)
+		if assert.Len(t, check.Reports, 1, "the check is fetched with its reports") {
+			assert.Equal(t, onfido.ReportNameWatchlistStandard, check.Reports[0].Name)
+		}
+	}
+}
+
+func TestWebhooksListen_Errors(t *testing.T) {
+	r := onfidoCLI(nil, "", "", "webhooks", "listen", "--port", "0")
+	assert.Equal(t, 1, r.code)
+	assert.Contains(t, r.stderr, onfido.WebhookTokenEnv)
+
+	r = onfidoCLI(nil, "", "", "webhooks", "listen", "--port", "0", "--webhook-token", "x", "--fetch")
+	assert.Equal(t, 1, r.code)
+	assert.Contains(t, r.stderr, "--fetch needs an API token")
+}
@@ -0,0 +1,291 @@
+// Command onfido runs everyday operations against the Onfido API.
+//
+// Usage:
+//
+//	onfido [flags] <resource> <command> [flags] [args]
+//
+// The API token is read from the ONFIDO_TOKEN environment variable. Results are
+// printed as a table by default, or as JSON or YAML with --output. Commands
+// which delete, cancel or update resources refuse to run with a
+// production token unless --yes is given.
+//
+// For example:
+//
+//	export ONFIDO_TOKEN=api_sandbox.xxx
+//	onfido applicants create --first-name Jane --last-name Doe
+//	onfido documents upload --applicant <id> --type passport passport.jpg
+//	onfido checks create --applicant <id> --reports document
+//	onfido checks wait <check-id> --output json
+//	onfido reports export --format csv --checkpoint export.checkpoint --out reports.csv
+//	onfido webhooks listen --port 8080 --fetch --forward-to http://localhost:3000/webhooks
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/mbowman100/go-onfido"
+)
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	code := run(ctx, os.Args[1:], os.Stdin, os.Stdout, os.Stderr, os.Getenv)
+	stop()
+	os.Exit(code)
+}
+
+// errUsage is returned when the command line is invalid, its usage has already been printed.
+var errUsage = errors.New("invalid usage")
+
+// command is a verb of a resource, e.g. "applicants list".
+type command struct {
+	resource string
+	verb     string
+	args     string
+	summary  string
+	// destructive commands need --yes to run with a production token.
+	destructive bool
+	// offline commands don't need an API token, the client is nil without one.
+	offline bool
+	run     func(e *env, args []string) error
+}
+
+func (c *command) name() string { return c.resource + " " + c.verb }
+
+var commands = []*command{
+	applicantsCreate, applicantsGet, applicantsUpdate, applicantsDelete, applicantsRestore, applicantsList,
+	documentsUpload, documentsDownload, documentsList,
+	checksCreate, checksGet, checksWait, checksList,
+	reportsGet, reportsResume, reportsCancel, reportsExport,
+	webhooksList, webhooksCreate, webhooksUpdate, webhooksDelete, webhooksListen,
+	sdkTokenCreate,
+}
+
+// env is the environment a command runs in.
+type env struct {
+	ctx    context.Context
+	stdin  io.Reader
+	stdout io.Writer
+	stderr io.Writer
+	getenv func(string) string
+
+	cmd *command
+	// global flags, which can be given before or after the command
+	output   string
+	yes      bool
+	endpoint string
+
+	client onfido.OnfidoClient
+	out    *printer
+}
+
+func (e *env) globalFlags(fs *flag.FlagSet) {
+	fs.StringVar(&e.output, "output", e.output, "output format: table, json or yaml")
+	fs.BoolVar(&e.yes, "yes", e.yes, "run destructive commands with a production token")
+	fs.StringVar(&e.endpoint, "endpoint", e.endpoint, "API endpoint, e.g. https://api.us.onfido.com/v3.5")
+}
+
+// flagSet returns the flag set of the current command, with the global flags.
+func (e *env) flagSet() *flag.FlagSet {
+	fs := flag.NewFlagSet("onfido "+e.cmd.name(), flag.ContinueOnError)
+	fs.SetOutput(e.stderr)
+	fs.Usage = func() {
+		fmt.Fprintf(e.stderr, "Usage: onfido %s [flags] %s\n\n%s\n\nFlags:\n", e.cmd.name(), e.cmd.args, e.cmd.summary)
+		fs.PrintDefaults()
+	}
+	e.globalFlags(fs)
+	return fs
+}
+
+// parse parses the command's flags, which may be interspersed with its
+// arguments, checks it gets nargs arguments (any number if negative) and
+// creates the client. It returns the arguments.
+func (e *env) parse(fs *flag.FlagSet, args []string, nargs int) ([]string, error) {
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			// The flag set has printed the error and usage.
+			return nil, errUsage
+		}
+		args = fs.Args()
+		if len(args) == 0 {
+			break
+		}
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+	if nargs >= 0 && len(positional) != nargs {
+		fs.Usage()
+		return nil, errUsage
+	}
+
+	out, err := newPrinter(e.output, e.stdout)
+	if err != nil {
+		return nil, err
+	}
+	e.out = out
+
+	token := e.getenv(onfido.TokenEnv)
+	if token == "" && e.cmd.offline {
+		return positional, nil
+	}
+	if token == "" {
+		return nil, fmt.Errorf("onfido token not found in environmental variable `%s`", onfido.TokenEnv)
+	}
+	var opts []onfido.ClientOption
+	if e.endpoint != "" {
+		opts = append(opts, onfido.WithEndpoint(e.endpoint))
+	}
+	e.client = onfido.NewClient(token, opts...)
+
+	if e.cmd.destructive && e.client.Token().Prod() && !e.yes {
+		return nil, fmt.Errorf("refusing to run %q with a production token, use --yes to confirm", e.cmd.name())
+	}
+	return positional, nil
+}
+
+// logf prints a progress or confirmation message, which isn't part of the output.
+func (e *env) logf(format string, args ...interface{}) {
+	fmt.Fprintf(e.stderr, format+"\n", args...)
+}
+
+func run(ctx context.Context, args []string, stdin io.Reader, stdout, stderr io.Writer, getenv func(string) string) int {
+	e := &env{
+		ctx:    ctx,
+		stdin:  stdin,
+		stdout: stdout,
+		stderr: stderr,
+		getenv: getenv,
+		output: formatTable,
+	}
+
+	fs := flag.NewFlagSet("onfido", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.Usage = func() { usage(stderr, fs) }
+	e.globalFlags(fs)
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	args = fs.Args()
+	if len(args) == 0 {
+		fs.Usage()
+		return 2
+	}
+	if len(args) == 1 {
+		fmt.Fprintf(stderr, "onfido: missing command for %s\n", args[0])
+		printCommands(stderr, args[0])
+		return 2
+	}
+
+	for _, c := range commands {
+		if c.resource == args[0] && c.verb == args[1] {
+			e.cmd = c
+			break
+		}
+	}
+	if e.cmd == nil {
+		fmt.Fprintf(stderr, "onfido: unknown command %q\n", strings.Join(args[:2], " "))
+		printCommands(stderr, args[0])
+		return 2
+	}
+
+	if err := e.cmd.run(e, args[2:]); err != nil {
+		if errors.Is(err, errUsage) {
+			return 2
+		}
+		fmt.Fprintf(stderr, "onfido: %v\n", err)
+		return 1
+	}
+	return 0
+}
+
+func usage(w io.Writer, fs *flag.FlagSet) {
+	fmt.Fprintf(w, "Usage: onfido [flags] <resource> <command> [flags] [args]\n\n")
+	fmt.Fprintf(w, "The API token is read from %s.\n\nCommands:\n", onfido.TokenEnv)
+	printCommands(w, "")
+	fmt.Fprintf(w, "\nFlags:\n")
+	fs.PrintDefaults()
+}
+
+// printCommands lists the commands of resource, or all of them if it's unknown.
+func printCommands(w io.Writer, resource string) {
+	var list []*command
+	for _, c := range commands {
+		if c.resource == resource {
+			list = append(list, c)
+		}
+	}
+	if len(list) == 0 {
+		list = append(list, commands...)
+	}
+	sort.SliceStable(list, func(i, j int) bool { return list[i].resource < list[j].resource })
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	for _, c := range list {
+		fmt.Fprintf(tw, "  %s %s\t%s\n", c.name(), c.args, c.summary)
+	}
+	tw.Flush()
+}
+
+// limitFlag adds the --limit flag of list commands.
+func limitFlag(fs *flag.FlagSet) *int {
+	return fs.Int("limit", 100, "maximum number of items to list, 0 for all")
+}
+
+// collect returns up to limit items of the iterator, or all of them if limit is 0.
+func collect(ctx context.Context, it onfido.Iter, limit int) ([]interface{}, error) {
+	items := []interface{}{}
+	for (limit <= 0 || len(items) < limit) && it.Next(ctx) {
+		items = append(items, it.Current())
+	}
+	return items, it.Err()
+}
+
+// readJSON decodes the JSON file at path, or stdin if it's "-", into v.
+func (e *env) readJSON(path string, v interface{}) error {
+	r := e.stdin
+	if path != "-" {
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		r = f
+	}
+	dec := json.NewDecoder(r)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(v); err != nil {
+		return fmt.Errorf("failed to decode %s: %w", path, err)
+	}
+	return nil
+}
+
+// isSet reports whether the flag name was given on the command line.
+func isSet(fs *flag.FlagSet, name string) bool {
+	set := false
+	fs.Visit(func(f *flag.Flag) {
+		if f.Name == name {
+			set = true
+		}
+	})
+	return set
+}
+
+// splitList splits a comma separated flag value, e.g. --tags a,b.
+func splitList(s string) []string {
+	var list []string
+	for _, v := range strings.Split(s, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			list = append(list, v)
+		}
+	}
+	return list
+}
@@ -0,0 +1,137 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/mbowman100/go-onfido"
+	"github.com/mbowman100/go-onfido/onfidotest"
+	"github.com/stretchr/testify/assert"
+)
+
+type result struct {
+	code   int
+	stdout string
+	stderr string
+}
+
+// onfidoCLI runs the command line against the server with the token, and stdin if any.
+func onfidoCLI(srv *onfidotest.Server, token, stdin string, args ...string) result {
+	var stdout, stderr bytes.Buffer
+	getenv := func(key string) string {
+		if key == onfido.TokenEnv {
+			return token
+		}
+		return ""
+	}
+	if srv != nil {
+		args = append([]string{"--endpoint", srv.Endpoint()}, args...)
+	}
+	code := run(context.Background(), args, strings.NewReader(stdin), &stdout, &stderr, getenv)
+	return result{code: code, stdout: stdout.String(), stderr: stderr.String()}
+}
+
+// decode decodes the JSON output of a command into v.
+func decode(t *testing.T, r result, v interface{}) {
+	t.Helper()
+	if r.code != 0 {
+		t.Fatalf("exit code %d: %s", r.code, r.stderr)
+	}
+	if err := json.Unmarshal([]byte(r.stdout), v); err != nil {
+		t.Fatalf("invalid JSON output %q: %v", r.stdout, err)
+	}
+}
+
+func TestRun_Usage(t *testing.T) {
+	r := onfidoCLI(nil, onfidotest.Token, "")
+	assert.Equal(t, 2, r.code)
+	assert.Contains(t, r.stderr, "applicants create")
+	assert.Contains(t, r.stderr, "webhooks delete")
+
+	r = onfidoCLI(nil, onfidotest.Token, "", "applicants")
+	assert.Equal(t, 2, r.code)
+	assert.Contains(t, r.stderr, "applicants restore")
+	assert.NotContains(t, r.stderr, "webhooks delete")
+
+	r = onfidoCLI(nil, onfidotest.Token, "", "applicants", "explode")
+	assert.Equal(t, 2, r.code)
+	assert.Contains(t, r.stderr, `unknown command "applicants explode"`)
+
+	r = onfidoCLI(nil, onfidotest.Token, "", "applicants", "get")
+	assert.Equal(t, 2, r.code, "missing argument")
+	assert.Contains(t, r.stderr, "Usage: onfido applicants get")
+
+	r = onfidoCLI(nil, onfidotest.Token, "", "applicants", "get", "--nope", "id")
+	assert.Equal(t, 2, r.code, "unknown flag")
+}
+
+func TestRun_Errors(t *testing.T) {
+	srv := onfidotest.NewServer()
+	defer srv.Close()
+
+	r := onfidoCLI(srv, "", "", "applicants", "list")
+	assert.Equal(t, 1, r.code)
+	assert.Contains(t, r.stderr, onfido.TokenEnv)
+
+	r = onfidoCLI(srv, onfidotest.Token, "", "applicants", "list", "--output", "xml")
+	assert.Equal(t, 1, r.code)
+	assert.Contains(t, r.stderr, `unknown output format "xml"`)
+
+	r = onfidoCLI(srv, onfidotest.Token, "", "applicants", "get", "unknown")
+	assert.Equal(t, 1, r.code)
+	assert.Contains(t, r.stderr, "onfido: ")
+}
+
+func TestRun_ProductionToken(t *testing.T) {
+	srv := onfidotest.NewServer()
+	defer srv.Close()
+	a, err := srv.Client().CreateApplicant(context.Background(), onfido.Applicant{FirstName: "Jane", LastName: "Doe"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const prodToken = "api_live.xxx"
+	r := onfidoCLI(srv, prodToken, "", "applicants", "delete", a.ID)
+	assert.Equal(t, 1, r.code)
+	assert.Contains(t, r.stderr, "refusing to run")
+	_, err = srv.Client().GetApplicant(context.Background(), a.ID)
+	assert.NoError(t, err, "applicant shouldn't be deleted")
+
+	r = onfidoCLI(srv, prodToken, "", "applicants", "update", a.ID, "--email", "john@example.com")
+	assert.Equal(t, 1, r.code)
+	assert.Contains(t, r.stderr, "refusing to run")
+
+	r = onfidoCLI(srv, prodToken, "", "applicants", "get", a.ID)
+	assert.Equal(t, 0, r.code, "only destructive commands are refused: %s", r.stderr)
+
+	r = onfidoCLI(srv, prodToken, "", "--yes", "applicants", "delete", a.ID)
+	assert.Equal(t, 0, r.code, r.stderr)
+	_, err = srv.Client().GetApplicant(context.Background(), a.ID)
+	assert.Error(t, err)
+
+	r = onfidoCLI(srv, onfidotest.Token, "", "reports", "cancel", "id", "--yes=false")
+	assert.NotContains(t, r.stderr, "refusing to run", "sandbox tokens don't need --yes")
+}
+
+func TestRun_InterspersedFlags(t *testing.T) {
+	srv := onfidotest.NewServer()
+	defer srv.Close()
+
+	r := onfidoCLI(srv, onfidotest.Token, "", "applicants", "create", "--first-name", "Jane", "--output", "json", "--last-name", "Doe")
+	var a onfido.Applicant
+	decode(t, r, &a)
+	assert.Equal(t, "Jane", a.FirstName)
+
+	r = onfidoCLI(srv, onfidotest.Token, "", "applicants", "get", a.ID, "--output", "json")
+	var got onfido.Applicant
+	decode(t, r, &got)
+	assert.Equal(t, a.ID, got.ID)
+}
+
+func TestSplitList(t *testing.T) {
+	assert.Equal(t, []string{"a", "b"}, splitList(" a, ,b,"))
+	assert.Nil(t, splitList(""))
+}
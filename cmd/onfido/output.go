@@ -0,0 +1,265 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Output formats
+const (
+	formatTable = "table"
+	formatJSON  = "json"
+	formatYAML  = "yaml"
+)
+
+// printer prints API resources in the output format.
+// Values are printed as their JSON encoding, so fields are named as in the API.
+type printer struct {
+	format string
+	w      io.Writer
+}
+
+func newPrinter(format string, w io.Writer) (*printer, error) {
+	switch format {
+	case formatTable, formatJSON, formatYAML:
+		return &printer{format: format, w: w}, nil
+	}
+	return nil, fmt.Errorf("unknown output format %q, expected table, json or yaml", format)
+}
+
+// object prints a single resource, as one row per field in a table.
+func (p *printer) object(v interface{}) error {
+	if p.format == formatJSON {
+		return p.json(v)
+	}
+	ov, err := decodeOrdered(v)
+	if err != nil {
+		return err
+	}
+	if p.format == formatYAML {
+		return p.yaml(ov)
+	}
+
+	obj, ok := ov.(object)
+	if !ok {
+		_, err := fmt.Fprintln(p.w, cell(ov))
+		return err
+	}
+	tw := tabwriter.NewWriter(p.w, 0, 4, 2, ' ', 0)
+	for _, f := range obj {
+		fmt.Fprintf(tw, "%s:\t%s\n", f.key, cell(f.value))
+	}
+	return tw.Flush()
+}
+
+// list prints a list of resources, a slice, with the given columns in a table.
+// Columns are the JSON names of the fields.
+func (p *printer) list(columns []string, items interface{}) error {
+	if p.format == formatJSON {
+		return p.json(items)
+	}
+	lv, err := decodeOrdered(items)
+	if err != nil {
+		return err
+	}
+	if p.format == formatYAML {
+		return p.yaml(lv)
+	}
+
+	rows, _ := lv.([]interface{})
+	tw := tabwriter.NewWriter(p.w, 0, 4, 2, ' ', 0)
+	headers := make([]string, len(columns))
+	for i, c := range columns {
+		headers[i] = strings.ToUpper(strings.ReplaceAll(c, "_", " "))
+	}
+	fmt.Fprintln(tw, strings.Join(headers, "\t"))
+	for _, row := range rows {
+		obj, _ := row.(object)
+		cells := make([]string, len(columns))
+		for i, c := range columns {
+			v, _ := obj.get(c)
+			cells[i] = cell(v)
+		}
+		fmt.Fprintln(tw, strings.Join(cells, "\t"))
+	}
+	return tw.Flush()
+}
+
+func (p *printer) json(v interface{}) error {
+	enc := json.NewEncoder(p.w)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+func (p *printer) yaml(v interface{}) error {
+	enc := yaml.NewEncoder(p.w)
+	enc.SetIndent(2)
+	if err := enc.Encode(yamlNode(v)); err != nil {
+		return err
+	}
+	return enc.Close()
+}
+
+// field is a field of a decoded JSON object.
+type field struct {
+	key   string
+	value interface{}
+}
+
+// object is a decoded JSON object, which keeps the order of its fields.
+type object []field
+
+func (o object) get(key string) (interface{}, bool) {
+	for _, f := range o {
+		if f.key == key {
+			return f.value, true
+		}
+	}
+	return nil, false
+}
+
+// decodeOrdered returns the JSON encoding of v decoded into objects,
+// []interface{}, string, json.Number, bool and nil values.
+func decodeOrdered(v interface{}) (interface{}, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	return decodeValue(dec)
+}
+
+func decodeValue(dec *json.Decoder) (interface{}, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+	switch tok {
+	case json.Delim('{'):
+		obj := object{}
+		for dec.More() {
+			key, err := dec.Token()
+			if err != nil {
+				return nil, err
+			}
+			value, err := decodeValue(dec)
+			if err != nil {
+				return nil, err
+			}
+			obj = append(obj, field{key: key.(string), value: value})
+		}
+		_, err = dec.Token()
+		return obj, err
+	case json.Delim('['):
+		arr := []interface{}{}
+		for dec.More() {
+			value, err := decodeValue(dec)
+			if err != nil {
+				return nil, err
+			}
+			arr = append(arr, value)
+		}
+		_, err = dec.Token()
+		return arr, err
+	}
+	return tok, nil
+}
+
+// cell formats a value in a table cell: lists of scalars are comma
+// separated and objects are compact JSON.
+func cell(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	case bool:
+		return strconv.FormatBool(v)
+	case []interface{}:
+		s := make([]string, len(v))
+		for i, e := range v {
+			switch e.(type) {
+			case object, []interface{}:
+				return compactJSON(v)
+			}
+			s[i] = cell(e)
+		}
+		return strings.Join(s, ",")
+	}
+	return compactJSON(v)
+}
+
+func compactJSON(v interface{}) string {
+	var b strings.Builder
+	writeJSON(&b, v)
+	return b.String()
+}
+
+func writeJSON(w io.Writer, v interface{}) {
+	switch v := v.(type) {
+	case object:
+		io.WriteString(w, "{")
+		for i, f := range v {
+			if i > 0 {
+				io.WriteString(w, ",")
+			}
+			writeJSON(w, f.key)
+			io.WriteString(w, ":")
+			writeJSON(w, f.value)
+		}
+		io.WriteString(w, "}")
+	case []interface{}:
+		io.WriteString(w, "[")
+		for i, e := range v {
+			if i > 0 {
+				io.WriteString(w, ",")
+			}
+			writeJSON(w, e)
+		}
+		io.WriteString(w, "]")
+	default:
+		b, _ := json.Marshal(v)
+		w.Write(b)
+	}
+}
+
+// yamlNode returns the YAML node of a value returned by decodeOrdered, which
+// keeps the order of the fields of objects. Strings are encoded by yaml, so
+// they're quoted when they could be mistaken for another type, including
+// YAML 1.1 booleans such as yes.
+func yamlNode(v interface{}) *yaml.Node {
+	switch v := v.(type) {
+	case object:
+		n := &yaml.Node{Kind: yaml.MappingNode}
+		for _, f := range v {
+			n.Content = append(n.Content, yamlNode(f.key), yamlNode(f.value))
+		}
+		return n
+	case []interface{}:
+		n := &yaml.Node{Kind: yaml.SequenceNode}
+		for _, e := range v {
+			n.Content = append(n.Content, yamlNode(e))
+		}
+		return n
+	case string:
+		var n yaml.Node
+		n.Encode(v)
+		return &n
+	case json.Number:
+		return &yaml.Node{Kind: yaml.ScalarNode, Value: v.String()}
+	case bool:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!bool", Value: strconv.FormatBool(v)}
+	}
+	return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!null", Value: "null"}
+}
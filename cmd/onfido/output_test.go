@@ -0,0 +1,118 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+)
+
+type sample struct {
+	ID      string                 `json:"id"`
+	Name    string                 `json:"name,omitempty"`
+	Count   int                    `json:"count"`
+	OK      bool                   `json:"ok"`
+	Tags    []string               `json:"tags"`
+	Nested  map[string]interface{} `json:"nested,omitempty"`
+	Items   []map[string]string    `json:"items,omitempty"`
+	Missing *string                `json:"missing"`
+}
+
+func print(t *testing.T, format string, f func(p *printer) error) string {
+	t.Helper()
+	var buf bytes.Buffer
+	p, err := newPrinter(format, &buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := f(p); err != nil {
+		t.Fatal(err)
+	}
+	return buf.String()
+}
+
+func TestPrinter_Object(t *testing.T) {
+	v := sample{
+		ID:     "123",
+		Count:  2,
+		OK:     true,
+		Tags:   []string{"a", "b"},
+		Nested: map[string]interface{}{"result": "clear", "empty": map[string]interface{}{}},
+		Items:  []map[string]string{{"k": "v", "x": "yes"}},
+	}
+
+	assert.Equal(t, `id:       123
+count:    2
+ok:       true
+tags:     a,b
+nested:   {"empty":{},"result":"clear"}
+items:    [{"k":"v","x":"yes"}]
+missing:  
+`, print(t, formatTable, func(p *printer) error { return p.object(v) }))
+
+	assert.Equal(t, `id: "123"
+count: 2
+ok: true
+tags:
+  - a
+  - b
+nested:
+  empty: {}
+  result: clear
+items:
+  - k: v
+    x: "yes"
+missing: null
+`, print(t, formatYAML, func(p *printer) error { return p.object(v) }))
+
+	assert.Equal(t, "{\n  \"id\": \"<1>\",\n  \"count\": 0,\n  \"ok\": false,\n  \"tags\": null,\n  \"missing\": null\n}\n",
+		print(t, formatJSON, func(p *printer) error { return p.object(sample{ID: "<1>"}) }))
+}
+
+func TestPrinter_List(t *testing.T) {
+	items := []interface{}{
+		&sample{ID: "1", Name: "first", Tags: []string{"a"}},
+		&sample{ID: "2", Count: 10},
+	}
+
+	assert.Equal(t, `ID  NAME   COUNT
+1   first  0
+2          10
+`, print(t, formatTable, func(p *printer) error { return p.list([]string{"id", "name", "count"}, items) }))
+
+	assert.Equal(t, "ID\n", print(t, formatTable, func(p *printer) error { return p.list([]string{"id"}, []interface{}{}) }))
+	assert.Equal(t, "[]\n", print(t, formatYAML, func(p *printer) error { return p.list([]string{"id"}, []interface{}{}) }))
+
+	assert.Equal(t, `- id: "1"
+  name: first
+  count: 0
+  ok: false
+  tags:
+    - a
+  missing: null
+- id: "2"
+  count: 10
+  ok: false
+  tags: []
+  missing: null
+`, print(t, formatYAML, func(p *printer) error {
+		return p.list(nil, []interface{}{items[0], &sample{ID: "2", Count: 10, Tags: []string{}}})
+	}))
+}
+
+func TestPrinter_YAMLStrings(t *testing.T) {
+	for _, s := range []string{
+		"clear", "check.completed", "https://example.com/x", "1b2c3d4e-0000-4000-8000-1",
+		"2024-01-31T10:00:00Z", "1e3", "0x1F", "", "Yes", "null", "a: b", "a #b", "-1",
+		"*alias", "two\nlines", "Jane Doe", "trailing ",
+	} {
+		out := print(t, formatYAML, func(p *printer) error { return p.object(s) })
+		var decoded interface{}
+		if err := yaml.Unmarshal([]byte(out), &decoded); err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, s, decoded, "strings are printed as YAML strings: %s", out)
+	}
+	assert.Equal(t, "clear\n", print(t, formatYAML, func(p *printer) error { return p.object("clear") }))
+}
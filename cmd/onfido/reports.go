@@ -0,0 +1,56 @@
+package main
+
+var reportsGet = &command{
+	resource: "reports",
+	verb:     "get",
+	args:     "<report-id>",
+	summary:  "Retrieve a report, with its breakdown.",
+	run: func(e *env, args []string) error {
+		args, err := e.parse(e.flagSet(), args, 1)
+		if err != nil {
+			return err
+		}
+		r, err := e.client.GetReport(e.ctx, args[0])
+		if err != nil {
+			return err
+		}
+		return e.out.object(r)
+	},
+}
+
+var reportsResume = &command{
+	resource: "reports",
+	verb:     "resume",
+	args:     "<report-id>",
+	summary:  "Resume a paused report.",
+	run: func(e *env, args []string) error {
+		args, err := e.parse(e.flagSet(), args, 1)
+		if err != nil {
+			return err
+		}
+		if err := e.client.ResumeReport(e.ctx, args[0]); err != nil {
+			return err
+		}
+		e.logf("report %s resumed", args[0])
+		return nil
+	},
+}
+
+var reportsCancel = &command{
+	resource:    "reports",
+	verb:        "cancel",
+	args:        "<report-id>",
+	summary:     "Cancel a report which isn't complete.",
+	destructive: true,
+	run: func(e *env, args []string) error {
+		args, err := e.parse(e.flagSet(), args, 1)
+		if err != nil {
+			return err
+		}
+		if err := e.client.CancelReport(e.ctx, args[0]); err != nil {
+			return err
+		}
+		e.logf("report %s cancelled", args[0])
+		return nil
+	},
+}
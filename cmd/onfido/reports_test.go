@@ -0,0 +1,47 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mbowman100/go-onfido"
+	"github.com/mbowman100/go-onfido/onfidotest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReports(t *testing.T) {
+	srv := onfidotest.NewServer(onfidotest.WithCheckDelay(-1))
+	defer srv.Close()
+	c := srv.Client()
+	a, err := c.CreateApplicant(context.Background(), onfido.Applicant{FirstName: "Jane", LastName: "Doe"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	chk, err := c.CreateCheck(context.Background(), onfido.CheckRequest{
+		ApplicantID: a.ID,
+		ReportNames: []onfido.ReportName{onfido.ReportNameWatchlistStandard},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	it := c.ListReports(chk.ID)
+	if !it.Next(context.Background()) {
+		t.Fatal("check has no reports", it.Err())
+	}
+	id := it.Report().ID
+
+	var rep onfido.Report
+	decode(t, onfidoCLI(srv, onfidotest.Token, "", "reports", "get", id, "--output", "json"), &rep)
+	assert.Equal(t, onfido.ReportNameWatchlistStandard, rep.Name)
+	assert.Equal(t, chk.ID, rep.CheckID)
+
+	r := onfidoCLI(srv, onfidotest.Token, "", "reports", "resume", id)
+	assert.Equal(t, 1, r.code)
+	assert.Contains(t, r.stderr, "Report is not paused")
+
+	r = onfidoCLI(srv, onfidotest.Token, "", "reports", "cancel", id)
+	assert.Equal(t, 0, r.code, r.stderr)
+	assert.Contains(t, r.stderr, "cancelled")
+	decode(t, onfidoCLI(srv, onfidotest.Token, "", "reports", "get", id, "--output", "json"), &rep)
+	assert.Equal(t, "cancelled", rep.Status)
+}
@@ -0,0 +1,34 @@
+package main
+
+import "github.com/mbowman100/go-onfido"
+
+var sdkTokenCreate = &command{
+	resource: "sdk-token",
+	verb:     "create",
+	args:     "<applicant-id>",
+	summary:  "Generate an SDK token for the web SDK (--referrer) or the mobile SDKs (--application-id).",
+	run: func(e *env, args []string) error {
+		fs := e.flagSet()
+		referrer := fs.String("referrer", "", "referrer `pattern` of the web SDK, e.g. https://*.example.com/*")
+		applicationID := fs.String("application-id", "", "application `id` of the mobile SDKs, e.g. com.example.app")
+		args, err := e.parse(fs, args, 1)
+		if err != nil {
+			return err
+		}
+		if (*referrer == "") == (*applicationID == "") {
+			fs.Usage()
+			return errUsage
+		}
+
+		var tok *onfido.SdkToken
+		if *referrer != "" {
+			tok, err = e.client.NewSdkTokenWeb(e.ctx, args[0], *referrer)
+		} else {
+			tok, err = e.client.NewSdkTokenMobile(e.ctx, args[0], *applicationID)
+		}
+		if err != nil {
+			return err
+		}
+		return e.out.object(tok)
+	},
+}
@@ -0,0 +1,30 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mbowman100/go-onfido"
+	"github.com/mbowman100/go-onfido/onfidotest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSdkTokenCreate(t *testing.T) {
+	srv := onfidotest.NewServer()
+	defer srv.Close()
+	a, err := srv.Client().CreateApplicant(context.Background(), onfido.Applicant{FirstName: "Jane", LastName: "Doe"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := onfidoCLI(srv, onfidotest.Token, "", "sdk-token", "create", a.ID)
+	assert.Equal(t, 2, r.code, "--referrer or --application-id is required")
+
+	var tok onfido.SdkToken
+	decode(t, onfidoCLI(srv, onfidotest.Token, "", "sdk-token", "create", a.ID, "--referrer", "https://*.example.com/*", "--output", "json"), &tok)
+	assert.NotEmpty(t, tok.Token)
+	assert.Equal(t, "https://*.example.com/*", tok.Referrer)
+
+	decode(t, onfidoCLI(srv, onfidotest.Token, "", "sdk-token", "create", a.ID, "--application-id", "com.example.app", "--output", "json"), &tok)
+	assert.Equal(t, "com.example.app", tok.ApplicationID)
+}
@@ -0,0 +1,163 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+
+	"github.com/mbowman100/go-onfido"
+)
+
+var webhookColumns = []string{"id", "url", "enabled", "environments", "events"}
+
+// webhookFlags adds the flags setting the fields of a webhook, which apply
+// sets on wr, after the fields read from --from-file.
+func webhookFlags(e *env, fs *flag.FlagSet) (apply func(wr *onfido.WebhookRefRequest) error) {
+	fromFile := fs.String("from-file", "", "read the webhook from a JSON `file`, - for stdin")
+	url := fs.String("url", "", "HTTPS URL receiving the events")
+	enabled := fs.Bool("enabled", true, "whether events are sent to the webhook")
+	events := fs.String("events", "", "comma separated events, e.g. check.completed,report.completed, defaults to all")
+	environments := fs.String("environments", "", "comma separated environments: sandbox, live, defaults to both")
+	return func(wr *onfido.WebhookRefRequest) error {
+		if *fromFile != "" {
+			if err := e.readJSON(*fromFile, wr); err != nil {
+				return err
+			}
+		}
+		if isSet(fs, "url") {
+			wr.URL = *url
+		}
+		if isSet(fs, "enabled") {
+			wr.Enabled = *enabled
+		}
+		if isSet(fs, "events") {
+			wr.Events = nil
+			for _, ev := range splitList(*events) {
+				wr.Events = append(wr.Events, onfido.WebhookEvent(ev))
+			}
+		}
+		if isSet(fs, "environments") {
+			wr.Environments = nil
+			for _, env := range splitList(*environments) {
+				wr.Environments = append(wr.Environments, onfido.WebhookEnvironment(env))
+			}
+		}
+		return nil
+	}
+}
+
+var webhooksList = &command{
+	resource: "webhooks",
+	verb:     "list",
+	summary:  "List webhooks.",
+	run: func(e *env, args []string) error {
+		fs := e.flagSet()
+		limit := limitFlag(fs)
+		if _, err := e.parse(fs, args, 0); err != nil {
+			return err
+		}
+		items, err := collect(e.ctx, e.client.ListWebhooks(), *limit)
+		if err != nil {
+			return err
+		}
+		return e.out.list(webhookColumns, items)
+	},
+}
+
+var webhooksCreate = &command{
+	resource: "webhooks",
+	verb:     "create",
+	summary:  "Register a webhook, its token is printed once.",
+	run: func(e *env, args []string) error {
+		fs := e.flagSet()
+		apply := webhookFlags(e, fs)
+		if _, err := e.parse(fs, args, 0); err != nil {
+			return err
+		}
+
+		wr := onfido.WebhookRefRequest{Enabled: true}
+		if err := apply(&wr); err != nil {
+			return err
+		}
+		if wr.URL == "" {
+			fs.Usage()
+			return errUsage
+		}
+		wh, err := e.client.CreateWebhook(e.ctx, wr)
+		if err != nil {
+			return err
+		}
+		return e.out.object(wh)
+	},
+}
+
+var webhooksUpdate = &command{
+	resource: "webhooks",
+	verb:     "update",
+	args:     "<webhook-id>",
+	summary:  "Update the given fields of a webhook.",
+	// Disabling a webhook, or changing its URL, events or environments, stops
+	// the deliveries its receiver relies on.
+	destructive: true,
+	run: func(e *env, args []string) error {
+		fs := e.flagSet()
+		apply := webhookFlags(e, fs)
+		args, err := e.parse(fs, args, 1)
+		if err != nil {
+			return err
+		}
+
+		// The whole webhook is sent, so fields which aren't given are
+		// kept from the current webhook.
+		current, err := findWebhook(e, args[0])
+		if err != nil {
+			return err
+		}
+		wr := onfido.WebhookRefRequest{
+			URL:          current.URL,
+			Enabled:      current.Enabled,
+			Environments: current.Environments,
+			Events:       current.Events,
+		}
+		if err := apply(&wr); err != nil {
+			return err
+		}
+		wh, err := e.client.UpdateWebhook(e.ctx, args[0], wr)
+		if err != nil {
+			return err
+		}
+		return e.out.object(wh)
+	},
+}
+
+// findWebhook returns the webhook from the list, the client can't retrieve a single webhook.
+func findWebhook(e *env, id string) (*onfido.WebhookRef, error) {
+	it := e.client.ListWebhooks()
+	for it.Next(e.ctx) {
+		if wh := it.WebhookRef(); wh.ID == id {
+			return wh, nil
+		}
+	}
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+	return nil, fmt.Errorf("webhook %s not found", id)
+}
+
+var webhooksDelete = &command{
+	resource:    "webhooks",
+	verb:        "delete",
+	args:        "<webhook-id>",
+	summary:     "Delete a webhook.",
+	destructive: true,
+	run: func(e *env, args []string) error {
+		args, err := e.parse(e.flagSet(), args, 1)
+		if err != nil {
+			return err
+		}
+		if err := e.client.DeleteWebhook(e.ctx, args[0]); err != nil {
+			return err
+		}
+		e.logf("webhook %s deleted", args[0])
+		return nil
+	},
+}
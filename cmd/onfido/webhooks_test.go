@@ -0,0 +1,53 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mbowman100/go-onfido"
+	"github.com/mbowman100/go-onfido/onfidotest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebhooks(t *testing.T) {
+	srv := onfidotest.NewServer()
+	defer srv.Close()
+
+	r := onfidoCLI(srv, onfidotest.Token, "", "webhooks", "create")
+	assert.Equal(t, 2, r.code, "--url is required")
+
+	var wh onfido.WebhookRef
+	decode(t, onfidoCLI(srv, onfidotest.Token, "", "webhooks", "create", "--url", "https://example.com/hook",
+		"--events", "check.completed,report.completed", "--output", "json"), &wh)
+	assert.True(t, wh.Enabled)
+	assert.NotEmpty(t, wh.Token)
+	assert.Equal(t, []onfido.WebhookEvent{onfido.WebhookEventCheckCompleted, onfido.WebhookEventReportCompleted}, wh.Events)
+
+	var updated onfido.WebhookRef
+	decode(t, onfidoCLI(srv, onfidotest.Token, "", "webhooks", "update", wh.ID, "--enabled=false", "--output", "json"), &updated)
+	assert.False(t, updated.Enabled)
+	assert.Equal(t, "https://example.com/hook", updated.URL, "fields which aren't given are kept")
+	assert.Equal(t, wh.Events, updated.Events)
+
+	r = onfidoCLI(srv, "api_live.xxx", "", "webhooks", "update", wh.ID, "--enabled=true")
+	assert.Equal(t, 1, r.code)
+	assert.Contains(t, r.stderr, "refusing to run")
+	webhooks, err := collect(context.Background(), srv.Client().ListWebhooks(), 0)
+	if assert.NoError(t, err) && assert.Len(t, webhooks, 1) {
+		assert.False(t, webhooks[0].(*onfido.WebhookRef).Enabled, "webhook shouldn't be updated without --yes")
+	}
+
+	r = onfidoCLI(srv, onfidotest.Token, "", "webhooks", "update", "unknown", "--enabled")
+	assert.Equal(t, 1, r.code)
+	assert.Contains(t, r.stderr, "webhook unknown not found")
+
+	r = onfidoCLI(srv, onfidotest.Token, "", "webhooks", "list")
+	assert.Equal(t, 0, r.code, r.stderr)
+	assert.Contains(t, r.stdout, "https://example.com/hook")
+	assert.Contains(t, r.stdout, "check.completed,report.completed")
+
+	r = onfidoCLI(srv, onfidotest.Token, "", "webhooks", "delete", wh.ID)
+	assert.Equal(t, 0, r.code, r.stderr)
+	r = onfidoCLI(srv, onfidotest.Token, "", "webhooks", "list", "--output", "json")
+	assert.Equal(t, "[]\n", r.stdout)
+}
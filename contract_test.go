@@ -0,0 +1,674 @@
+package onfido_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mbowman100/go-onfido"
+	"github.com/mbowman100/go-onfido/internal/openapi"
+)
+
+// The contract test calls every OnfidoClient method and compares the requests it
+// sends, and the types it decodes responses into, with the vendored OpenAPI spec.
+// Drift is written to a report which is checked in, so that fixing (or introducing)
+// drift shows up in review. Regenerate the report with:
+//
+//	go test -run TestContract . -update-contract
+var updateContract = flag.Bool("update-contract", false, "regenerate the contract drift report")
+
+var (
+	contractSpec   = filepath.Join("openapi", "onfido-v3.5.json")
+	contractSource = filepath.Join("openapi", "SOURCE")
+	contractReport = filepath.Join("testdata", "contract_report.txt")
+)
+
+// contractSkip are the methods which don't map to a single operation.
+var contractSkip = map[string]string{
+	"SetHTTPClient":    "configuration",
+	"Token":            "configuration",
+	"GetResource":      "generic href lookup",
+	"GetCheckExpanded": "composes GetCheck and GetReport",
+	"UploadAndExtract": "composes UploadDocument and ExtractDocument",
+}
+
+// contractSpecSource returns the upstream artifact the specification was vendored
+// from, recorded in openapi/SOURCE by internal/specsync.
+func contractSpecSource() string {
+	b, err := ioutil.ReadFile(contractSource)
+	if err != nil {
+		return "hand transcription, not vendored from upstream (see openapi/README.md)"
+	}
+	return strings.TrimSpace(strings.SplitN(string(b), "\n", 2)[0])
+}
+
+// contractMaxDepth bounds the nesting compared and filled in, as some types are recursive.
+const contractMaxDepth = 5
+
+func TestContract(t *testing.T) {
+	spec, err := openapi.Load(contractSpec)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rec := &capturingRequester{}
+	client := onfido.NewClient("api_sandbox.contract",
+		onfido.WithEndpoint("https://contract.test/v3.5"),
+		onfido.WithHTTPClient(rec),
+	)
+
+	var report strings.Builder
+	report.WriteString("# Contract drift between OnfidoClient and " + filepath.ToSlash(contractSpec) + "\n")
+	report.WriteString("# Specification: " + contractSpecSource() + "\n")
+	report.WriteString("# Regenerate with: go test -run TestContract . -update-contract\n")
+
+	cv := reflect.ValueOf(client)
+	ct := reflect.TypeOf((*onfido.OnfidoClient)(nil)).Elem()
+	for i := 0; i < ct.NumMethod(); i++ {
+		m := ct.Method(i)
+		report.WriteString("\n")
+		if reason, ok := contractSkip[m.Name]; ok {
+			fmt.Fprintf(&report, "%s: skipped (%s)\n", m.Name, reason)
+			continue
+		}
+
+		rec.reset()
+		results := callSample(cv.MethodByName(m.Name))
+		cc := &contractCheck{spec: spec}
+		cc.check(m.Name, rec.requests, results)
+		report.WriteString(cc.String())
+	}
+
+	got := report.String()
+	if *updateContract {
+		if err := ioutil.WriteFile(contractReport, []byte(got), 0644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	want, err := ioutil.ReadFile(contractReport)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, string(want), got, "the client drifted from the spec, review and regenerate the report with -update-contract")
+}
+
+func TestContract_Compare(t *testing.T) {
+	spec, err := openapi.Parse([]byte(`{
+  "openapi": "3.0.0",
+  "paths": {},
+  "components": {"schemas": {"Thing": {"type": "object", "properties": {
+    "id": {"type": "string"},
+    "count": {"type": "number"},
+    "tags": {"type": "array", "items": {"type": "string"}},
+    "nested": {"type": "object", "properties": {"ok": {"type": "boolean"}}}
+  }}}}
+}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	type nested struct {
+		OK    string `json:"ok"`
+		Extra int    `json:"extra"`
+	}
+	type thing struct {
+		ID     string    `json:"id"`
+		Count  int       `json:"count"`
+		Tags   []string  `json:"tags"`
+		Nested nested    `json:"nested"`
+		When   time.Time `json:"when"`
+		Hidden string    `json:"-"`
+	}
+
+	cc := &contractCheck{spec: spec}
+	cc.compareType("response", "", reflect.TypeOf(thing{}), &openapi.Schema{Ref: "#/components/schemas/Thing"}, 0)
+	sort.Strings(cc.findings)
+	assert.Equal(t, []string{
+		`response: extra field "nested.extra" (integer)`,
+		`response: extra field "when" (string)`,
+		`response: mistyped field "count": client integer, spec number`,
+		`response: mistyped field "nested.ok": client string, spec boolean`,
+	}, cc.findings)
+
+	cc = &contractCheck{spec: spec}
+	cc.compareValue("request", "", map[string]interface{}{"id": 1.0, "tags": []interface{}{"a"}, "other": true}, &openapi.Schema{Ref: "#/components/schemas/Thing"}, 0)
+	sort.Strings(cc.findings)
+	assert.Equal(t, []string{
+		`request: extra field "other" (boolean)`,
+		`request: missing field "count" (number)`,
+		`request: missing field "nested" (object)`,
+		`request: mistyped field "id": client number, spec string`,
+	}, cc.findings)
+}
+
+// capturingRequester records the requests and answers each with an empty JSON object.
+type capturingRequester struct {
+	requests []*capturedRequest
+}
+
+type capturedRequest struct {
+	*http.Request
+	body []byte
+}
+
+func (r *capturingRequester) reset() {
+	r.requests = nil
+}
+
+func (r *capturingRequester) Do(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		if body, err = ioutil.ReadAll(req.Body); err != nil {
+			return nil, err
+		}
+	}
+	r.requests = append(r.requests, &capturedRequest{Request: req, body: body})
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       ioutil.NopCloser(strings.NewReader("{}")),
+		Request:    req,
+	}, nil
+}
+
+var (
+	contextType  = reflect.TypeOf((*context.Context)(nil)).Elem()
+	writerType   = reflect.TypeOf((*io.Writer)(nil)).Elem()
+	readerType   = reflect.TypeOf(&bytes.Reader{})
+	timeType     = reflect.TypeOf(time.Time{})
+	rawJSONType  = reflect.TypeOf(json.RawMessage{})
+	unmarshaler  = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+	iterFuncs    = map[string]bool{"Next": true, "Err": true, "Current": true}
+	sampleString = "x"
+	sampleTime   = time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+)
+
+// callSample calls the method with sample arguments, advancing the returned iterator if any.
+func callSample(m reflect.Value) []reflect.Value {
+	args := make([]reflect.Value, m.Type().NumIn())
+	for i := range args {
+		args[i] = sampleValue(m.Type().In(i), 0)
+	}
+	results := m.Call(args)
+	if len(results) > 0 {
+		if it, ok := results[0].Interface().(onfido.Iter); ok {
+			it.Next(context.Background())
+		}
+	}
+	return results
+}
+
+// sampleValue returns a value of type t with every field set, so that none is omitted when encoded.
+func sampleValue(t reflect.Type, depth int) reflect.Value {
+	v := reflect.New(t).Elem()
+	switch {
+	case t == contextType:
+		return reflect.ValueOf(context.Background())
+	case t == writerType:
+		return reflect.ValueOf(ioutil.Discard)
+	case t.Kind() == reflect.Interface && t.NumMethod() > 0 && readerType.Implements(t):
+		return reflect.ValueOf(bytes.NewReader([]byte(sampleString)))
+	case t == timeType:
+		return reflect.ValueOf(sampleTime)
+	case depth > contractMaxDepth:
+		return v
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		v.SetString(sampleString)
+	case reflect.Bool:
+		v.SetBool(true)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v.SetInt(1)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		v.SetUint(1)
+	case reflect.Float32, reflect.Float64:
+		v.SetFloat(1)
+	case reflect.Ptr:
+		p := reflect.New(t.Elem())
+		p.Elem().Set(sampleValue(t.Elem(), depth+1))
+		v.Set(p)
+	case reflect.Slice:
+		v = reflect.Append(v, sampleValue(t.Elem(), depth+1))
+	case reflect.Map:
+		v = reflect.MakeMap(t)
+		v.SetMapIndex(sampleValue(t.Key(), depth+1), sampleValue(t.Elem(), depth+1))
+	case reflect.Interface:
+		if t.NumMethod() == 0 {
+			v.Set(reflect.ValueOf(map[string]interface{}{sampleString: sampleString}))
+		}
+	case reflect.Struct:
+		for i := 0; i < t.NumField(); i++ {
+			if f := t.Field(i); f.PkgPath == "" {
+				v.Field(i).Set(sampleValue(f.Type, depth+1))
+			}
+		}
+	}
+	return v
+}
+
+// contractCheck compares the requests and results of a method with the spec.
+type contractCheck struct {
+	spec     *openapi.Document
+	header   string
+	findings []string
+}
+
+func (cc *contractCheck) addf(format string, args ...interface{}) {
+	cc.findings = append(cc.findings, fmt.Sprintf(format, args...))
+}
+
+func (cc *contractCheck) String() string {
+	var b strings.Builder
+	b.WriteString(cc.header + "\n")
+	sort.Strings(cc.findings)
+	for _, f := range cc.findings {
+		b.WriteString("  " + f + "\n")
+	}
+	return b.String()
+}
+
+func (cc *contractCheck) check(method string, requests []*capturedRequest, results []reflect.Value) {
+	if len(requests) == 0 {
+		cc.header = method + ": no request sent"
+		if err, ok := results[len(results)-1].Interface().(error); ok && err != nil {
+			cc.header += " (" + err.Error() + ")"
+		}
+		return
+	}
+
+	var ops []string
+	for _, req := range requests {
+		path := strings.TrimPrefix(req.URL.Path, "/v3.5")
+		r := cc.spec.Find(req.Method, path)
+		if r == nil {
+			ops = append(ops, req.Method+" "+path)
+			if cc.spec.HasPath(path) {
+				cc.addf("path: method %s isn't supported by %s", req.Method, path)
+			} else {
+				cc.addf("path: no operation matches %s %s", req.Method, path)
+			}
+			continue
+		}
+		ops = append(ops, fmt.Sprintf("%s %s (%s)", r.Method, r.Path, r.Operation.OperationID))
+		cc.checkQuery(req, r)
+		cc.checkRequestBody(req, r)
+		cc.checkResponse(method, results, r)
+	}
+	cc.header = method + ": " + strings.Join(ops, ", ")
+}
+
+func (cc *contractCheck) checkQuery(req *capturedRequest, r *openapi.Route) {
+	params := make(map[string]*openapi.Parameter)
+	for _, p := range r.Parameters {
+		if p.In == "query" {
+			params[p.Name] = p
+		}
+	}
+	query := req.URL.Query()
+	for name := range query {
+		if params[name] == nil {
+			cc.addf("query: extra parameter %q", name)
+		}
+	}
+	for name, p := range params {
+		if _, ok := query[name]; !ok && p.Required {
+			cc.addf("query: missing required parameter %q", name)
+		}
+	}
+}
+
+func (cc *contractCheck) checkRequestBody(req *capturedRequest, r *openapi.Route) {
+	var content map[string]*openapi.MediaType
+	if rb := r.Operation.RequestBody; rb != nil {
+		content = rb.Content
+	}
+	if len(req.body) == 0 {
+		if len(content) > 0 && r.Operation.RequestBody.Required {
+			cc.addf("request: no body sent, spec expects one")
+		}
+		return
+	}
+
+	ct, params, _ := mime.ParseMediaType(req.Header.Get("Content-Type"))
+	mt, ok := content[ct]
+	if !ok || mt == nil || mt.Schema == nil {
+		cc.addf("request: spec has no %q body", ct)
+		return
+	}
+
+	switch ct {
+	case "application/json":
+		var v interface{}
+		if err := json.Unmarshal(req.body, &v); err != nil {
+			cc.addf("request: invalid JSON body: %v", err)
+			return
+		}
+		cc.compareValue("request", "", v, mt.Schema, 0)
+	case "multipart/form-data":
+		fields := make(map[string]interface{})
+		mr := multipart.NewReader(bytes.NewReader(req.body), params["boundary"])
+		for {
+			p, err := mr.NextPart()
+			if err != nil {
+				break
+			}
+			// Form values are untyped, so only their names are compared.
+			fields[p.FormName()] = nil
+		}
+		cc.compareValue("request", "", fields, mt.Schema, 0)
+	}
+}
+
+// responseKind classifies how a method handles the response body: "json",
+// "binary" or "" when it's discarded.
+func responseKind(results []reflect.Value, method reflect.Type) (string, reflect.Type) {
+	for i := 0; i < method.NumIn(); i++ {
+		if method.In(i) == writerType {
+			return "binary", nil
+		}
+	}
+	if _, ok := results[0].Interface().(onfido.Iter); ok {
+		return "json", responseType(results[0].Type())
+	}
+	if len(results) < 2 {
+		return "", nil
+	}
+	t := results[0].Type()
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() == reflect.Struct {
+		if f, ok := t.FieldByName("Data"); ok && f.Type == reflect.TypeOf([]byte(nil)) {
+			return "binary", nil
+		}
+	}
+	return "json", responseType(results[0].Type())
+}
+
+// responseType returns the type responses are decoded into, the element type for iterators.
+func responseType(t reflect.Type) reflect.Type {
+	if t.Implements(reflect.TypeOf((*onfido.Iter)(nil)).Elem()) {
+		for i := 0; i < t.NumMethod(); i++ {
+			m := t.Method(i)
+			ft := m.Type
+			in := 1
+			if t.Kind() == reflect.Interface {
+				in = 0
+			}
+			if !iterFuncs[m.Name] && ft.NumIn() == in && ft.NumOut() == 1 {
+				return reflect.SliceOf(ft.Out(0))
+			}
+		}
+	}
+	return t
+}
+
+func (cc *contractCheck) checkResponse(method string, results []reflect.Value, r *openapi.Route) {
+	mt, ok := reflect.TypeOf((*onfido.OnfidoClient)(nil)).Elem().MethodByName(method)
+	if !ok {
+		return
+	}
+	kind, t := responseKind(results, mt.Type)
+
+	_, resp := cc.spec.SuccessResponse(r.Operation)
+	var schema *openapi.Schema
+	specKind := ""
+	if resp != nil && len(resp.Content) > 0 {
+		if schema = openapi.JSONSchema(resp.Content); schema != nil {
+			specKind = "json"
+		} else {
+			specKind = "binary"
+		}
+	}
+
+	switch {
+	case kind == "json" && specKind == "json":
+		cc.compareType("response", "", t, schema, 0)
+	case kind == "json" && specKind == "":
+		cc.addf("response: spec has no body, client decodes %s", results[0].Type())
+	case kind == "" && specKind == "json":
+		cc.addf("response: client discards the JSON body")
+	case kind != specKind:
+		cc.addf("response: client expects a %s body, spec returns %s", kind, orNone(specKind))
+	}
+}
+
+func orNone(s string) string {
+	if s == "" {
+		return "none"
+	}
+	return s
+}
+
+// jsonFields returns the JSON fields of a struct type, including those of embedded structs.
+func jsonFields(t reflect.Type) map[string]reflect.Type {
+	fields := make(map[string]reflect.Type)
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" && !f.Anonymous {
+			continue
+		}
+		name := strings.Split(f.Tag.Get("json"), ",")[0]
+		if name == "-" {
+			continue
+		}
+		if f.Anonymous && name == "" {
+			ft := f.Type
+			if ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				for n, t := range jsonFields(ft) {
+					if _, ok := fields[n]; !ok {
+						fields[n] = t
+					}
+				}
+				continue
+			}
+		}
+		if name == "" {
+			name = f.Name
+		}
+		fields[name] = f.Type
+	}
+	return fields
+}
+
+// goJSONType returns the JSON type a Go type decodes from, or "" for any.
+func goJSONType(t reflect.Type) string {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch {
+	case t == timeType:
+		return "string"
+	case t == rawJSONType, reflect.PtrTo(t).Implements(unmarshaler):
+		return ""
+	}
+	switch t.Kind() {
+	case reflect.String:
+		return "string"
+	case reflect.Bool:
+		return "boolean"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "integer"
+	case reflect.Float32, reflect.Float64:
+		return "number"
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return "string"
+		}
+		return "array"
+	case reflect.Map, reflect.Struct:
+		return "object"
+	}
+	return ""
+}
+
+// compatible reports whether a value of the client's JSON type can hold the spec's.
+func compatible(client, spec string) bool {
+	return client == "" || spec == "" || client == spec || client == "number" && spec == "integer"
+}
+
+// compareType compares the Go type responses are decoded into with the spec schema.
+func (cc *contractCheck) compareType(where, path string, t reflect.Type, s *openapi.Schema, depth int) {
+	if depth > contractMaxDepth {
+		return
+	}
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	clientType, specType := goJSONType(t), cc.spec.TypeOf(s)
+	if path == "" && clientType == "array" && specType == "object" {
+		// List responses wrap the items in an object with a single array property.
+		if items := cc.listItems(s); items != nil {
+			s, specType = items, "array"
+		}
+	}
+	if !compatible(clientType, specType) {
+		if path == "" {
+			cc.addf("%s: mistyped body: client %s, spec %s", where, clientType, specType)
+		} else {
+			cc.addf("%s: mistyped field %q: client %s, spec %s", where, path, clientType, specType)
+		}
+		return
+	}
+	if clientType == "" || specType == "" {
+		return
+	}
+
+	switch clientType {
+	case "array":
+		if schema := cc.spec.Resolve(s); schema != nil && schema.Items != nil {
+			cc.compareType(where, path+"[]", t.Elem(), schema.Items, depth+1)
+		}
+	case "object":
+		if t.Kind() != reflect.Struct {
+			return
+		}
+		props := cc.spec.Properties(s)
+		if len(props) == 0 {
+			return
+		}
+		fields := jsonFields(t)
+		for name, ft := range fields {
+			p, ok := props[name]
+			if !ok {
+				cc.addf("%s: extra field %q (%s)", where, joinPath(path, name), orAny(goJSONType(ft)))
+				continue
+			}
+			cc.compareType(where, joinPath(path, name), ft, p, depth+1)
+		}
+		for name, p := range props {
+			if _, ok := fields[name]; !ok {
+				cc.addf("%s: missing field %q (%s)", where, joinPath(path, name), orAny(cc.spec.TypeOf(p)))
+			}
+		}
+	}
+}
+
+// listItems returns the item schema of a list response wrapping a single array, or nil.
+func (cc *contractCheck) listItems(s *openapi.Schema) *openapi.Schema {
+	props := cc.spec.Properties(s)
+	if len(props) != 1 {
+		return nil
+	}
+	for _, p := range props {
+		if cc.spec.TypeOf(p) == "array" {
+			return p
+		}
+	}
+	return nil
+}
+
+// compareValue compares a decoded JSON request body with the spec schema.
+func (cc *contractCheck) compareValue(where, path string, v interface{}, s *openapi.Schema, depth int) {
+	if depth > contractMaxDepth {
+		return
+	}
+	specType := cc.spec.TypeOf(s)
+	clientType := jsonValueType(v)
+	if !compatible(clientType, specType) || clientType == "number" && specType == "integer" && !isWhole(v) {
+		cc.addf("%s: mistyped field %q: client %s, spec %s", where, path, clientType, specType)
+		return
+	}
+
+	switch v := v.(type) {
+	case []interface{}:
+		if schema := cc.spec.Resolve(s); schema != nil && schema.Items != nil && len(v) > 0 {
+			cc.compareValue(where, path+"[]", v[0], schema.Items, depth+1)
+		}
+	case map[string]interface{}:
+		props := cc.spec.Properties(s)
+		if len(props) == 0 {
+			return
+		}
+		for name, fv := range v {
+			p, ok := props[name]
+			if !ok {
+				cc.addf("%s: extra field %q (%s)", where, joinPath(path, name), orAny(jsonValueType(fv)))
+				continue
+			}
+			cc.compareValue(where, joinPath(path, name), fv, p, depth+1)
+		}
+		for name, p := range props {
+			if _, ok := v[name]; !ok {
+				cc.addf("%s: missing field %q (%s)", where, joinPath(path, name), orAny(cc.spec.TypeOf(p)))
+			}
+		}
+	}
+}
+
+func jsonValueType(v interface{}) string {
+	switch v.(type) {
+	case string:
+		return "string"
+	case bool:
+		return "boolean"
+	case float64:
+		return "number"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	}
+	return ""
+}
+
+func isWhole(v interface{}) bool {
+	f, ok := v.(float64)
+	return ok && f == float64(int64(f))
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+func orAny(t string) string {
+	if t == "" {
+		return "any"
+	}
+	return t
+}
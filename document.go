@@ -66,6 +66,17 @@ type Documents struct {
 	Documents []*Document `json:"documents"`
 }
 
+// fileExtensions maps the sniffed content types of supported uploads to a file extension.
+var fileExtensions = map[string]string{
+	"image/jpeg":      ".jpg",
+	"image/png":       ".png",
+	"image/gif":       ".gif",
+	"image/webp":      ".webp",
+	"application/pdf": ".pdf",
+	"video/mp4":       ".mp4",
+	"video/webm":      ".webm",
+}
+
 var quoteEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
 
 func escapeQuotes(s string) string {
@@ -84,48 +95,66 @@ func createFormFile(writer *multipart.Writer, fieldname string, file io.ReadSeek
 	if _, err := file.Seek(0, 0); err != nil {
 		return nil, err
 	}
+	contentType := http.DetectContentType(buffer)
 	var filename string
 	if f, ok := file.(*os.File); ok {
 		filename = f.Name()
+	} else {
+		// Without a file name the part would be treated as a plain form value.
+		filename = fieldname + fileExtensions[contentType]
 	}
 
 	h := make(textproto.MIMEHeader)
 	h.Set("Content-Disposition",
 		fmt.Sprintf(`form-data; name="%s"; filename="%s"`,
 			escapeQuotes(fieldname), escapeQuotes(filename)))
-	h.Set("Content-Type", http.DetectContentType(buffer))
+	h.Set("Content-Type", contentType)
 
 	return writer.CreatePart(h)
 }
 
-// UploadDocument uploads a document for the provided applicant.
-// see https://documentation.onfido.com/?shell#upload-document
-func (c *client) UploadDocument(ctx context.Context, dr DocumentRequest) (*Document, error) {
+// newMultipartRequest creates a multipart/form-data POST request with the file
+// as the "file" part followed by the provided fields, in order.
+func (c *client) newMultipartRequest(uri string, file io.ReadSeeker, fields ...formField) (*http.Request, error) {
 	body := &bytes.Buffer{}
 	writer := multipart.NewWriter(body)
 
-	part, err := createFormFile(writer, "file", dr.File)
+	part, err := createFormFile(writer, "file", file)
 	if err != nil {
 		return nil, err
 	}
-	if _, err := io.Copy(part, dr.File); err != nil {
+	if _, err := io.Copy(part, file); err != nil {
 		return nil, err
 	}
-	if err := writer.WriteField("type", string(dr.Type)); err != nil {
-		return nil, err
-	}
-	if err := writer.WriteField("side", string(dr.Side)); err != nil {
-		return nil, err
-	}
-	if err := writer.WriteField("applicant_id", dr.ApplicantID); err != nil {
-		return nil, err
+	for _, f := range fields {
+		if err := writer.WriteField(f.name, f.value); err != nil {
+			return nil, err
+		}
 	}
 	if err := writer.Close(); err != nil {
 		return nil, err
 	}
 
-	req, err := c.newRequest("POST", "/documents", body)
+	req, err := c.newRequest("POST", uri, body)
+	if err != nil {
+		return nil, err
+	}
 	req.Header.Set("Content-Type", writer.FormDataContentType())
+	return req, nil
+}
+
+type formField struct {
+	name, value string
+}
+
+// UploadDocument uploads a document for the provided applicant.
+// see https://documentation.onfido.com/?shell#upload-document
+func (c *client) UploadDocument(ctx context.Context, dr DocumentRequest) (*Document, error) {
+	req, err := c.newMultipartRequest("/documents", dr.File,
+		formField{"type", string(dr.Type)},
+		formField{"side", string(dr.Side)},
+		formField{"applicant_id", dr.ApplicantID},
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -169,7 +198,7 @@ func (c *client) DownloadDocument(ctx context.Context, id string) (*DocumentDown
 
 // DocumentIter represents a document iterator
 type DocumentIter struct {
-	*iter
+	Iter
 }
 
 // Document returns the current item in the iterator as a Document.
@@ -39,9 +39,9 @@ func main() {
 	check, err := client.CreateCheck(ctx, onfido.CheckRequest{
 		ApplicantID:           applicant.ID,
 		ApplicantProvidesData: true,
-		ReportNames: []string{
-			string(onfido.ReportNameDocument),
-			string(onfido.ReportNameIdentityEnhanced),
+		ReportNames: []onfido.ReportName{
+			onfido.ReportNameDocument,
+			onfido.ReportNameIdentityEnhanced,
 		},
 	})
 	if err != nil {
@@ -0,0 +1,44 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/mbowman100/go-onfido"
+)
+
+func main() {
+	client, err := onfido.NewClientFromEnv()
+	if err != nil {
+		panic(err)
+	}
+
+	// Use a persistent store shared with the webhook handler in production.
+	dedup := onfido.NewMemoryDedupStore()
+	handle := func(ctx context.Context, wr *onfido.WebhookRequest) error {
+		fmt.Printf("Recovered: %s %s\n", wr.Payload.Action, wr.Payload.Object.ID)
+		return nil
+	}
+
+	recovery := &onfido.WebhookRecovery{
+		Client:  client,
+		Dedup:   dedup,
+		Process: handle,
+		Reports: true,
+		// Also recover checks created up to a week before the lookback period,
+		// which completed within it.
+		MaxCheckAge: 7 * 24 * time.Hour,
+	}
+
+	err = recovery.Run(context.Background(), 15*time.Minute, 24*time.Hour, func(res *onfido.WebhookRecoveryResult, err error) {
+		if err != nil {
+			fmt.Printf("Recovery failed: %v\n", err)
+			return
+		}
+		fmt.Printf("Checked %d checks, recovered %d events\n", res.Checks, len(res.Recovered))
+	})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -1,14 +1,16 @@
 package main
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/mbowman100/go-onfido"
 )
 
 func main() {
-	wh, err := onfido.NewWebhookFromEnv()
+	wh, err := onfido.NewWebhookFromEnv(onfido.WithReplayGuard(10*time.Minute, nil))
 	if err != nil {
 		panic(err)
 	}
@@ -16,7 +18,17 @@ func main() {
 	http.HandleFunc("/webhook/onfido", func(w http.ResponseWriter, req *http.Request) {
 		whReq, err := wh.ParseFromRequest(req)
 		if err != nil {
-			if err == onfido.ErrInvalidWebhookSignature {
+			if errors.Is(err, onfido.ErrWebhookReplay) {
+				w.WriteHeader(http.StatusOK)
+				w.Write([]byte("Already received"))
+				return
+			}
+			if errors.Is(err, onfido.ErrWebhookStale) {
+				w.WriteHeader(http.StatusBadRequest)
+				w.Write([]byte("Outside the replay tolerance"))
+				return
+			}
+			if errors.Is(err, onfido.ErrInvalidWebhookSignature) {
 				w.WriteHeader(http.StatusBadRequest)
 				w.Write([]byte("Invalid signature"))
 				return
@@ -27,8 +39,21 @@ func main() {
 			return
 		}
 
+		if err := handle(whReq); err != nil {
+			// Forget the request so Onfido's retry isn't rejected as a replay.
+			wh.(onfido.WebhookReleaser).Release(whReq)
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte("Error occurred"))
+			return
+		}
+
 		fmt.Fprintf(w, "Webhook: %+v\n", whReq)
 	})
 
 	http.ListenAndServe(":8080", nil)
 }
+
+func handle(whReq *onfido.WebhookRequest) error {
+	fmt.Printf("%s %s: %s\n", whReq.Payload.ResourceType, whReq.Payload.Action, whReq.Payload.Object.ID)
+	return nil
+}
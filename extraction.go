@@ -0,0 +1,90 @@
+package onfido
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+)
+
+// DocumentClassification represents the classification of an extracted document
+type DocumentClassification struct {
+	IssuingCountry string       `json:"issuing_country,omitempty"`
+	IssuingState   string       `json:"issuing_state,omitempty"`
+	DocumentType   DocumentType `json:"document_type,omitempty"`
+	Subtype        string       `json:"subtype,omitempty"`
+	Version        string       `json:"version,omitempty"`
+}
+
+// ExtractedData represents the data extracted from a document.
+// Fields which couldn't be extracted are left empty.
+type ExtractedData struct {
+	FirstName      string `json:"first_name,omitempty"`
+	MiddleName     string `json:"middle_name,omitempty"`
+	LastName       string `json:"last_name,omitempty"`
+	FullName       string `json:"full_name,omitempty"`
+	Gender         string `json:"gender,omitempty"`
+	DateOfBirth    string `json:"date_of_birth,omitempty"`
+	DocumentNumber string `json:"document_number,omitempty"`
+	DocumentType   string `json:"document_type,omitempty"`
+	DateOfExpiry   string `json:"date_of_expiry,omitempty"`
+	IssuingDate    string `json:"issuing_date,omitempty"`
+	IssuingCountry string `json:"issuing_country,omitempty"`
+	Nationality    string `json:"nationality,omitempty"`
+	AddressLine1   string `json:"address_line_1,omitempty"`
+	AddressLine2   string `json:"address_line_2,omitempty"`
+	AddressLine3   string `json:"address_line_3,omitempty"`
+	AddressLine4   string `json:"address_line_4,omitempty"`
+	AddressLine5   string `json:"address_line_5,omitempty"`
+	MRZLine1       string `json:"mrz_line1,omitempty"`
+	MRZLine2       string `json:"mrz_line2,omitempty"`
+	MRZLine3       string `json:"mrz_line3,omitempty"`
+}
+
+// ExtractionResult represents the result of a document data extraction (autofill)
+// see https://documentation.onfido.com/#autofill-object
+type ExtractionResult struct {
+	DocumentID             string                 `json:"document_id,omitempty"`
+	DocumentClassification DocumentClassification `json:"document_classification"`
+	ExtractedData          ExtractedData          `json:"extracted_data"`
+}
+
+// ExtractDocument extracts the data of a previously uploaded document,
+// e.g. to prefill an onboarding form.
+// see https://documentation.onfido.com/#autofill
+func (c *client) ExtractDocument(ctx context.Context, documentID string) (*ExtractionResult, error) {
+	if documentID == "" {
+		return nil, errors.New("invalid document id")
+	}
+	jsonStr, err := json.Marshal(struct {
+		DocumentID string `json:"document_id"`
+	}{documentID})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := c.newRequest(http.MethodPost, "/extractions", bytes.NewBuffer(jsonStr))
+	if err != nil {
+		return nil, err
+	}
+
+	var resp ExtractionResult
+	_, err = c.do(ctx, req, &resp)
+	return &resp, err
+}
+
+// UploadAndExtract uploads a document and extracts its data.
+// The uploaded document is returned even if the extraction fails.
+func (c *client) UploadAndExtract(ctx context.Context, dr DocumentRequest) (*Document, *ExtractionResult, error) {
+	doc, err := c.UploadDocument(ctx, dr)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	res, err := c.ExtractDocument(ctx, doc.ID)
+	if err != nil {
+		return doc, nil, err
+	}
+	return doc, res, nil
+}
@@ -0,0 +1,106 @@
+package onfido
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+const extractionJSON = `{
+	"document_id": "doc-1",
+	"document_classification": {
+		"issuing_country": "GBR",
+		"document_type": "driving_licence"
+	},
+	"extracted_data": {
+		"first_name": "JANE",
+		"last_name": "WHITEHEAD",
+		"date_of_birth": "1976-03-11",
+		"document_number": "200407512345",
+		"date_of_expiry": "2031-05-28",
+		"nationality": "GBR",
+		"address_line_1": "122 BURNS CRESCENT",
+		"address_line_2": "EDINBURGH"
+	}
+}`
+
+func TestExtractDocument_NonOKResponse(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+	}))
+	defer srv.Close()
+
+	client := NewClient("123").(*client)
+	client.endpoint = srv.URL
+
+	_, err := client.ExtractDocument(context.Background(), "doc-1")
+	if err == nil {
+		t.Fatal("expected server to return non ok response, got successful response")
+	}
+}
+
+func TestExtractDocument_DataExtracted(t *testing.T) {
+	m := mux.NewRouter()
+	m.HandleFunc("/extractions", func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]string
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "doc-1", body["document_id"])
+
+		w.Header().Set("Content-Type", "application/json")
+		_, wErr := w.Write([]byte(extractionJSON))
+		assert.NoError(t, wErr)
+	}).Methods("POST")
+	srv := httptest.NewServer(m)
+	defer srv.Close()
+
+	client := NewClient("123").(*client)
+	client.endpoint = srv.URL
+
+	res, err := client.ExtractDocument(context.Background(), "doc-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, DocumentTypeDrivingLicense, res.DocumentClassification.DocumentType)
+	assert.Equal(t, "GBR", res.DocumentClassification.IssuingCountry)
+	assert.Equal(t, "JANE", res.ExtractedData.FirstName)
+	assert.Equal(t, "1976-03-11", res.ExtractedData.DateOfBirth)
+	assert.Equal(t, "2031-05-28", res.ExtractedData.DateOfExpiry)
+	assert.Equal(t, "122 BURNS CRESCENT", res.ExtractedData.AddressLine1)
+}
+
+func TestUploadAndExtract(t *testing.T) {
+	m := mux.NewRouter()
+	m.HandleFunc("/documents", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, wErr := w.Write([]byte(`{"id": "doc-1", "type": "driving_licence"}`))
+		assert.NoError(t, wErr)
+	}).Methods("POST")
+	m.HandleFunc("/extractions", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, wErr := w.Write([]byte(extractionJSON))
+		assert.NoError(t, wErr)
+	}).Methods("POST")
+	srv := httptest.NewServer(m)
+	defer srv.Close()
+
+	client := NewClient("123").(*client)
+	client.endpoint = srv.URL
+
+	doc, res, err := client.UploadAndExtract(context.Background(), DocumentRequest{
+		ApplicantID: "541d040b-89f8-444b-8921-16b1333bf1c6",
+		File:        bytes.NewReader([]byte("test")),
+		Type:        DocumentTypeDrivingLicense,
+		Side:        DocumentSideFront,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "doc-1", doc.ID)
+	assert.Equal(t, "WHITEHEAD", res.ExtractedData.LastName)
+}
@@ -1,10 +1,16 @@
 module github.com/mbowman100/go-onfido
 
-go 1.14
+go 1.18
 
 require (
 	github.com/gorilla/mux v1.7.4
 	github.com/stretchr/testify v1.6.0
 	github.com/tomnomnom/linkheader v0.0.0-20180905144013-02ca5825eb80
 	github.com/uw-labs/go-onfido v0.0.0-20200220102243-a3e5f74e6744
+	gopkg.in/yaml.v3 v3.0.1
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.0 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
 )
@@ -0,0 +1,95 @@
+package onfido
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+)
+
+// IDPhoto represents an ID photo in Onfido API
+// see https://documentation.onfido.com/#id-photo-object
+type IDPhoto struct {
+	ID           string     `json:"id,omitempty"`
+	CreatedAt    *time.Time `json:"created_at,omitempty"`
+	Href         string     `json:"href,omitempty"`
+	DownloadHref string     `json:"download_href,omitempty"`
+	FileName     string     `json:"file_name,omitempty"`
+	FileType     string     `json:"file_type,omitempty"`
+	FileSize     int        `json:"file_size,omitempty"`
+}
+
+// IDPhotoRequest represents an ID photo upload request to Onfido API
+type IDPhotoRequest struct {
+	ApplicantID string
+	File        io.ReadSeeker
+}
+
+// UploadIDPhoto uploads an ID photo for the provided applicant.
+// see https://documentation.onfido.com/#upload-id-photo
+func (c *client) UploadIDPhoto(ctx context.Context, ir IDPhotoRequest) (*IDPhoto, error) {
+	req, err := c.newMultipartRequest("/id_photos", ir.File,
+		formField{"applicant_id", ir.ApplicantID},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp IDPhoto
+	_, err = c.do(ctx, req, &resp)
+	return &resp, err
+}
+
+// GetIDPhoto retrieves a single ID photo by its ID.
+// see https://documentation.onfido.com/#retrieve-id-photo
+func (c *client) GetIDPhoto(ctx context.Context, id string) (*IDPhoto, error) {
+	req, err := c.newRequest(http.MethodGet, "/id_photos/"+id, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp IDPhoto
+	_, err = c.do(ctx, req, &resp)
+	return &resp, err
+}
+
+// DownloadIDPhoto streams the binary data representing the ID photo into w.
+// see https://documentation.onfido.com/#download-id-photo
+func (c *client) DownloadIDPhoto(ctx context.Context, id string, w io.Writer) error {
+	return c.download(ctx, "/id_photos/"+id+"/download", w, "id photo")
+}
+
+// IDPhotoIter represents an ID photo iterator
+type IDPhotoIter struct {
+	Iter
+}
+
+// IDPhoto returns the current item in the iterator as an IDPhoto.
+func (i *IDPhotoIter) IDPhoto() *IDPhoto {
+	return i.Current().(*IDPhoto)
+}
+
+// ListIDPhotos retrieves the list of ID photos for the provided applicant.
+// see https://documentation.onfido.com/#list-id-photos
+func (c *client) ListIDPhotos(applicantID string) *IDPhotoIter {
+	return &IDPhotoIter{&iter{
+		c:       c,
+		nextURL: "/id_photos?applicant_id=" + applicantID,
+		handler: func(body []byte) ([]interface{}, error) {
+			var r struct {
+				IDPhotos []*IDPhoto `json:"id_photos"`
+			}
+
+			if err := json.Unmarshal(body, &r); err != nil {
+				return nil, err
+			}
+
+			values := make([]interface{}, len(r.IDPhotos))
+			for i, v := range r.IDPhotos {
+				values[i] = v
+			}
+			return values, nil
+		},
+	}}
+}
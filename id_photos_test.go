@@ -0,0 +1,105 @@
+package onfido
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIDPhotos_Upload(t *testing.T) {
+	applicantID := "541d040b-89f8-444b-8921-16b1333bf1c6"
+	jpeg := []byte("\xff\xd8\xff\xe0 jpeg data")
+
+	m := mux.NewRouter()
+	m.HandleFunc("/id_photos", func(w http.ResponseWriter, r *http.Request) {
+		assert.NoError(t, r.ParseMultipartForm(1<<20))
+		assert.Equal(t, applicantID, r.FormValue("applicant_id"))
+		_, fh, err := r.FormFile("file")
+		if assert.NoError(t, err) {
+			assert.Equal(t, "image/jpeg", fh.Header.Get("Content-Type"))
+			assert.Equal(t, "file.jpg", fh.Filename)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, wErr := w.Write([]byte(`{"id": "idp-1", "file_type": "image/jpeg"}`))
+		assert.NoError(t, wErr)
+	}).Methods("POST")
+	srv := httptest.NewServer(m)
+	defer srv.Close()
+
+	client := NewClient("123").(*client)
+	client.endpoint = srv.URL
+
+	p, err := client.UploadIDPhoto(context.Background(), IDPhotoRequest{
+		ApplicantID: applicantID,
+		File:        bytes.NewReader(jpeg),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "idp-1", p.ID)
+}
+
+func TestIDPhotos_List(t *testing.T) {
+	applicantID := "541d040b-89f8-444b-8921-16b1333bf1c6"
+	m := mux.NewRouter()
+	m.HandleFunc("/id_photos", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, applicantID, r.URL.Query().Get("applicant_id"))
+		w.Header().Set("Content-Type", "application/json")
+		_, wErr := w.Write([]byte(`{"id_photos": [{"id": "idp-1"}, {"id": "idp-2"}]}`))
+		assert.NoError(t, wErr)
+	}).Methods("GET")
+	srv := httptest.NewServer(m)
+	defer srv.Close()
+
+	client := NewClient("123").(*client)
+	client.endpoint = srv.URL
+
+	var ids []string
+	it := client.ListIDPhotos(applicantID)
+	for it.Next(context.Background()) {
+		ids = append(ids, it.IDPhoto().ID)
+	}
+	if it.Err() != nil {
+		t.Fatal(it.Err())
+	}
+	assert.Equal(t, []string{"idp-1", "idp-2"}, ids)
+}
+
+func TestIDPhotos_GetAndDownload(t *testing.T) {
+	m := mux.NewRouter()
+	m.HandleFunc("/id_photos/{id}", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, wErr := w.Write([]byte(`{"id": "` + mux.Vars(r)["id"] + `", "file_size": 10}`))
+		assert.NoError(t, wErr)
+	}).Methods("GET")
+	m.HandleFunc("/id_photos/{id}/download", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "image/jpeg")
+		_, wErr := w.Write([]byte("image data"))
+		assert.NoError(t, wErr)
+	}).Methods("GET")
+	srv := httptest.NewServer(m)
+	defer srv.Close()
+
+	client := NewClient("123").(*client)
+	client.endpoint = srv.URL
+	ctx := context.Background()
+
+	p, err := client.GetIDPhoto(ctx, "idp-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 10, p.FileSize)
+
+	var buf bytes.Buffer
+	if err := client.DownloadIDPhoto(ctx, "idp-1", &buf); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "image data", buf.String())
+}
@@ -4,6 +4,7 @@
 package onfido_test
 
 import (
+	"bytes"
 	"context"
 	"flag"
 	"os"
@@ -13,168 +14,380 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/mbowman100/go-onfido"
+	"github.com/mbowman100/go-onfido/onfidotest"
 )
 
+// The integration suite replays the interactions recorded in the cassette by default,
+// so it runs without network:
+//
+//	go test -tags integration -run Integration .
+//
+// To record the cassette against the sandbox, with personal data and tokens scrubbed:
+//
+//	go test -tags integration -run Integration . -record -onfidoToken api_sandbox.xxx
 var (
-	applicantID string
-	documentID  string
+	onfidoToken  = flag.String("onfidoToken", "", "onfido sandbox token used to record the integration tests")
+	record       = flag.Bool("record", false, "record the integration tests against the sandbox into the cassette")
+	cassettePath = flag.String("cassette", filepath.Join("testdata", "integration.json"), "cassette of the integration tests")
 )
 
-var onfidoToken = flag.String("onfidoToken", "", "onfido token used for integration tests")
-
 // ------------------------------------------------------------------
-// Applicants
+// Applicants and documents
 // ------------------------------------------------------------------
 
-func TestIntegrationCreateApplicant_ApplicantCreated(t *testing.T) {
-	expected := getDefaultApplicant()
-	a, err := getOnfidoClient().CreateApplicant(context.Background(), *getDefaultApplicant())
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	assert.Equal(t, expected.Title, a.Title)
-	assert.Equal(t, expected.Email, a.Email)
-	assert.Equal(t, expected.FirstName, a.FirstName)
-	assert.Equal(t, expected.LastName, a.LastName)
-	assert.Equal(t, expected.Addresses, a.Addresses)
-	assert.Equal(t, expected.IDNumbers, a.IDNumbers)
-
-	applicantID = a.ID
-}
-
-func TestIntegrationGetApplicant_ApplicantRetrieved(t *testing.T) {
-	if applicantID == "" {
-		t.Skip("no applicant ID set, check applicant created test. skipping")
-	}
-
+func TestIntegration(t *testing.T) {
+	client, rec := getOnfidoClient(t)
+	ctx := context.Background()
 	expected := getDefaultApplicant()
-	client := getOnfidoClient()
-
-	a, err := client.GetApplicant(context.Background(), applicantID)
-	if err != nil {
-		t.Fatal(err)
-	}
 
-	assert.Equal(t, expected.Title, a.Title)
-	assert.Equal(t, expected.Email, a.Email)
-	assert.Equal(t, expected.FirstName, a.FirstName)
-	assert.Equal(t, expected.LastName, a.LastName)
-	assert.Equal(t, expected.Addresses, a.Addresses)
-	assert.Equal(t, expected.IDNumbers, a.IDNumbers)
-}
-
-func TestIntegrationUpdateApplicant_ApplicantUpdated(t *testing.T) {
+	var applicantID, documentID string
+	defer func() {
+		if !*record {
+			assert.Empty(t, rec.Unused(), "recorded interactions weren't replayed")
+			return
+		}
+		if err := rec.Save(); err != nil {
+			t.Error(err)
+		}
+	}()
+
+	t.Run("CreateApplicant", func(t *testing.T) {
+		a, err := client.CreateApplicant(ctx, *expected)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, expected.Title, a.Title)
+		assert.Equal(t, expected.Email, a.Email)
+		assert.Equal(t, expected.FirstName, a.FirstName)
+		assert.Equal(t, expected.LastName, a.LastName)
+		assert.Equal(t, expected.IDNumbers, a.IDNumbers)
+
+		applicantID = a.ID
+	})
 	if applicantID == "" {
-		t.Skip("no applicant ID set, check applicant created test. skipping")
-	}
-
-	expected := getDefaultApplicant()
-	expected.ID = applicantID
-	expected.FirstName = "John"
-	expected.LastName = "Doe"
-
-	a, err := getOnfidoClient().UpdateApplicant(context.Background(), *expected)
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	assert.Equal(t, expected.FirstName, a.FirstName)
-	assert.Equal(t, expected.LastName, a.LastName)
-}
-
-func TestIntegrationListApplicants_ApplicantsListed(t *testing.T) {
-	client := getOnfidoClient()
-	iterated := false
-
-	it := client.ListApplicants()
-	for it.Next(context.Background()) {
-		a := it.Applicant()
-		assert.NotEmpty(t, a.FirstName)
-		assert.NotEmpty(t, a.LastName)
-		iterated = true
-	}
-	if it.Err() != nil {
-		t.Fatal(it.Err())
-	}
-	if !iterated {
-		t.Fatal("no applicant returned by iterator")
+		t.Fatal("no applicant created")
 	}
+	defer t.Run("DeleteApplicant", func(t *testing.T) {
+		if err := client.DeleteApplicant(ctx, applicantID); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("GetApplicant", func(t *testing.T) {
+		a, err := client.GetApplicant(ctx, applicantID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, expected.Email, a.Email)
+		assert.Equal(t, expected.FirstName, a.FirstName)
+		assert.Equal(t, expected.LastName, a.LastName)
+	})
+
+	t.Run("UpdateApplicant", func(t *testing.T) {
+		update := *expected
+		update.ID = applicantID
+		update.FirstName = "John"
+		update.LastName = "Doe"
+
+		a, err := client.UpdateApplicant(ctx, update)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, update.FirstName, a.FirstName)
+		assert.Equal(t, update.LastName, a.LastName)
+	})
+
+	t.Run("ListApplicants", func(t *testing.T) {
+		iterated := false
+		it := client.ListApplicants()
+		for it.Next(ctx) {
+			a := it.Applicant()
+			assert.NotEmpty(t, a.FirstName)
+			assert.NotEmpty(t, a.LastName)
+			iterated = true
+		}
+		if it.Err() != nil {
+			t.Fatal(it.Err())
+		}
+		if !iterated {
+			t.Fatal("no applicant returned by iterator")
+		}
+	})
+
+	t.Run("UploadDocument", func(t *testing.T) {
+		dr := getDefaultDocument(t)
+		dr.ApplicantID = applicantID
+		d, err := client.UploadDocument(ctx, dr)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, "id-card.jpg", d.FileName)
+		assert.Equal(t, dr.Type, d.Type)
+		assert.Equal(t, dr.Side, d.Side)
+
+		documentID = d.ID
+	})
+
+	t.Run("GetDocument", func(t *testing.T) {
+		if documentID == "" {
+			t.Skip("no document uploaded")
+		}
+
+		d, err := client.GetDocument(ctx, documentID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, "id-card.jpg", d.FileName)
+		assert.Equal(t, onfido.DocumentTypeIDCard, d.Type)
+		assert.Equal(t, onfido.DocumentSideFront, d.Side)
+	})
+
+	t.Run("ListDocuments", func(t *testing.T) {
+		var ids []string
+		it := client.ListDocuments(applicantID)
+		for it.Next(ctx) {
+			ids = append(ids, it.Document().ID)
+		}
+		if it.Err() != nil {
+			t.Fatal(it.Err())
+		}
+		assert.Contains(t, ids, documentID)
+	})
+
+	t.Run("NewSdkTokenWeb", func(t *testing.T) {
+		token, err := client.NewSdkTokenWeb(ctx, applicantID, "https://*.example.com/*")
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.NotEmpty(t, token.Token)
+	})
+
+	testChecks(t, client, applicantID, documentID)
+	testWatchlistMonitors(t, client, applicantID)
+	testWebhooks(t, client)
 }
 
 // ------------------------------------------------------------------
-// Documents
+// Checks and reports
 // ------------------------------------------------------------------
 
-func TestIntegrationUploadDocument_DocumentUploaded(t *testing.T) {
-	if applicantID == "" {
-		t.Skip("no applicant ID set, check applicant created test. skipping")
-	}
-
-	file, err := os.Open("./examples/upload-document/id-card.jpg")
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	expected := getDefaultDocument()
-	d, err := getOnfidoClient().UploadDocument(context.Background(), applicantID, *expected)
-	if err != nil {
-		t.Fatal(err)
+func testChecks(t *testing.T, client onfido.OnfidoClient, applicantID, documentID string) {
+	ctx := context.Background()
+	if documentID == "" {
+		t.Error("no document uploaded")
+		return
 	}
 
-	if d.FileName != filepath.Base(file.Name()) || d.Type != expected.Type || d.Side != expected.Side {
-		t.Fatalf(
-			"document uploaded did not match expected\nexpected filename: %s, type: %s, side: %s\ngot filename: %s, type: %s, side: %s",
-			filepath.Base(file.Name()),
-			expected.Type,
-			expected.Side,
-			d.FileName,
-			d.Type,
-			d.Side,
-		)
+	var checkID, reportID string
+	t.Run("CreateCheck", func(t *testing.T) {
+		chk, err := client.CreateCheck(ctx, onfido.CheckRequest{
+			ApplicantID: applicantID,
+			ReportNames: []onfido.ReportName{onfido.ReportNameDocument},
+			DocumentIDs: []string{documentID},
+			Tags:        []string{"integration"},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, applicantID, chk.ApplicantID)
+		assert.Equal(t, []string{"integration"}, chk.Tags)
+		checkID = chk.ID
+	})
+	if checkID == "" {
+		t.Error("no check created")
+		return
 	}
 
-	documentID = d.ID
+	t.Run("GetCheck", func(t *testing.T) {
+		chk, err := client.GetCheck(ctx, checkID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, checkID, chk.ID)
+		if assert.Len(t, chk.Reports, 1) {
+			reportID = chk.Reports[0]
+		}
+	})
+
+	t.Run("GetCheckExpanded", func(t *testing.T) {
+		chk, err := client.GetCheckExpanded(ctx, checkID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if assert.Len(t, chk.Reports, 1) {
+			assert.Equal(t, reportID, chk.Reports[0].ID)
+			assert.Equal(t, onfido.ReportNameDocument, chk.Reports[0].Name)
+		}
+	})
+
+	t.Run("ListChecks", func(t *testing.T) {
+		var ids []string
+		it := client.ListChecks(applicantID)
+		for it.Next(ctx) {
+			ids = append(ids, it.Check().ID)
+		}
+		if it.Err() != nil {
+			t.Fatal(it.Err())
+		}
+		assert.Equal(t, []string{checkID}, ids)
+	})
+
+	t.Run("GetReport", func(t *testing.T) {
+		if reportID == "" {
+			t.Skip("no report")
+		}
+
+		r, err := client.GetReport(ctx, reportID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, reportID, r.ID)
+		assert.Equal(t, onfido.ReportNameDocument, r.Name)
+	})
+
+	t.Run("ListReports", func(t *testing.T) {
+		var ids []string
+		it := client.ListReports(checkID)
+		for it.Next(ctx) {
+			ids = append(ids, it.Report().ID)
+		}
+		if it.Err() != nil {
+			t.Fatal(it.Err())
+		}
+		assert.Equal(t, []string{reportID}, ids)
+	})
+
+	t.Run("DownloadCheck", func(t *testing.T) {
+		var pdf bytes.Buffer
+		if err := client.DownloadCheck(ctx, checkID, &pdf); err != nil {
+			t.Fatal(err)
+		}
+		assert.True(t, bytes.HasPrefix(pdf.Bytes(), []byte("%PDF")), "the check is downloaded as a PDF")
+	})
 }
 
-func TestIntegrationGetDocument_DocumentRetrieved(t *testing.T) {
-	if documentID == "" {
-		t.Skip("no document ID set, check document upload test. skipping")
-	}
-
-	expected := getDefaultDocument()
-	file := expected.File.(*os.File)
-	d, err := getOnfidoClient().GetDocument(context.Background(), applicantID, documentID)
-	if err != nil {
-		t.Fatal(err)
-	}
+// ------------------------------------------------------------------
+// Watchlist monitors
+// ------------------------------------------------------------------
 
-	if d.FileName != filepath.Base(file.Name()) || d.Type != expected.Type || d.Side != expected.Side {
-		t.Fatalf(
-			"document uploaded did not match expected\nexpected filename: %s, type: %s, side: %s\ngot filename: %s, type: %s, side: %s",
-			filepath.Base(file.Name()),
-			expected.Type,
-			expected.Side,
-			d.FileName,
-			d.Type,
-			d.Side,
-		)
+func testWatchlistMonitors(t *testing.T, client onfido.OnfidoClient, applicantID string) {
+	ctx := context.Background()
+
+	var monitorID string
+	t.Run("CreateWatchlistMonitor", func(t *testing.T) {
+		m, err := client.CreateWatchlistMonitor(ctx, onfido.WatchlistMonitorRequest{
+			ApplicantID: applicantID,
+			ReportName:  onfido.ReportNameWatchlistStandard,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, applicantID, m.ApplicantID)
+		assert.Equal(t, onfido.ReportNameWatchlistStandard, m.ReportName)
+		monitorID = m.ID
+	})
+	if monitorID == "" {
+		t.Error("no watchlist monitor created")
+		return
 	}
+	defer t.Run("DeleteWatchlistMonitor", func(t *testing.T) {
+		if err := client.DeleteWatchlistMonitor(ctx, monitorID); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("GetWatchlistMonitor", func(t *testing.T) {
+		m, err := client.GetWatchlistMonitor(ctx, monitorID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, monitorID, m.ID)
+	})
+
+	t.Run("ListWatchlistMonitors", func(t *testing.T) {
+		var ids []string
+		it := client.ListWatchlistMonitors(applicantID)
+		for it.Next(ctx) {
+			ids = append(ids, it.WatchlistMonitor().ID)
+		}
+		if it.Err() != nil {
+			t.Fatal(it.Err())
+		}
+		assert.Contains(t, ids, monitorID)
+	})
+
+	t.Run("ListMonitorMatches", func(t *testing.T) {
+		if _, err := client.ListMonitorMatches(ctx, monitorID); err != nil {
+			t.Fatal(err)
+		}
+	})
 }
 
 // ------------------------------------------------------------------
-// Delete Applicant
+// Webhooks
 // ------------------------------------------------------------------
 
-func TestIntegrationDeleteApplicant_ApplicantDeleted(t *testing.T) {
-	if applicantID == "" {
-		t.Skip("no applicant ID set, check applicant created test. skipping")
-	}
-
-	if err := getOnfidoClient().DeleteApplicant(context.Background(), applicantID); err != nil {
-		t.Fatal(err)
+func testWebhooks(t *testing.T, client onfido.OnfidoClient) {
+	ctx := context.Background()
+
+	var webhookID string
+	t.Run("CreateWebhook", func(t *testing.T) {
+		wh, err := client.CreateWebhook(ctx, onfido.WebhookRefRequest{
+			URL:     "https://example.com/onfido/integration",
+			Enabled: false,
+			Events:  []onfido.WebhookEvent{onfido.WebhookEventCheckCompleted},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, "https://example.com/onfido/integration", wh.URL)
+		assert.False(t, wh.Enabled)
+		webhookID = wh.ID
+	})
+	if webhookID == "" {
+		t.Error("no webhook created")
+		return
 	}
-	applicantID = ""
+	defer t.Run("DeleteWebhook", func(t *testing.T) {
+		if err := client.DeleteWebhook(ctx, webhookID); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("UpdateWebhook", func(t *testing.T) {
+		wh, err := client.UpdateWebhook(ctx, webhookID, onfido.WebhookRefRequest{
+			URL:     "https://example.com/onfido/integration",
+			Enabled: false,
+			Events:  []onfido.WebhookEvent{onfido.WebhookEventCheckCompleted, onfido.WebhookEventReportCompleted},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Len(t, wh.Events, 2)
+	})
+
+	t.Run("ListWebhooks", func(t *testing.T) {
+		var ids []string
+		it := client.ListWebhooks()
+		for it.Next(ctx) {
+			ids = append(ids, it.WebhookRef().ID)
+		}
+		if it.Err() != nil {
+			t.Fatal(it.Err())
+		}
+		assert.Contains(t, ids, webhookID)
+	})
 }
 
 // ------------------------------------------------------------------
@@ -183,7 +396,6 @@ func TestIntegrationDeleteApplicant_ApplicantDeleted(t *testing.T) {
 
 func getDefaultApplicant() *onfido.Applicant {
 	return &onfido.Applicant{
-		Title:     "Mr",
 		FirstName: "Foo",
 		LastName:  "Bar",
 		Email:     "foo@bar.com",
@@ -193,39 +405,46 @@ func getDefaultApplicant() *onfido.Applicant {
 				Value: "1234567",
 			},
 		},
-		Addresses: []onfido.Address{
-			{
-				FlatNumber: "10",
-				Street:     "Baker Street",
-				Town:       "London",
-				Postcode:   "W1U 8ED",
-				Country:    "GBR",
-				StartDate:  "2017-12-05",
-			},
-		},
 	}
 }
 
-func getDefaultDocument() *onfido.DocumentRequest {
+func getDefaultDocument(t *testing.T) onfido.DocumentRequest {
 	file, err := os.Open("./examples/upload-document/id-card.jpg")
 	if err != nil {
-		panic(err)
+		t.Fatal(err)
 	}
+	t.Cleanup(func() { file.Close() })
 
-	return &onfido.DocumentRequest{
+	return onfido.DocumentRequest{
 		File: file,
 		Type: onfido.DocumentTypeIDCard,
 		Side: onfido.DocumentSideFront,
 	}
 }
 
-func getOnfidoClient() onfido.Client {
+// getOnfidoClient returns a client recording into the cassette with -record,
+// or replaying it otherwise.
+func getOnfidoClient(t *testing.T) (onfido.OnfidoClient, *onfidotest.Recorder) {
+	if !*record {
+		rec, err := onfidotest.NewRecorder(*cassettePath, onfidotest.ModeReplay)
+		if os.IsNotExist(err) {
+			t.Fatalf("cassette %s not found, record it against the sandbox with -record -onfidoToken", *cassettePath)
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		return onfido.NewClient("api_sandbox.replay", onfido.WithHTTPClient(rec)), rec
+	}
+
 	if *onfidoToken == "" {
-		panic("onfido token not set")
+		t.Fatal("onfido token not set")
 	}
-	client := onfido.NewClient(*onfidoToken)
-	if client.Token.Prod() {
-		panic("do not use a production token for integration tests")
+	if onfido.Token(*onfidoToken).Prod() {
+		t.Fatal("do not use a production token for integration tests")
+	}
+	rec, err := onfidotest.NewRecorder(*cassettePath, onfidotest.ModeRecord)
+	if err != nil {
+		t.Fatal(err)
 	}
-	return client
+	return onfido.NewClient(*onfidoToken, onfido.WithHTTPClient(rec)), rec
 }
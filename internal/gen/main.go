@@ -0,0 +1,607 @@
+// Command gen generates the models and endpoints of the onfidoapi package from
+// the vendored OpenAPI specification, see the go:generate directive in onfidoapi.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/mbowman100/go-onfido/internal/openapi"
+)
+
+const (
+	modelsFile    = "models_gen.go"
+	endpointsFile = "endpoints_gen.go"
+	header        = "// Code generated by gen from the Onfido OpenAPI specification; DO NOT EDIT.\n\npackage onfidoapi\n"
+)
+
+func main() {
+	spec := flag.String("spec", "../openapi/onfido-v3.5.json", "OpenAPI specification")
+	out := flag.String("out", ".", "directory of the generated files")
+	flag.Parse()
+
+	doc, err := openapi.Load(*spec)
+	if err != nil {
+		log.Fatal(err)
+	}
+	files, err := generate(doc)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for name, code := range files {
+		if err := ioutil.WriteFile(filepath.Join(*out, name), code, 0644); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
+
+// generate returns the generated files by name.
+func generate(doc *openapi.Document) (map[string][]byte, error) {
+	g := &generator{
+		doc:       doc,
+		types:     make(map[string]*typeDecl),
+		multipart: make(map[string]bool),
+	}
+
+	// Multipart bodies get a multipartFields method, so they must be known first.
+	for _, r := range doc.Routes() {
+		if rb := r.Operation.RequestBody; rb != nil {
+			if mt := rb.Content["multipart/form-data"]; mt != nil && mt.Schema != nil && mt.Schema.Ref != "" {
+				g.multipart[typeName(openapi.RefName(mt.Schema.Ref))] = true
+			}
+		}
+	}
+
+	var names []string
+	for name := range doc.Components.Schemas {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if err := g.declare(typeName(name), name, doc.Components.Schemas[name]); err != nil {
+			return nil, err
+		}
+	}
+
+	var endpoints []*endpoint
+	for _, r := range doc.Routes() {
+		e, err := g.endpoint(r)
+		if err != nil {
+			return nil, fmt.Errorf("%s %s: %w", r.Method, r.Path, err)
+		}
+		endpoints = append(endpoints, e)
+	}
+	sort.Slice(endpoints, func(i, j int) bool { return endpoints[i].Name < endpoints[j].Name })
+	for i := 1; i < len(endpoints); i++ {
+		if endpoints[i].Name == endpoints[i-1].Name {
+			return nil, fmt.Errorf("duplicate endpoint %s", endpoints[i].Name)
+		}
+	}
+
+	models, err := format.Source(g.models())
+	if err != nil {
+		return nil, fmt.Errorf("invalid models: %w", err)
+	}
+	code, err := format.Source(g.endpoints(endpoints))
+	if err != nil {
+		return nil, fmt.Errorf("invalid endpoints: %w", err)
+	}
+	return map[string][]byte{modelsFile: models, endpointsFile: code}, nil
+}
+
+type generator struct {
+	doc       *openapi.Document
+	types     map[string]*typeDecl
+	multipart map[string]bool
+	// imports of the file being generated
+	imports map[string]bool
+}
+
+type typeDecl struct {
+	Name string
+	Doc  string
+	// Source is the schema the type is generated from, see source.
+	Source string
+	// Underlying is the type of enums and named non-struct types, empty for structs.
+	Underlying string
+	Values     []string
+	Fields     []*field
+}
+
+type field struct {
+	Name     string
+	Type     string
+	JSON     string
+	Doc      string
+	Required bool
+}
+
+// declare declares the type of a component or inline schema, src is the
+// location of the schema: a component name, a property ("Check.status") or
+// a query parameter ("list_workflow_runs?sort").
+func (g *generator) declare(name, src string, s *openapi.Schema) error {
+	if _, ok := g.types[name]; ok {
+		return fmt.Errorf("duplicate type %s", name)
+	}
+	d := &typeDecl{Name: name, Doc: s.Description, Source: src}
+	g.types[name] = d
+
+	if s.Ref != "" {
+		d.Underlying = typeName(openapi.RefName(s.Ref))
+		return nil
+	}
+	switch g.doc.TypeOf(s) {
+	case "object":
+		props := g.doc.Properties(s)
+		if len(props) == 0 {
+			d.Underlying = "map[string]interface{}"
+			return nil
+		}
+		required := g.doc.RequiredProperties(s)
+		var jsonNames []string
+		for n := range props {
+			jsonNames = append(jsonNames, n)
+		}
+		sort.Strings(jsonNames)
+		for _, n := range jsonNames {
+			typ, err := g.goType(props[n], name+exportedName(n), src+"."+n)
+			if err != nil {
+				return fmt.Errorf("%s.%s: %w", name, n, err)
+			}
+			d.Fields = append(d.Fields, &field{
+				Name:     exportedName(n),
+				Type:     typ,
+				JSON:     n,
+				Doc:      props[n].Description,
+				Required: required[n],
+			})
+		}
+	case "string":
+		d.Underlying = "string"
+		for _, v := range s.Enum {
+			sv, ok := v.(string)
+			if !ok {
+				return fmt.Errorf("%s: unsupported enum value %v", name, v)
+			}
+			d.Values = append(d.Values, sv)
+		}
+	default:
+		typ, err := g.goType(s, name+"Item", src+"[]")
+		if err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+		d.Underlying = typ
+	}
+	return nil
+}
+
+// goType returns the Go type of a schema, declaring the types of inline
+// enums and objects under the given name.
+func (g *generator) goType(s *openapi.Schema, name, src string) (string, error) {
+	if s == nil {
+		return "interface{}", nil
+	}
+	if s.Ref != "" {
+		ref := g.doc.Resolve(s)
+		if ref == nil {
+			return "", fmt.Errorf("unresolved reference %s", s.Ref)
+		}
+		tn := typeName(openapi.RefName(s.Ref))
+		if g.doc.TypeOf(ref) == "object" && len(g.doc.Properties(ref)) > 0 {
+			return "*" + tn, nil
+		}
+		return tn, nil
+	}
+	if len(s.AllOf) == 1 && len(s.Properties) == 0 {
+		return g.goType(s.AllOf[0], name, src)
+	}
+
+	switch g.doc.TypeOf(s) {
+	case "string":
+		switch {
+		case s.Format == "date-time":
+			return "*time.Time", nil
+		case s.Format == "binary":
+			return "*File", nil
+		case len(s.Enum) > 0:
+			return name, g.declare(name, src, s)
+		}
+		return "string", nil
+	case "integer":
+		return "int", nil
+	case "number":
+		return "float64", nil
+	case "boolean":
+		return "bool", nil
+	case "array":
+		item, err := g.goType(s.Items, name, src+"[]")
+		return "[]" + item, err
+	case "object":
+		if len(g.doc.Properties(s)) == 0 {
+			return "map[string]interface{}", nil
+		}
+		return "*" + name, g.declare(name, src, s)
+	}
+	return "interface{}", nil
+}
+
+func (g *generator) models() []byte {
+	var names []string
+	for name := range g.types {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var body bytes.Buffer
+	g.imports = make(map[string]bool)
+	for _, name := range names {
+		d := g.types[name]
+		body.WriteString("\n")
+		writeDoc(&body, d.Name, d.Doc, fmt.Sprintf("%s is generated from %s", d.Name, source(d.Source)))
+		if d.Fields == nil {
+			fmt.Fprintf(&body, "type %s %s\n", d.Name, d.Underlying)
+			g.use(d.Underlying)
+			if len(d.Values) > 0 {
+				fmt.Fprintf(&body, "\n// %s values\nconst (\n", d.Name)
+				for _, v := range d.Values {
+					fmt.Fprintf(&body, "\t%s%s %s = %q\n", d.Name, exportedName(v), d.Name, v)
+				}
+				body.WriteString(")\n")
+			}
+			continue
+		}
+
+		fmt.Fprintf(&body, "type %s struct {\n", d.Name)
+		for _, f := range d.Fields {
+			if f.Doc != "" {
+				fmt.Fprintf(&body, "\t// %s\n", strings.ReplaceAll(strings.TrimSpace(f.Doc), "\n", "\n\t// "))
+			}
+			tag := f.JSON
+			if f.Type == "*File" {
+				tag = "-"
+			} else if !f.Required {
+				tag += ",omitempty"
+			}
+			fmt.Fprintf(&body, "\t%s %s `json:%q`\n", f.Name, f.Type, tag)
+			g.use(f.Type)
+		}
+		body.WriteString("}\n")
+
+		if g.multipart[d.Name] {
+			fmt.Fprintf(&body, "\nfunc (b %s) multipartFields() []multipartField {\n\treturn []multipartField{\n", d.Name)
+			for _, f := range d.Fields {
+				fmt.Fprintf(&body, "\t\t{%q, b.%s, %t},\n", f.JSON, f.Name, f.Required)
+			}
+			body.WriteString("\t}\n}\n")
+		}
+	}
+	return g.file(body.Bytes())
+}
+
+// use records the imports needed by a Go type.
+func (g *generator) use(typ string) {
+	if strings.Contains(typ, "time.") {
+		g.imports["time"] = true
+	}
+}
+
+// file prepends the header and the recorded imports to the body of a file.
+func (g *generator) file(body []byte) []byte {
+	var imports []string
+	for p := range g.imports {
+		imports = append(imports, p)
+	}
+	sort.Strings(imports)
+
+	var b bytes.Buffer
+	b.WriteString(header)
+	if len(imports) > 0 {
+		b.WriteString("\nimport (\n")
+		for _, p := range imports {
+			fmt.Fprintf(&b, "\t%q\n", p)
+		}
+		b.WriteString(")\n")
+	}
+	b.Write(body)
+	return b.Bytes()
+}
+
+func writeDoc(b *bytes.Buffer, name, doc, fallback string) {
+	doc = strings.TrimSpace(doc)
+	if doc == "" {
+		doc = fallback
+	} else if !strings.HasPrefix(doc, name+" ") {
+		doc = fallback + ".\n" + doc
+	}
+	b.WriteString("// " + strings.ReplaceAll(doc, "\n", "\n// ") + "\n")
+}
+
+type endpoint struct {
+	Name      string
+	Operation string
+	Method    string
+	Path      string
+	Summary   string
+	// Params are the arguments after ctx: path and required query parameters,
+	// the optional query parameters and the request body.
+	Params []string
+	// PathExpr builds the request path.
+	PathExpr string
+	// Query are the required query parameters by name, set from the arguments.
+	Query [][2]string
+	// QueryParams is the type of the optional query parameters, if any.
+	QueryParams *typeDecl
+	Body        string
+	Multipart   bool
+	Accept      string
+	// Result is the type decoded from a JSON response, empty for binary
+	// responses (written to w) and responses without body.
+	Result string
+	Binary bool
+}
+
+func (g *generator) endpoint(r *openapi.Route) (*endpoint, error) {
+	if r.Operation.OperationID == "" {
+		return nil, fmt.Errorf("missing operationId")
+	}
+	e := &endpoint{
+		Name:      exportedName(r.Operation.OperationID),
+		Operation: r.Operation.OperationID,
+		Method:    r.Method,
+		Path:      r.Path,
+		Summary:   r.Operation.Summary,
+		Accept:    "application/json",
+	}
+
+	var pathParams []*openapi.Parameter
+	var queryParams []*openapi.Parameter
+	for _, p := range r.Parameters {
+		switch p.In {
+		case "path":
+			pathParams = append(pathParams, p)
+		case "query":
+			queryParams = append(queryParams, p)
+		}
+	}
+
+	// Path parameters in the order of the template.
+	var parts []string
+	for _, seg := range strings.Split(strings.TrimPrefix(r.Path, "/"), "/") {
+		if !strings.HasPrefix(seg, "{") {
+			parts = append(parts, fmt.Sprintf("%q", "/"+seg))
+			continue
+		}
+		name := strings.Trim(seg, "{}")
+		found := false
+		for _, p := range pathParams {
+			found = found || p.Name == name
+		}
+		if !found {
+			return nil, fmt.Errorf("undeclared path parameter %s", name)
+		}
+		arg := unexportedName(name)
+		e.Params = append(e.Params, arg+" string")
+		parts = append(parts, fmt.Sprintf(`"/"+url.PathEscape(%s)`, arg))
+	}
+	e.PathExpr = strings.ReplaceAll(strings.Join(parts, "+"), `"+"`, "")
+
+	var optional []*field
+	for _, p := range queryParams {
+		typ, err := g.goType(p.Schema, e.Name+exportedName(p.Name), e.Operation+"?"+p.Name)
+		if err != nil {
+			return nil, err
+		}
+		if p.Required {
+			arg := unexportedName(p.Name)
+			e.Params = append(e.Params, arg+" "+typ)
+			e.Query = append(e.Query, [2]string{p.Name, arg})
+			continue
+		}
+		optional = append(optional, &field{Name: exportedName(p.Name), Type: typ, JSON: p.Name})
+	}
+	if len(optional) > 0 {
+		e.QueryParams = &typeDecl{Name: e.Name + "Params", Fields: optional}
+		e.Params = append(e.Params, "params *"+e.QueryParams.Name)
+	}
+
+	if rb := r.Operation.RequestBody; rb != nil {
+		schema := openapi.JSONSchema(rb.Content)
+		if mt := rb.Content["multipart/form-data"]; schema == nil && mt != nil {
+			schema, e.Multipart = mt.Schema, true
+		}
+		if schema == nil {
+			return nil, fmt.Errorf("unsupported request body")
+		}
+		typ, err := g.goType(schema, e.Name+"Body", e.Operation+" request body")
+		if err != nil {
+			return nil, err
+		}
+		typ = strings.TrimPrefix(typ, "*")
+		if e.Multipart && !g.multipart[typ] {
+			return nil, fmt.Errorf("multipart body must reference a schema")
+		}
+		e.Body = typ
+		e.Params = append(e.Params, "body "+typ)
+	}
+
+	_, resp := g.doc.SuccessResponse(r.Operation)
+	if resp != nil && len(resp.Content) > 0 {
+		if schema := openapi.JSONSchema(resp.Content); schema != nil {
+			typ, err := g.goType(schema, e.Name+"Response", e.Operation+" response")
+			if err != nil {
+				return nil, err
+			}
+			e.Result = typ
+		} else {
+			var types []string
+			for ct := range resp.Content {
+				types = append(types, ct)
+			}
+			sort.Strings(types)
+			e.Binary, e.Accept = true, strings.Join(types, ", ")
+			e.Params = append(e.Params, "w io.Writer")
+		}
+	}
+	return e, nil
+}
+
+func (g *generator) endpoints(endpoints []*endpoint) []byte {
+	g.imports = map[string]bool{"context": true, "net/http": true}
+
+	var body bytes.Buffer
+	for _, e := range endpoints {
+		if e.QueryParams != nil {
+			fmt.Fprintf(&body, "\n// %s are the optional query parameters of %s\ntype %s struct {\n", e.QueryParams.Name, e.Name, e.QueryParams.Name)
+			for _, f := range e.QueryParams.Fields {
+				fmt.Fprintf(&body, "\t%s %s\n", f.Name, f.Type)
+				g.use(f.Type)
+			}
+			fmt.Fprintf(&body, "}\n\nfunc (p *%s) encode(q url.Values) {\n\tif p == nil {\n\t\treturn\n\t}\n", e.QueryParams.Name)
+			for _, f := range e.QueryParams.Fields {
+				fmt.Fprintf(&body, "\tsetQuery(q, %q, p.%s)\n", f.JSON, f.Name)
+			}
+			body.WriteString("}\n")
+			g.imports["net/url"] = true
+		}
+
+		body.WriteString("\n")
+		writeDoc(&body, e.Name, e.Summary, fmt.Sprintf("%s calls the %s operation", e.Name, e.Operation))
+		fmt.Fprintf(&body, "// %s %s\n", e.Method, e.Path)
+
+		results := "error"
+		if e.Result != "" {
+			results = "(" + e.Result + ", error)"
+			g.use(e.Result)
+		}
+		fmt.Fprintf(&body, "func (c *Client) %s(%s) %s {\n", e.Name, strings.Join(append([]string{"ctx context.Context"}, e.Params...), ", "), results)
+
+		fmt.Fprintf(&body, "\treq := request{\n\t\tmethod: %s,\n\t\tpath: %s,\n\t\taccept: %q,\n", methodConst(e.Method), e.PathExpr, e.Accept)
+		if strings.Contains(e.PathExpr, "url.") {
+			g.imports["net/url"] = true
+		}
+		if e.Body != "" {
+			if e.Multipart {
+				body.WriteString("\t\tmultipart: body.multipartFields(),\n")
+			} else {
+				body.WriteString("\t\tjson: body,\n")
+			}
+		}
+		body.WriteString("\t}\n")
+		if len(e.Query) > 0 || e.QueryParams != nil {
+			g.imports["net/url"] = true
+			body.WriteString("\treq.query = make(url.Values)\n")
+			for _, q := range e.Query {
+				fmt.Fprintf(&body, "\tsetQuery(req.query, %q, %s)\n", q[0], q[1])
+			}
+			if e.QueryParams != nil {
+				body.WriteString("\tparams.encode(req.query)\n")
+			}
+		}
+
+		switch {
+		case e.Binary:
+			g.imports["io"] = true
+			body.WriteString("\treturn c.do(ctx, req, w)\n")
+		case e.Result == "":
+			body.WriteString("\treturn c.do(ctx, req, nil)\n")
+		case strings.HasPrefix(e.Result, "*"):
+			fmt.Fprintf(&body, "\tvar out %s\n\tif err := c.do(ctx, req, &out); err != nil {\n\t\treturn nil, err\n\t}\n\treturn &out, nil\n", e.Result[1:])
+		default:
+			fmt.Fprintf(&body, "\tvar out %s\n\terr := c.do(ctx, req, &out)\n\treturn out, err\n", e.Result)
+		}
+		body.WriteString("}\n")
+	}
+	return g.file(body.Bytes())
+}
+
+// source describes the location of a schema for doc comments.
+func source(src string) string {
+	switch {
+	case strings.Contains(src, "?"):
+		i := strings.Index(src, "?")
+		return fmt.Sprintf("the %s parameter of %s", src[i+1:], src[:i])
+	case strings.Contains(src, " "):
+		return "the " + src
+	case strings.Contains(src, "."):
+		return "the " + src + " property"
+	}
+	return "the " + src + " schema"
+}
+
+func methodConst(method string) string {
+	return "http.Method" + method[:1] + strings.ToLower(method[1:])
+}
+
+// initialisms are the words written in upper case in Go names.
+var initialisms = map[string]bool{
+	"aml": true, "api": true, "id": true, "ip": true, "json": true, "pdf": true,
+	"qes": true, "ssn": true, "uri": true, "url": true, "us": true, "uuid": true,
+}
+
+// words splits snake_case, dotted and CamelCase names into lower case words.
+func words(s string) []string {
+	var out []string
+	var cur []rune
+	flush := func() {
+		if len(cur) > 0 {
+			out = append(out, strings.ToLower(string(cur)))
+			cur = nil
+		}
+	}
+	runes := []rune(s)
+	for i, r := range runes {
+		switch {
+		case !unicode.IsLetter(r) && !unicode.IsDigit(r):
+			flush()
+		case unicode.IsUpper(r) && i > 0 && (unicode.IsLower(runes[i-1]) || i+1 < len(runes) && unicode.IsLower(runes[i+1]) && unicode.IsUpper(runes[i-1])):
+			flush()
+			cur = append(cur, r)
+		default:
+			cur = append(cur, r)
+		}
+	}
+	flush()
+	return out
+}
+
+// exportedName returns the exported Go name of an API name, e.g. IDNumbers for id_numbers.
+func exportedName(s string) string {
+	var b strings.Builder
+	for _, w := range words(s) {
+		if initialisms[w] {
+			b.WriteString(strings.ToUpper(w))
+		} else {
+			b.WriteString(strings.ToUpper(w[:1]) + w[1:])
+		}
+	}
+	name := b.String()
+	if name == "" || unicode.IsDigit(rune(name[0])) {
+		name = "X" + name
+	}
+	return name
+}
+
+// unexportedName returns the name of an argument, e.g. applicantID for applicant_id.
+func unexportedName(s string) string {
+	ws := words(s)
+	first := ws[0]
+	ws = ws[1:]
+	name := exportedName(strings.Join(ws, "_"))
+	if len(ws) == 0 {
+		name = ""
+	}
+	return first + name
+}
+
+// typeName returns the Go name of a component schema.
+func typeName(s string) string {
+	return exportedName(s)
+}
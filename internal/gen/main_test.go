@@ -0,0 +1,123 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mbowman100/go-onfido/internal/openapi"
+)
+
+// TestGenerate_UpToDate fails when the specification or the generator changed
+// without running go generate in onfidoapi.
+func TestGenerate_UpToDate(t *testing.T) {
+	doc, err := openapi.Load("../../openapi/onfido-v3.5.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	files, err := generate(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, want := range files {
+		got, err := ioutil.ReadFile(filepath.Join("../../onfidoapi", name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(want, got) {
+			t.Errorf("onfidoapi/%s is out of date, run go generate ./onfidoapi", name)
+		}
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	doc, err := openapi.Parse([]byte(`{
+  "openapi": "3.0.0",
+  "paths": {
+    "/things/{thing_id}": {
+      "parameters": [{"name": "thing_id", "in": "path", "required": true, "schema": {"type": "string"}}],
+      "get": {
+        "operationId": "find_thing",
+        "summary": "Retrieves a thing.",
+        "parameters": [{"name": "expand", "in": "query", "schema": {"type": "boolean"}}],
+        "responses": {"200": {"description": "OK", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/Thing"}}}}}
+      }
+    }
+  },
+  "components": {"schemas": {"Thing": {"type": "object", "required": ["id"], "properties": {
+    "id": {"type": "string"},
+    "kind": {"type": "string", "enum": ["big", "small"]},
+    "created_at": {"type": "string", "format": "date-time"}
+  }}}}
+}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	files, err := generate(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	models := string(files[modelsFile])
+	for _, s := range []string{
+		"type Thing struct {",
+		"ID        string     `json:\"id\"`",
+		"Kind      ThingKind  `json:\"kind,omitempty\"`",
+		"CreatedAt *time.Time `json:\"created_at,omitempty\"`",
+		"// ThingKind is generated from the Thing.kind property",
+		"ThingKindBig   ThingKind = \"big\"",
+	} {
+		assert.Contains(t, models, s)
+	}
+
+	endpoints := string(files[endpointsFile])
+	for _, s := range []string{
+		"// FindThing calls the find_thing operation.\n// Retrieves a thing.\n// GET /things/{thing_id}\n",
+		"func (c *Client) FindThing(ctx context.Context, thingID string, params *FindThingParams) (*Thing, error) {",
+		`path:   "/things/" + url.PathEscape(thingID),`,
+		`setQuery(q, "expand", p.Expand)`,
+	} {
+		assert.Contains(t, endpoints, s)
+	}
+}
+
+func TestGenerate_Errors(t *testing.T) {
+	for name, spec := range map[string]string{
+		"missing operationId":       `{"openapi": "3.0.0", "paths": {"/a": {"get": {"responses": {}}}}}`,
+		"undeclared path parameter": `{"openapi": "3.0.0", "paths": {"/a/{id}": {"get": {"operationId": "a", "responses": {}}}}}`,
+		"unresolved reference": `{"openapi": "3.0.0", "paths": {}, "components": {"schemas": {
+			"A": {"type": "object", "properties": {"b": {"$ref": "#/components/schemas/B"}}}}}}`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			doc, err := openapi.Parse([]byte(spec))
+			if err != nil {
+				t.Fatal(err)
+			}
+			_, err = generate(doc)
+			if assert.Error(t, err) {
+				assert.True(t, strings.Contains(err.Error(), name), err.Error())
+			}
+		})
+	}
+}
+
+func TestNames(t *testing.T) {
+	for in, want := range map[string]string{
+		"id_numbers":                        "IDNumbers",
+		"IdPhoto":                           "IDPhoto",
+		"UsDrivingLicence":                  "USDrivingLicence",
+		"download_qes_document":             "DownloadQESDocument",
+		"workflow_run.completed":            "WorkflowRunCompleted",
+		"watchlist_aml":                     "WatchlistAML",
+		"document_with_address_information": "DocumentWithAddressInformation",
+		"2fa":                               "X2fa",
+	} {
+		assert.Equal(t, want, exportedName(in), in)
+	}
+	assert.Equal(t, "workflowRunID", unexportedName("workflow_run_id"))
+	assert.Equal(t, "postcode", unexportedName("postcode"))
+}
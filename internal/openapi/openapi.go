@@ -0,0 +1,406 @@
+// Package openapi loads the subset of OpenAPI 3 documents used to check
+// and generate the client against Onfido's API specification.
+package openapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Document is an OpenAPI 3 document
+type Document struct {
+	OpenAPI    string               `json:"openapi"`
+	Info       Info                 `json:"info"`
+	Paths      map[string]*PathItem `json:"paths"`
+	Components Components           `json:"components"`
+}
+
+// Info is the metadata of a document
+type Info struct {
+	Title       string `json:"title"`
+	Version     string `json:"version"`
+	Description string `json:"description,omitempty"`
+}
+
+// Components holds the reusable objects of a document
+type Components struct {
+	Schemas    map[string]*Schema    `json:"schemas"`
+	Parameters map[string]*Parameter `json:"parameters"`
+	Responses  map[string]*Response  `json:"responses"`
+}
+
+// PathItem holds the operations of a path
+type PathItem struct {
+	Parameters []*Parameter `json:"parameters"`
+	Get        *Operation   `json:"get"`
+	Put        *Operation   `json:"put"`
+	Post       *Operation   `json:"post"`
+	Delete     *Operation   `json:"delete"`
+	Patch      *Operation   `json:"patch"`
+}
+
+// Operations returns the operations of the path by HTTP method.
+func (p *PathItem) Operations() map[string]*Operation {
+	ops := make(map[string]*Operation)
+	for method, op := range map[string]*Operation{
+		http.MethodGet:    p.Get,
+		http.MethodPut:    p.Put,
+		http.MethodPost:   p.Post,
+		http.MethodDelete: p.Delete,
+		http.MethodPatch:  p.Patch,
+	} {
+		if op != nil {
+			ops[method] = op
+		}
+	}
+	return ops
+}
+
+// Operation is an API operation
+type Operation struct {
+	OperationID string               `json:"operationId"`
+	Summary     string               `json:"summary,omitempty"`
+	Description string               `json:"description,omitempty"`
+	Parameters  []*Parameter         `json:"parameters"`
+	RequestBody *RequestBody         `json:"requestBody"`
+	Responses   map[string]*Response `json:"responses"`
+}
+
+// Parameter is a path, query or header parameter
+type Parameter struct {
+	Ref      string  `json:"$ref,omitempty"`
+	Name     string  `json:"name"`
+	In       string  `json:"in"`
+	Required bool    `json:"required"`
+	Schema   *Schema `json:"schema"`
+}
+
+// RequestBody is the body of an operation's request
+type RequestBody struct {
+	Required bool                  `json:"required"`
+	Content  map[string]*MediaType `json:"content"`
+}
+
+// Response is a response of an operation
+type Response struct {
+	Ref         string                `json:"$ref,omitempty"`
+	Description string                `json:"description"`
+	Content     map[string]*MediaType `json:"content"`
+}
+
+// MediaType is the schema of a body with a given content type
+type MediaType struct {
+	Schema *Schema `json:"schema"`
+}
+
+// Schema is a JSON schema
+type Schema struct {
+	Ref         string             `json:"$ref,omitempty"`
+	Type        string             `json:"type,omitempty"`
+	Format      string             `json:"format,omitempty"`
+	Description string             `json:"description,omitempty"`
+	Enum        []interface{}      `json:"enum,omitempty"`
+	Required    []string           `json:"required,omitempty"`
+	Nullable    bool               `json:"nullable,omitempty"`
+	Properties  map[string]*Schema `json:"properties,omitempty"`
+	Items       *Schema            `json:"items,omitempty"`
+	AllOf       []*Schema          `json:"allOf,omitempty"`
+	OneOf       []*Schema          `json:"oneOf,omitempty"`
+	AnyOf       []*Schema          `json:"anyOf,omitempty"`
+	// AdditionalProperties is either a boolean or a schema.
+	AdditionalProperties json.RawMessage `json:"additionalProperties,omitempty"`
+}
+
+// Load reads the JSON or YAML document at path.
+func Load(path string) (*Document, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return Parse(b)
+}
+
+// Parse parses a JSON or YAML document.
+func Parse(b []byte) (*Document, error) {
+	b, err := ToJSON(b)
+	if err != nil {
+		return nil, fmt.Errorf("invalid openapi document: %w", err)
+	}
+	var d Document
+	if err := json.Unmarshal(b, &d); err != nil {
+		return nil, fmt.Errorf("invalid openapi document: %w", err)
+	}
+	if !strings.HasPrefix(d.OpenAPI, "3.") {
+		return nil, fmt.Errorf("unsupported openapi version %q", d.OpenAPI)
+	}
+	return &d, nil
+}
+
+// ToJSON returns the JSON of a JSON or YAML document, upstream specifications
+// are published as YAML. JSON documents are returned as is.
+func ToJSON(b []byte) ([]byte, error) {
+	if json.Valid(b) {
+		return b, nil
+	}
+	var v interface{}
+	if err := yaml.Unmarshal(b, &v); err != nil {
+		return nil, err
+	}
+	v, err := jsonValue(v)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(v)
+}
+
+// jsonValue converts a decoded YAML value to one encoding/json can marshal:
+// mappings with non-string keys, such as response codes, get string keys.
+func jsonValue(v interface{}) (interface{}, error) {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, e := range v {
+			e, err := jsonValue(e)
+			if err != nil {
+				return nil, err
+			}
+			v[k] = e
+		}
+		return v, nil
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, e := range v {
+			e, err := jsonValue(e)
+			if err != nil {
+				return nil, err
+			}
+			m[fmt.Sprint(k)] = e
+		}
+		return m, nil
+	case []interface{}:
+		for i, e := range v {
+			e, err := jsonValue(e)
+			if err != nil {
+				return nil, err
+			}
+			v[i] = e
+		}
+		return v, nil
+	}
+	return v, nil
+}
+
+// RefName returns the name of the component referenced by ref,
+// e.g. "Applicant" for "#/components/schemas/Applicant".
+func RefName(ref string) string {
+	return ref[strings.LastIndex(ref, "/")+1:]
+}
+
+// maxRefDepth bounds the references followed when resolving, in case of cycles.
+const maxRefDepth = 32
+
+// Resolve follows the schema's $ref to the referenced component schema.
+// It returns nil if a reference can't be resolved.
+func (d *Document) Resolve(s *Schema) *Schema {
+	for i := 0; s != nil && s.Ref != ""; i++ {
+		if i == maxRefDepth {
+			return nil
+		}
+		s = d.Components.Schemas[RefName(s.Ref)]
+	}
+	return s
+}
+
+// Properties returns the properties of an object schema, including those of
+// its allOf schemas.
+func (d *Document) Properties(s *Schema) map[string]*Schema {
+	props := make(map[string]*Schema)
+	d.collectProperties(s, props, 0)
+	return props
+}
+
+func (d *Document) collectProperties(s *Schema, props map[string]*Schema, depth int) {
+	s = d.Resolve(s)
+	if s == nil || depth > maxRefDepth {
+		return
+	}
+	for _, sub := range s.AllOf {
+		d.collectProperties(sub, props, depth+1)
+	}
+	for name, p := range s.Properties {
+		props[name] = p
+	}
+}
+
+// RequiredProperties returns the required properties of an object schema,
+// including those of its allOf schemas.
+func (d *Document) RequiredProperties(s *Schema) map[string]bool {
+	required := make(map[string]bool)
+	var collect func(s *Schema, depth int)
+	collect = func(s *Schema, depth int) {
+		s = d.Resolve(s)
+		if s == nil || depth > maxRefDepth {
+			return
+		}
+		for _, sub := range s.AllOf {
+			collect(sub, depth+1)
+		}
+		for _, name := range s.Required {
+			required[name] = true
+		}
+	}
+	collect(s, 0)
+	return required
+}
+
+// TypeOf returns the JSON type of a schema: "string", "integer", "number",
+// "boolean", "array" or "object", or "" when any type is allowed.
+func (d *Document) TypeOf(s *Schema) string {
+	s = d.Resolve(s)
+	if s == nil {
+		return ""
+	}
+	if s.Type != "" {
+		return s.Type
+	}
+	if len(s.Properties) > 0 {
+		return "object"
+	}
+	for _, sub := range s.AllOf {
+		if t := d.TypeOf(sub); t != "" {
+			return t
+		}
+	}
+	return ""
+}
+
+// Route is an operation with its HTTP method, path template and parameters
+type Route struct {
+	Method    string
+	Path      string
+	Operation *Operation
+	// Parameters are the path item's and the operation's parameters.
+	Parameters []*Parameter
+}
+
+// Routes returns the routes of the document, sorted by path and method.
+func (d *Document) Routes() []*Route {
+	var routes []*Route
+	for path, item := range d.Paths {
+		for method, op := range item.Operations() {
+			routes = append(routes, &Route{
+				Method:     method,
+				Path:       path,
+				Operation:  op,
+				Parameters: d.parameters(item.Parameters, op.Parameters),
+			})
+		}
+	}
+	sort.Slice(routes, func(i, j int) bool {
+		if routes[i].Path != routes[j].Path {
+			return routes[i].Path < routes[j].Path
+		}
+		return routes[i].Method < routes[j].Method
+	})
+	return routes
+}
+
+func (d *Document) parameters(lists ...[]*Parameter) []*Parameter {
+	var params []*Parameter
+	for _, list := range lists {
+		for _, p := range list {
+			if p.Ref != "" {
+				p = d.Components.Parameters[RefName(p.Ref)]
+			}
+			if p != nil {
+				params = append(params, p)
+			}
+		}
+	}
+	return params
+}
+
+// Find returns the route matching the request method and path (without the
+// version prefix), or nil. Literal path segments take precedence over templates.
+func (d *Document) Find(method, path string) *Route {
+	var (
+		best      *Route
+		bestScore = -1
+	)
+	segments := strings.Split(strings.TrimPrefix(path, "/"), "/")
+	for _, r := range d.Routes() {
+		if r.Method != method {
+			continue
+		}
+		if score, ok := matchPath(strings.Split(strings.TrimPrefix(r.Path, "/"), "/"), segments); ok && score > bestScore {
+			best, bestScore = r, score
+		}
+	}
+	return best
+}
+
+// HasPath reports whether any operation matches the path, whatever its method.
+func (d *Document) HasPath(path string) bool {
+	segments := strings.Split(strings.TrimPrefix(path, "/"), "/")
+	for tmpl := range d.Paths {
+		if _, ok := matchPath(strings.Split(strings.TrimPrefix(tmpl, "/"), "/"), segments); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// matchPath matches path segments against a template's, returning the number of literal segments.
+func matchPath(tmpl, segments []string) (int, bool) {
+	if len(tmpl) != len(segments) {
+		return 0, false
+	}
+	score := 0
+	for i, t := range tmpl {
+		switch {
+		case strings.HasPrefix(t, "{") && strings.HasSuffix(t, "}"):
+			if segments[i] == "" {
+				return 0, false
+			}
+		case t == segments[i]:
+			score++
+		default:
+			return 0, false
+		}
+	}
+	return score, true
+}
+
+// JSONSchema returns the schema of the JSON content, or nil.
+func JSONSchema(content map[string]*MediaType) *Schema {
+	for ct, mt := range content {
+		if strings.HasPrefix(ct, "application/json") && mt != nil {
+			return mt.Schema
+		}
+	}
+	return nil
+}
+
+// SuccessResponse returns the first 2xx response of the operation and its status code.
+func (d *Document) SuccessResponse(op *Operation) (string, *Response) {
+	var codes []string
+	for code := range op.Responses {
+		if strings.HasPrefix(code, "2") {
+			codes = append(codes, code)
+		}
+	}
+	if len(codes) == 0 {
+		return "", nil
+	}
+	sort.Strings(codes)
+	resp := op.Responses[codes[0]]
+	if resp != nil && resp.Ref != "" {
+		resp = d.Components.Responses[RefName(resp.Ref)]
+	}
+	return codes[0], resp
+}
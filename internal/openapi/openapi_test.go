@@ -0,0 +1,119 @@
+package openapi
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testDocument = `{
+  "openapi": "3.0.0",
+  "info": {"title": "Test", "version": "v1"},
+  "paths": {
+    "/items": {
+      "get": {"operationId": "list_items", "responses": {"200": {"description": "OK"}}}
+    },
+    "/items/{item_id}": {
+      "parameters": [{"name": "item_id", "in": "path", "required": true, "schema": {"type": "string"}}],
+      "get": {
+        "operationId": "find_item",
+        "parameters": [{"$ref": "#/components/parameters/Expand"}],
+        "responses": {"200": {"description": "OK", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/Item"}}}}}
+      }
+    },
+    "/items/special": {
+      "get": {"operationId": "special_item", "responses": {"204": {"description": "No Content"}}}
+    }
+  },
+  "components": {
+    "parameters": {"Expand": {"name": "expand", "in": "query", "schema": {"type": "boolean"}}},
+    "schemas": {
+      "Base": {"type": "object", "properties": {"id": {"type": "string"}}, "required": ["id"]},
+      "Item": {"allOf": [{"$ref": "#/components/schemas/Base"}, {"properties": {"count": {"type": "integer"}}}]},
+      "Loop": {"$ref": "#/components/schemas/Loop"}
+    }
+  }
+}`
+
+func TestParse(t *testing.T) {
+	d, err := Parse([]byte(testDocument))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := d.Find(http.MethodGet, "/items/123")
+	if assert.NotNil(t, r) {
+		assert.Equal(t, "find_item", r.Operation.OperationID)
+		assert.Len(t, r.Parameters, 2)
+		assert.Equal(t, "expand", r.Parameters[1].Name)
+	}
+	assert.Equal(t, "special_item", d.Find(http.MethodGet, "/items/special").Operation.OperationID)
+	assert.Nil(t, d.Find(http.MethodPost, "/items"))
+	assert.Nil(t, d.Find(http.MethodGet, "/items/"))
+	assert.True(t, d.HasPath("/items"))
+	assert.False(t, d.HasPath("/other"))
+
+	code, resp := d.SuccessResponse(r.Operation)
+	assert.Equal(t, "200", code)
+	item := JSONSchema(resp.Content)
+	assert.Equal(t, "object", d.TypeOf(item))
+	props := d.Properties(item)
+	assert.Len(t, props, 2)
+	assert.Equal(t, "integer", d.TypeOf(props["count"]))
+	assert.Equal(t, map[string]bool{"id": true}, d.RequiredProperties(item))
+
+	assert.Nil(t, d.Resolve(&Schema{Ref: "#/components/schemas/Loop"}))
+	assert.Len(t, d.Routes(), 3)
+}
+
+func TestParse_YAML(t *testing.T) {
+	d, err := Parse([]byte(`openapi: 3.0.0
+info:
+  title: Test
+  version: v1
+paths:
+  /items/{item_id}:
+    get:
+      operationId: find_item
+      responses:
+        200:
+          description: OK
+          content:
+            application/json:
+              schema:
+                $ref: '#/components/schemas/Item'
+components:
+  schemas:
+    Item:
+      type: object
+      properties:
+        id:
+          type: string
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := d.Find(http.MethodGet, "/items/123")
+	if assert.NotNil(t, r) {
+		code, resp := d.SuccessResponse(r.Operation)
+		assert.Equal(t, "200", code)
+		assert.Equal(t, "string", d.TypeOf(d.Properties(JSONSchema(resp.Content))["id"]))
+	}
+}
+
+func TestParse_UnsupportedVersion(t *testing.T) {
+	_, err := Parse([]byte(`{"swagger": "2.0"}`))
+	assert.Error(t, err)
+}
+
+func TestLoad_Onfido(t *testing.T) {
+	d, err := Load("../../openapi/onfido-v3.5.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, r := range d.Routes() {
+		assert.NotEmpty(t, r.Operation.OperationID, "%s %s", r.Method, r.Path)
+	}
+}
@@ -0,0 +1,85 @@
+// Command specsync vendors Onfido's OpenAPI specification from an artifact of
+// https://github.com/onfido/onfido-openapi-spec, given by URL or local path:
+//
+//	go run ./internal/specsync -src https://raw.githubusercontent.com/onfido/onfido-openapi-spec/<ref>/<artifact>
+//
+// The artifact, JSON or YAML, is validated and written as JSON to
+// openapi/onfido-v3.5.json, and its URL and SHA-256 to openapi/SOURCE. Then
+// regenerate onfidoapi and the contract report, see openapi/README.md.
+package main
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/mbowman100/go-onfido/internal/openapi"
+)
+
+func main() {
+	src := flag.String("src", "", "URL or path of the upstream specification artifact")
+	out := flag.String("out", "openapi/onfido-v3.5.json", "vendored specification")
+	source := flag.String("source", "openapi/SOURCE", "file recording the vendored artifact's URL and SHA-256")
+	flag.Parse()
+	if *src == "" {
+		flag.Usage()
+		log.Fatal("missing -src")
+	}
+
+	artifact, err := read(*src)
+	if err != nil {
+		log.Fatal(err)
+	}
+	spec, sum, err := vendor(artifact)
+	if err != nil {
+		log.Fatalf("%s: %v", *src, err)
+	}
+	if err := ioutil.WriteFile(*out, spec, 0644); err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile(*source, []byte(fmt.Sprintf("%s\nsha256 %s\n", *src, sum)), 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// read returns the artifact at the URL or path src.
+func read(src string) ([]byte, error) {
+	if !strings.HasPrefix(src, "https://") && !strings.HasPrefix(src, "http://") {
+		return ioutil.ReadFile(src)
+	}
+	resp, err := http.Get(src)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("GET %s: %s", src, resp.Status)
+	}
+	return ioutil.ReadAll(resp.Body)
+}
+
+// vendor returns the artifact as indented JSON, once it's checked to be an
+// OpenAPI 3 document, and the hex SHA-256 of the artifact.
+func vendor(artifact []byte) ([]byte, string, error) {
+	if _, err := openapi.Parse(artifact); err != nil {
+		return nil, "", err
+	}
+	b, err := openapi.ToJSON(artifact)
+	if err != nil {
+		return nil, "", err
+	}
+	var spec bytes.Buffer
+	if err := json.Indent(&spec, b, "", "  "); err != nil {
+		return nil, "", err
+	}
+	spec.WriteByte('\n')
+	sum := sha256.Sum256(artifact)
+	return spec.Bytes(), hex.EncodeToString(sum[:]), nil
+}
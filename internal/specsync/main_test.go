@@ -0,0 +1,19 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVendor(t *testing.T) {
+	spec, sum, err := vendor([]byte("openapi: 3.0.0\ninfo:\n  title: Test\n  version: v1\npaths: {}\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "{\n  \"info\": {\n    \"title\": \"Test\",\n    \"version\": \"v1\"\n  },\n  \"openapi\": \"3.0.0\",\n  \"paths\": {}\n}\n", string(spec))
+	assert.Len(t, sum, 64)
+
+	_, _, err = vendor([]byte(`{"swagger": "2.0"}`))
+	assert.Error(t, err)
+}
@@ -0,0 +1,127 @@
+package onfido
+
+import "context"
+
+// cannedIter is an Iter over canned pages, which fails with pagesErr (if not
+// nil) once they are exhausted.
+type cannedIter struct {
+	pages    [][]interface{}
+	pagesErr error
+
+	values []interface{}
+	cur    interface{}
+	err    error
+}
+
+// newCannedIter returns an iterator over the given pages, which fails with err
+// (if not nil) once they are exhausted.
+func newCannedIter[T any](pages [][]T, err error) *cannedIter {
+	values := make([][]interface{}, len(pages))
+	for i, page := range pages {
+		for _, v := range page {
+			values[i] = append(values[i], v)
+		}
+	}
+	return &cannedIter{pages: values, pagesErr: err}
+}
+
+func (it *cannedIter) Current() interface{} {
+	return it.cur
+}
+
+func (it *cannedIter) Err() error {
+	return it.err
+}
+
+func (it *cannedIter) Next(ctx context.Context) bool {
+	if it.err != nil {
+		return false
+	}
+	for len(it.values) == 0 && len(it.pages) > 0 {
+		if err := ctx.Err(); err != nil {
+			it.err = err
+			return false
+		}
+		it.values, it.pages = it.pages[0], it.pages[1:]
+	}
+	if len(it.values) == 0 {
+		it.err = it.pagesErr
+		return false
+	}
+	it.cur = it.values[0]
+	it.values = it.values[1:]
+	return true
+}
+
+// The New*Iter funcs return iterators over canned pages, for fakes and mocks of
+// OnfidoClient. The iterator fails with err (if not nil) after the last page.
+
+// NewPickerIter returns an iterator over canned pages of addresses.
+func NewPickerIter(pages [][]*Address, err error) *PickerIter {
+	return &PickerIter{newCannedIter(pages, err)}
+}
+
+// NewLiveVideoIter returns an iterator over canned pages of live videos.
+func NewLiveVideoIter(pages [][]*LiveVideo, err error) LiveVideoIter {
+	return &liveVideoIter{newCannedIter(pages, err)}
+}
+
+// NewApplicantIter returns an iterator over canned pages of applicants.
+func NewApplicantIter(pages [][]*Applicant, err error) *ApplicantIter {
+	return &ApplicantIter{newCannedIter(pages, err)}
+}
+
+// NewCheckIter returns an iterator over canned pages of checks.
+func NewCheckIter(pages [][]*Check, err error) *CheckIter {
+	return &CheckIter{newCannedIter(pages, err)}
+}
+
+// NewDocumentIter returns an iterator over canned pages of documents.
+func NewDocumentIter(pages [][]*Document, err error) *DocumentIter {
+	return &DocumentIter{newCannedIter(pages, err)}
+}
+
+// NewIDPhotoIter returns an iterator over canned pages of ID photos.
+func NewIDPhotoIter(pages [][]*IDPhoto, err error) *IDPhotoIter {
+	return &IDPhotoIter{newCannedIter(pages, err)}
+}
+
+// NewLivePhotoIter returns an iterator over canned pages of live photos.
+func NewLivePhotoIter(pages [][]*LivePhoto, err error) *LivePhotoIter {
+	return &LivePhotoIter{newCannedIter(pages, err)}
+}
+
+// NewMotionCaptureIter returns an iterator over canned pages of motion captures.
+func NewMotionCaptureIter(pages [][]*MotionCapture, err error) *MotionCaptureIter {
+	return &MotionCaptureIter{newCannedIter(pages, err)}
+}
+
+// NewReportIter returns an iterator over canned pages of reports.
+func NewReportIter(pages [][]*Report, err error) *ReportIter {
+	return &ReportIter{newCannedIter(pages, err)}
+}
+
+// NewSigningDocumentIter returns an iterator over canned pages of signing documents.
+func NewSigningDocumentIter(pages [][]*SigningDocument, err error) *SigningDocumentIter {
+	return &SigningDocumentIter{newCannedIter(pages, err)}
+}
+
+// NewTaskIter returns an iterator over canned pages of tasks.
+func NewTaskIter(pages [][]*Task, err error) *TaskIter {
+	return &TaskIter{newCannedIter(pages, err)}
+}
+
+// NewWatchlistMonitorIter returns an iterator over canned pages of watchlist monitors.
+func NewWatchlistMonitorIter(pages [][]*WatchlistMonitor, err error) *WatchlistMonitorIter {
+	return &WatchlistMonitorIter{newCannedIter(pages, err)}
+}
+
+// NewWebhookRefIter returns an iterator over canned pages of webhooks.
+func NewWebhookRefIter(pages [][]*WebhookRef, err error) *WebhookRefIter {
+	return &WebhookRefIter{newCannedIter(pages, err)}
+}
+
+// NewWorkflowRunIter returns an iterator over canned pages of workflow runs.
+func NewWorkflowRunIter(pages [][]*WorkflowRun, err error) *WorkflowRunIter {
+	return &WorkflowRunIter{newCannedIter(pages, err)}
+}
@@ -0,0 +1,41 @@
+package onfido
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewApplicantIter(t *testing.T) {
+	errBoom := errors.New("boom")
+	it := NewApplicantIter([][]*Applicant{
+		{{ID: "1"}, {ID: "2"}},
+		{},
+		{{ID: "3"}},
+	}, errBoom)
+
+	var ids []string
+	for it.Next(context.Background()) {
+		ids = append(ids, it.Applicant().ID)
+	}
+	assert.Equal(t, []string{"1", "2", "3"}, ids)
+	assert.Equal(t, errBoom, it.Err())
+}
+
+func TestNewLiveVideoIter_Empty(t *testing.T) {
+	it := NewLiveVideoIter(nil, nil)
+	assert.False(t, it.Next(context.Background()))
+	assert.NoError(t, it.Err())
+}
+
+func TestNewCheckIter_ContextCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	it := NewCheckIter([][]*Check{{{ID: "1"}}, {{ID: "2"}}}, nil)
+
+	assert.True(t, it.Next(ctx))
+	cancel()
+	assert.False(t, it.Next(ctx))
+	assert.Equal(t, context.Canceled, it.Err())
+}
@@ -1,7 +1,13 @@
 package onfido
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
 	"time"
 )
 
@@ -16,9 +22,70 @@ type LivePhoto struct {
 	FileSize     int32      `json:"file_size,omitempty"`
 }
 
+// LivePhotoRequest represents a live photo upload request to Onfido API
+type LivePhotoRequest struct {
+	ApplicantID string
+	File        io.ReadSeeker
+	// SkipAdvancedValidation sends advanced_validation=false, which skips Onfido's
+	// check that the photo contains exactly one face.
+	SkipAdvancedValidation bool
+}
+
+type LivePhotoDownload struct {
+	// Data is the binary data of the live photo
+	Data []byte
+}
+
+// UploadLivePhoto uploads a live photo for the provided applicant.
+// see https://documentation.onfido.com/#upload-live-photo
+func (c *client) UploadLivePhoto(ctx context.Context, lr LivePhotoRequest) (*LivePhoto, error) {
+	req, err := c.newMultipartRequest("/live_photos", lr.File,
+		formField{"applicant_id", lr.ApplicantID},
+		formField{"advanced_validation", strconv.FormatBool(!lr.SkipAdvancedValidation)},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp LivePhoto
+	_, err = c.do(ctx, req, &resp)
+	return &resp, err
+}
+
+// GetLivePhoto retrieves a single live photo by its ID.
+// see https://documentation.onfido.com/#retrieve-live-photo
+func (c *client) GetLivePhoto(ctx context.Context, id string) (*LivePhoto, error) {
+	req, err := c.newRequest(http.MethodGet, "/live_photos/"+id, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp LivePhoto
+	_, err = c.do(ctx, req, &resp)
+	return &resp, err
+}
+
+// DownloadLivePhoto returns the binary data representing the live photo.
+// see https://documentation.onfido.com/#download-live-photo
+func (c *client) DownloadLivePhoto(ctx context.Context, id string) (*LivePhotoDownload, error) {
+	req, err := c.newRequest(http.MethodGet, "/live_photos/"+id+"/download", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp bytes.Buffer
+	_, err = c.do(ctx, req, &resp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download live photo: %w", err)
+	}
+	return &LivePhotoDownload{
+		Data: resp.Bytes(),
+	}, err
+}
+
 // LivePhotoIter represents a LivePhoto iterator
 type LivePhotoIter struct {
-	*iter
+	Iter
 }
 
 // LivePhoto returns the current item in the iterator as a LivePhoto.
@@ -26,7 +93,7 @@ func (i *LivePhotoIter) LivePhoto() *LivePhoto {
 	return i.Current().(*LivePhoto)
 }
 
-// ListPhotos retrieves the list of photos for the provided applicant.
+// ListLivePhotos retrieves the list of photos for the provided applicant.
 // see https://documentation.onfido.com/?shell#live-photos
 func (c *client) ListLivePhotos(applicantID string) *LivePhotoIter {
 	return &LivePhotoIter{&iter{
@@ -1,6 +1,7 @@
 package onfido
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"net/http"
@@ -66,3 +67,125 @@ func TestLivePhotos_List(t *testing.T) {
 		t.Fatal(it.Err())
 	}
 }
+
+func TestLivePhotos_Upload(t *testing.T) {
+	applicantID := "541d040b-89f8-444b-8921-16b1333bf1c6"
+	png := []byte("\x89PNG\r\n\x1a\n0000")
+
+	m := mux.NewRouter()
+	m.HandleFunc("/live_photos", func(w http.ResponseWriter, r *http.Request) {
+		assert.NoError(t, r.ParseMultipartForm(1<<20))
+		assert.Equal(t, applicantID, r.FormValue("applicant_id"))
+		assert.Equal(t, "false", r.FormValue("advanced_validation"))
+		_, fh, err := r.FormFile("file")
+		if assert.NoError(t, err) {
+			assert.Equal(t, "image/png", fh.Header.Get("Content-Type"))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, wErr := w.Write([]byte(`{"id": "photo-1", "file_type": "image/png", "file_size": 12}`))
+		assert.NoError(t, wErr)
+	}).Methods("POST")
+	srv := httptest.NewServer(m)
+	defer srv.Close()
+
+	client := NewClient("123").(*client)
+	client.endpoint = srv.URL
+
+	p, err := client.UploadLivePhoto(context.Background(), LivePhotoRequest{
+		ApplicantID:            applicantID,
+		File:                   bytes.NewReader(png),
+		SkipAdvancedValidation: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "photo-1", p.ID)
+	assert.Equal(t, int32(12), p.FileSize)
+}
+
+func TestLivePhotos_UploadNonOKResponse(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		_, wErr := w.Write([]byte(`{"error": {"type": "validation_error", "message": "No face found"}}`))
+		assert.NoError(t, wErr)
+	}))
+	defer srv.Close()
+
+	client := NewClient("123").(*client)
+	client.endpoint = srv.URL
+
+	_, err := client.UploadLivePhoto(context.Background(), LivePhotoRequest{
+		File: bytes.NewReader([]byte("test")),
+	})
+	if err == nil {
+		t.Fatal("expected server to return non ok response, got successful response")
+	}
+	assert.Equal(t, "No face found", err.Error())
+}
+
+func TestLivePhotos_Get(t *testing.T) {
+	m := mux.NewRouter()
+	m.HandleFunc("/live_photos/{id}", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "photo-1", mux.Vars(r)["id"])
+		w.Header().Set("Content-Type", "application/json")
+		_, wErr := w.Write([]byte(`{"id": "photo-1", "download_href": "/v3.5/live_photos/photo-1/download"}`))
+		assert.NoError(t, wErr)
+	}).Methods("GET")
+	srv := httptest.NewServer(m)
+	defer srv.Close()
+
+	client := NewClient("123").(*client)
+	client.endpoint = srv.URL
+
+	p, err := client.GetLivePhoto(context.Background(), "photo-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "/v3.5/live_photos/photo-1/download", p.DownloadHref)
+}
+
+func TestLivePhotos_Download(t *testing.T) {
+	m := mux.NewRouter()
+	m.HandleFunc("/live_photos/{id}/download", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "image/png")
+		_, wErr := w.Write([]byte("image data"))
+		assert.NoError(t, wErr)
+	}).Methods("GET")
+	srv := httptest.NewServer(m)
+	defer srv.Close()
+
+	client := NewClient("123").(*client)
+	client.endpoint = srv.URL
+
+	d, err := client.DownloadLivePhoto(context.Background(), "photo-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []byte("image data"), d.Data)
+}
+
+func TestLivePhoto_RoundTrip(t *testing.T) {
+	// A live photo object of the v3.5 API, with every field of its schema.
+	const v35 = `{
+		"id": "7410a943-8f00-43d8-98de-36a774196d86",
+		"created_at": "2024-01-31T10:00:00Z",
+		"href": "/v3.5/live_photos/7410a943-8f00-43d8-98de-36a774196d86",
+		"download_href": "/v3.5/live_photos/7410a943-8f00-43d8-98de-36a774196d86/download",
+		"file_name": "selfie.jpg",
+		"file_type": "image/jpeg",
+		"file_size": 47544
+	}`
+
+	var lp LivePhoto
+	if err := json.Unmarshal([]byte(v35), &lp); err != nil {
+		t.Fatal(err)
+	}
+	b, err := json.Marshal(lp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.JSONEq(t, v35, string(b))
+}
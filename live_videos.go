@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"time"
 )
@@ -26,6 +27,25 @@ type LiveVideoDownload struct {
 	Data []byte
 }
 
+// GetLiveVideo retrieves a single live video by its ID.
+// see https://documentation.onfido.com/#retrieve-live-video
+func (c *client) GetLiveVideo(ctx context.Context, id string) (*LiveVideo, error) {
+	req, err := c.newRequest(http.MethodGet, "/live_videos/"+id, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp LiveVideo
+	_, err = c.do(ctx, req, &resp)
+	return &resp, err
+}
+
+// DownloadLiveVideoFrame streams a single frame of the live video, as a JPEG image, into w.
+// see https://documentation.onfido.com/#download-live-video-frame
+func (c *client) DownloadLiveVideoFrame(ctx context.Context, id string, w io.Writer) error {
+	return c.download(ctx, "/live_videos/"+id+"/frame", w, "live video frame")
+}
+
 // DownloadLiveVideo returns the binary data representing the video.
 // see https://documentation.onfido.com/#download-live-video
 func (c *client) DownloadLiveVideo(ctx context.Context, id string) (*LiveVideoDownload, error) {
@@ -46,7 +66,7 @@ func (c *client) DownloadLiveVideo(ctx context.Context, id string) (*LiveVideoDo
 
 // liveVideoIter represents a LiveVideo iterator
 type liveVideoIter struct {
-	*iter
+	Iter
 }
 
 type LiveVideoIter interface {
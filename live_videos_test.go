@@ -1,6 +1,7 @@
 package onfido
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"github.com/gorilla/mux"
@@ -91,3 +92,46 @@ func TestListLiveVideos(t *testing.T) {
 		t.Fatal(it.Err())
 	}
 }
+
+func TestGetLiveVideo(t *testing.T) {
+	mockVideoID := "93672a37-8223-48b9-a440-3b5cb52a8e4b"
+	m := mux.NewRouter()
+	m.HandleFunc("/live_videos/{videoId}", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, mockVideoID, mux.Vars(r)["videoId"])
+		w.Header().Set("Content-Type", "application/json")
+		_, wErr := w.Write([]byte(`{"id": "` + mockVideoID + `", "file_type": "video/mp4", "file_size": 1024}`))
+		assert.NoError(t, wErr)
+	}).Methods("GET")
+	srv := httptest.NewServer(m)
+	defer srv.Close()
+
+	client := NewClient("123").(*client)
+	client.endpoint = srv.URL
+
+	video, err := client.GetLiveVideo(context.Background(), mockVideoID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, mockVideoID, video.ID)
+	assert.Equal(t, 1024, video.FileSize)
+}
+
+func TestDownloadLiveVideoFrame(t *testing.T) {
+	m := mux.NewRouter()
+	m.HandleFunc("/live_videos/{videoId}/frame", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "image/jpeg")
+		_, wErr := w.Write([]byte("this is a frame"))
+		assert.NoError(t, wErr)
+	}).Methods("GET")
+	srv := httptest.NewServer(m)
+	defer srv.Close()
+
+	client := NewClient("123").(*client)
+	client.endpoint = srv.URL
+
+	var frame bytes.Buffer
+	if err := client.DownloadLiveVideoFrame(context.Background(), "video-1", &frame); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "this is a frame", frame.String())
+}
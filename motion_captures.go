@@ -0,0 +1,99 @@
+package onfido
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// MotionCapture represents a motion capture object in Onfido API
+// https://documentation.onfido.com/#motion-capture-object
+type MotionCapture struct {
+	ID           string     `json:"id,omitempty"`
+	CreatedAt    *time.Time `json:"created_at,omitempty"`
+	Href         string     `json:"href,omitempty"`
+	DownloadHref string     `json:"download_href,omitempty"`
+	FileName     string     `json:"file_name,omitempty"`
+	FileType     string     `json:"file_type,omitempty"`
+	FileSize     int        `json:"file_size,omitempty"`
+}
+
+type MotionCaptureDownload struct {
+	// Data is the binary data of the motion capture
+	Data []byte
+}
+
+// GetMotionCapture retrieves a single motion capture by its ID.
+// see https://documentation.onfido.com/#retrieve-motion-capture
+func (c *client) GetMotionCapture(ctx context.Context, id string) (*MotionCapture, error) {
+	req, err := c.newRequest(http.MethodGet, "/motion_captures/"+id, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp MotionCapture
+	_, err = c.do(ctx, req, &resp)
+	return &resp, err
+}
+
+// DownloadMotionCapture returns the binary data representing the motion capture video.
+// see https://documentation.onfido.com/#download-motion-capture
+func (c *client) DownloadMotionCapture(ctx context.Context, id string) (*MotionCaptureDownload, error) {
+	req, err := c.newRequest(http.MethodGet, "/motion_captures/"+id+"/download", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp bytes.Buffer
+	_, err = c.do(ctx, req, &resp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download motion capture: %w", err)
+	}
+	return &MotionCaptureDownload{
+		Data: resp.Bytes(),
+	}, err
+}
+
+// DownloadMotionCaptureFrame streams a single frame of the motion capture, as a JPEG image, into w.
+// see https://documentation.onfido.com/#download-motion-capture-frame
+func (c *client) DownloadMotionCaptureFrame(ctx context.Context, id string, w io.Writer) error {
+	return c.download(ctx, "/motion_captures/"+id+"/frame", w, "motion capture frame")
+}
+
+// MotionCaptureIter represents a motion capture iterator
+type MotionCaptureIter struct {
+	Iter
+}
+
+// MotionCapture returns the current item in the iterator as a MotionCapture.
+func (i *MotionCaptureIter) MotionCapture() *MotionCapture {
+	return i.Current().(*MotionCapture)
+}
+
+// ListMotionCaptures retrieves the list of motion captures for the provided applicant.
+// see https://documentation.onfido.com/#list-motion-captures
+func (c *client) ListMotionCaptures(applicantID string) *MotionCaptureIter {
+	return &MotionCaptureIter{&iter{
+		c:       c,
+		nextURL: "/motion_captures?applicant_id=" + applicantID,
+		handler: func(body []byte) ([]interface{}, error) {
+			var r struct {
+				MotionCaptures []*MotionCapture `json:"motion_captures"`
+			}
+
+			if err := json.Unmarshal(body, &r); err != nil {
+				return nil, err
+			}
+
+			values := make([]interface{}, len(r.MotionCaptures))
+			for i, v := range r.MotionCaptures {
+				values[i] = v
+			}
+			return values, nil
+		},
+	}}
+}
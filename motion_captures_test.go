@@ -0,0 +1,96 @@
+package onfido
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListMotionCaptures(t *testing.T) {
+	applicantID := "541d040b-89f8-444b-8921-16b1333bf1c6"
+	m := mux.NewRouter()
+	m.HandleFunc("/motion_captures", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, applicantID, r.URL.Query().Get("applicant_id"))
+		w.Header().Set("Content-Type", "application/json")
+		_, wErr := w.Write([]byte(`{"motion_captures": [{"id": "mc-1", "file_type": "video/mp4"}]}`))
+		assert.NoError(t, wErr)
+	}).Methods("GET")
+	srv := httptest.NewServer(m)
+	defer srv.Close()
+
+	client := NewClient("123").(*client)
+	client.endpoint = srv.URL
+
+	var ids []string
+	it := client.ListMotionCaptures(applicantID)
+	for it.Next(context.Background()) {
+		ids = append(ids, it.MotionCapture().ID)
+	}
+	if it.Err() != nil {
+		t.Fatal(it.Err())
+	}
+	assert.Equal(t, []string{"mc-1"}, ids)
+}
+
+func TestGetMotionCapture_NonOKResponse(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	client := NewClient("123").(*client)
+	client.endpoint = srv.URL
+
+	_, err := client.GetMotionCapture(context.Background(), "mc-1")
+	if err == nil {
+		t.Fatal("expected server to return non ok response, got successful response")
+	}
+}
+
+func TestMotionCapture_GetAndDownload(t *testing.T) {
+	m := mux.NewRouter()
+	m.HandleFunc("/motion_captures/{id}", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, wErr := w.Write([]byte(`{"id": "` + mux.Vars(r)["id"] + `"}`))
+		assert.NoError(t, wErr)
+	}).Methods("GET")
+	m.HandleFunc("/motion_captures/{id}/download", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "video/mp4")
+		_, wErr := w.Write([]byte("this is a video"))
+		assert.NoError(t, wErr)
+	}).Methods("GET")
+	m.HandleFunc("/motion_captures/{id}/frame", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "image/jpeg")
+		_, wErr := w.Write([]byte("this is a frame"))
+		assert.NoError(t, wErr)
+	}).Methods("GET")
+	srv := httptest.NewServer(m)
+	defer srv.Close()
+
+	client := NewClient("123").(*client)
+	client.endpoint = srv.URL
+	ctx := context.Background()
+
+	mc, err := client.GetMotionCapture(ctx, "mc-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "mc-1", mc.ID)
+
+	video, err := client.DownloadMotionCapture(ctx, "mc-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []byte("this is a video"), video.Data)
+
+	var frame bytes.Buffer
+	if err := client.DownloadMotionCaptureFrame(ctx, "mc-1", &frame); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "this is a frame", frame.String())
+}
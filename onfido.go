@@ -34,11 +34,27 @@ type OnfidoClient interface {
 	ListDocuments(applicantID string) *DocumentIter
 	UploadDocument(ctx context.Context, dr DocumentRequest) (*Document, error)
 	DownloadDocument(ctx context.Context, id string) (*DocumentDownload, error)
+	ExtractDocument(ctx context.Context, documentID string) (*ExtractionResult, error)
+	UploadAndExtract(ctx context.Context, dr DocumentRequest) (*Document, *ExtractionResult, error)
 	ListLivePhotos(applicantID string) *LivePhotoIter
+	UploadLivePhoto(ctx context.Context, lr LivePhotoRequest) (*LivePhoto, error)
+	GetLivePhoto(ctx context.Context, id string) (*LivePhoto, error)
+	DownloadLivePhoto(ctx context.Context, id string) (*LivePhotoDownload, error)
 	DownloadLiveVideo(ctx context.Context, id string) (*LiveVideoDownload, error)
+	UploadIDPhoto(ctx context.Context, ir IDPhotoRequest) (*IDPhoto, error)
+	GetIDPhoto(ctx context.Context, id string) (*IDPhoto, error)
+	DownloadIDPhoto(ctx context.Context, id string, w io.Writer) error
+	ListIDPhotos(applicantID string) *IDPhotoIter
 	ListLiveVideos(applicantID string) LiveVideoIter
+	GetLiveVideo(ctx context.Context, id string) (*LiveVideo, error)
+	DownloadLiveVideoFrame(ctx context.Context, id string, w io.Writer) error
+	ListMotionCaptures(applicantID string) *MotionCaptureIter
+	GetMotionCapture(ctx context.Context, id string) (*MotionCapture, error)
+	DownloadMotionCapture(ctx context.Context, id string) (*MotionCaptureDownload, error)
+	DownloadMotionCaptureFrame(ctx context.Context, id string, w io.Writer) error
 	CreateApplicant(ctx context.Context, a Applicant) (*Applicant, error)
 	DeleteApplicant(ctx context.Context, id string) error
+	RestoreApplicant(ctx context.Context, id string) error
 	GetApplicant(ctx context.Context, id string) (*Applicant, error)
 	ListApplicants() *ApplicantIter
 	UpdateApplicant(ctx context.Context, a Applicant) (*Applicant, error)
@@ -47,10 +63,33 @@ type OnfidoClient interface {
 	GetCheckExpanded(ctx context.Context, id string) (*Check, error)
 	ResumeCheck(ctx context.Context, id string) (*Check, error)
 	ListChecks(applicantID string) *CheckIter
+	DownloadCheck(ctx context.Context, id string, w io.Writer) error
 	CreateWebhook(ctx context.Context, wr WebhookRefRequest) (*WebhookRef, error)
 	UpdateWebhook(ctx context.Context, id string, wr WebhookRefRequest) (*WebhookRef, error)
 	DeleteWebhook(ctx context.Context, id string) error
 	ListWebhooks() *WebhookRefIter
+	CreateWorkflowRun(ctx context.Context, wr WorkflowRunRequest) (*WorkflowRun, error)
+	GetWorkflowRun(ctx context.Context, id string) (*WorkflowRun, error)
+	ListWorkflowRuns(filter WorkflowRunFilter) *WorkflowRunIter
+	DownloadSignedEvidenceFile(ctx context.Context, workflowRunID string, w io.Writer) error
+	DownloadEvidenceFolder(ctx context.Context, workflowRunID string, w io.Writer) error
+	CreateTimelineFile(ctx context.Context, workflowRunID string) (*TimelineFileReference, error)
+	DownloadTimelineFile(ctx context.Context, workflowRunID, timelineFileID string, w io.Writer) error
+	ListTasks(workflowRunID string) *TaskIter
+	UploadSigningDocument(ctx context.Context, sr SigningDocumentRequest) (*SigningDocument, error)
+	GetSigningDocument(ctx context.Context, id string) (*SigningDocument, error)
+	DownloadSigningDocument(ctx context.Context, id string, w io.Writer) error
+	ListSigningDocuments(applicantID string) *SigningDocumentIter
+	DownloadQESDocument(ctx context.Context, doc QESDocument, w io.Writer) error
+	GetTask(ctx context.Context, workflowRunID, taskID string) (*Task, error)
+	CompleteTask(ctx context.Context, workflowRunID, taskID string, data interface{}) error
+	CreateWatchlistMonitor(ctx context.Context, wr WatchlistMonitorRequest) (*WatchlistMonitor, error)
+	GetWatchlistMonitor(ctx context.Context, id string) (*WatchlistMonitor, error)
+	DeleteWatchlistMonitor(ctx context.Context, id string) error
+	ListWatchlistMonitors(applicantID string) *WatchlistMonitorIter
+	ListMonitorMatches(ctx context.Context, monitorID string) ([]*WatchlistMonitorMatch, error)
+	UpdateMonitorMatches(ctx context.Context, monitorID string, mu WatchlistMonitorMatchesUpdate) ([]*WatchlistMonitorMatch, error)
+	ForceReportCreation(ctx context.Context, monitorID string) error
 	PickAddresses(postcode string) *PickerIter
 	GetResource(ctx context.Context, href string, v interface{}) error
 	Token() Token
@@ -89,6 +128,72 @@ type Error struct {
 // known shapes of the values are []string and map[string][]string for recursive field validation
 type ErrorFields map[string]interface{}
 
+// UnmarshalJSON decodes the fields of an error. Values other than an object,
+// which the API isn't documented to return, are kept under the empty key rather
+// than failing to decode the whole error.
+func (f *ErrorFields) UnmarshalJSON(b []byte) error {
+	var v interface{}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	switch v := v.(type) {
+	case nil:
+		*f = nil
+	case map[string]interface{}:
+		*f = v
+	default:
+		*f = ErrorFields{"": v}
+	}
+	return nil
+}
+
+// Messages flattens the fields into their messages by field path, e.g.
+// {"addresses": [{"street": ["is too long"]}]} into {"addresses.0.street": ["is too long"]}.
+// Values of unexpected shapes are formatted as messages.
+func (f ErrorFields) Messages() map[string][]string {
+	msgs := make(map[string][]string)
+	for name, v := range f {
+		flattenErrorField(msgs, name, v)
+	}
+	return msgs
+}
+
+func flattenErrorField(msgs map[string][]string, path string, v interface{}) {
+	join := func(key string) string {
+		if path == "" {
+			return key
+		}
+		return path + "." + key
+	}
+	switch v := v.(type) {
+	case nil:
+	case string:
+		msgs[path] = append(msgs[path], v)
+	case []string:
+		msgs[path] = append(msgs[path], v...)
+	case []interface{}:
+		for i, item := range v {
+			if _, ok := item.(string); ok {
+				flattenErrorField(msgs, path, item)
+			} else {
+				flattenErrorField(msgs, join(fmt.Sprint(i)), item)
+			}
+		}
+	case map[string]interface{}:
+		for key, item := range v {
+			flattenErrorField(msgs, join(key), item)
+		}
+	case map[string][]string:
+		for key, item := range v {
+			flattenErrorField(msgs, join(key), item)
+		}
+	case ErrorFields:
+		flattenErrorField(msgs, path, map[string]interface{}(v))
+	default:
+		msgs[path] = append(msgs[path], fmt.Sprint(v))
+	}
+}
+
 func (e *Error) Error() string {
 	if e.Err.Msg != "" {
 		return e.Err.Msg
@@ -115,23 +220,45 @@ func (t Token) Prod() bool {
 
 func (c *client) Token() Token { return c.token }
 
+// ClientOption configures an Onfido client
+type ClientOption func(*client)
+
+// WithEndpoint points the client at another API endpoint, e.g. another region
+// (https://api.us.onfido.com/v3.5) or a fake server in tests.
+func WithEndpoint(endpoint string) ClientOption {
+	return func(c *client) {
+		c.endpoint = strings.TrimSuffix(endpoint, "/")
+	}
+}
+
+// WithHTTPClient sets the HTTP client used to send requests.
+func WithHTTPClient(httpClient HTTPRequester) ClientOption {
+	return func(c *client) {
+		c.httpClient = httpClient
+	}
+}
+
 // NewClientFromEnv creates a new Onfido client using configuration
 // from environment variables.
-func NewClientFromEnv() (OnfidoClient, error) {
+func NewClientFromEnv(opts ...ClientOption) (OnfidoClient, error) {
 	token := os.Getenv(TokenEnv)
 	if token == "" {
 		return nil, fmt.Errorf("onfido token not found in environmental variable `%s`", TokenEnv)
 	}
-	return NewClient(token), nil
+	return NewClient(token, opts...), nil
 }
 
 // NewClient creates a new Onfido client.
-func NewClient(token string) OnfidoClient {
-	return &client{
+func NewClient(token string, opts ...ClientOption) OnfidoClient {
+	c := &client{
 		endpoint:   DefaultEndpoint,
 		httpClient: http.DefaultClient,
 		token:      Token(token),
 	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
 }
 
 func (c *client) newRequest(method, uri string, body io.Reader) (*http.Request, error) {
@@ -172,24 +299,14 @@ func (c *client) newRequest(method, uri string, body io.Reader) (*http.Request,
 }
 
 func (c *client) do(ctx context.Context, req *http.Request, v interface{}) (*http.Response, error) {
-	req = req.WithContext(ctx)
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.send(ctx, req)
 	if err != nil {
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		default:
-			return nil, err
-		}
+		return nil, err
 	}
 	if resp.Body != nil {
 		defer resp.Body.Close()
 	}
 
-	if c := resp.StatusCode; c < 200 || c > 299 {
-		return nil, handleResponseErr(resp)
-	}
-
 	if v != nil {
 		if w, ok := v.(io.Writer); ok {
 			_, err = io.Copy(w, resp.Body)
@@ -203,15 +320,57 @@ func (c *client) do(ctx context.Context, req *http.Request, v interface{}) (*htt
 	return resp, err
 }
 
+// send sends the request and returns the response with its body unread,
+// or an error if the request failed or returned a non 2xx status code.
+// The caller must close the response body.
+func (c *client) send(ctx context.Context, req *http.Request) (*http.Response, error) {
+	req = req.WithContext(ctx)
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		default:
+			return nil, err
+		}
+	}
+
+	if c := resp.StatusCode; c < 200 || c > 299 {
+		err := handleResponseErr(resp)
+		if resp.Body != nil {
+			resp.Body.Close()
+		}
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// download streams the response body of a GET request to uri into w.
+func (c *client) download(ctx context.Context, uri string, w io.Writer, what string) error {
+	req, err := c.newRequest(http.MethodGet, uri, nil)
+	if err != nil {
+		return err
+	}
+
+	if _, err := c.do(ctx, req, w); err != nil {
+		return fmt.Errorf("failed to download %s: %w", what, err)
+	}
+	return nil
+}
+
 func isJSONResponse(resp *http.Response) bool {
 	return strings.Contains(resp.Header.Get("Content-Type"), "application/json")
 }
 
+// maxErrorBodySize bounds the error responses decoded by handleResponseErr.
+const maxErrorBodySize = 1 << 20
+
 func handleResponseErr(resp *http.Response) error {
 	var onfidoErr Error
 	if resp.Body != nil && isJSONResponse(resp) {
 		defer resp.Body.Close()
-		if err := json.NewDecoder(resp.Body).Decode(&onfidoErr); err != nil {
+		if err := json.NewDecoder(io.LimitReader(resp.Body, maxErrorBodySize)).Decode(&onfidoErr); err != nil {
 			return err
 		}
 	} else {
@@ -276,12 +435,9 @@ func (it *iter) Next(ctx context.Context) bool {
 		}
 		it.values = values
 
-		links := linkheader.Parse(resp.Header.Get("Link"))
-		links = links.FilterByRel("next")
-		if len(links) > 0 {
-			it.nextURL = links[0].URL
-		} else {
-			it.nextURL = ""
+		if it.nextURL, err = nextPageURL(resp.Header.Get("Link"), it.c.endpoint); err != nil {
+			it.err = err
+			return false
 		}
 	}
 	if len(it.values) == 0 {
@@ -292,3 +448,53 @@ func (it *iter) Next(ctx context.Context) bool {
 	it.values = it.values[1:]
 	return true
 }
+
+// ErrUnexpectedNextPage means that a list response linked its next page to another host
+var ErrUnexpectedNextPage = errors.New("unexpected next page link")
+
+// nextPageURL returns the URL of the next page from a Link header, or "" on the last page.
+// Absolute links must point at the endpoint's host (or another Onfido API host),
+// so that the token isn't sent elsewhere.
+func nextPageURL(header, endpoint string) (string, error) {
+	for _, link := range linkheader.Parse(header) {
+		if !hasRel(link.Rel, "next") {
+			continue
+		}
+		u, err := url.Parse(link.URL)
+		if err != nil {
+			return "", fmt.Errorf("%w: %v", ErrUnexpectedNextPage, err)
+		}
+		if !u.IsAbs() {
+			return link.URL, nil
+		}
+		e, err := url.Parse(endpoint)
+		if err != nil {
+			return "", err
+		}
+		if !sameAPIHost(u, e) {
+			return "", fmt.Errorf("%w: %s://%s", ErrUnexpectedNextPage, u.Scheme, u.Host)
+		}
+		return link.URL, nil
+	}
+	return "", nil
+}
+
+// hasRel reports whether the space separated link relations include rel.
+func hasRel(rels, rel string) bool {
+	for _, r := range strings.Fields(rels) {
+		if strings.EqualFold(r, rel) {
+			return true
+		}
+	}
+	return false
+}
+
+func sameAPIHost(u, endpoint *url.URL) bool {
+	if u.Scheme == endpoint.Scheme && u.Host == endpoint.Host {
+		return true
+	}
+	isOnfido := func(u *url.URL) bool {
+		return u.Scheme == "https" && u.Port() == "" && strings.HasSuffix(u.Hostname(), ".onfido.com")
+	}
+	return isOnfido(u) && isOnfido(endpoint)
+}
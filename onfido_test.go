@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"net/url"
 	"os"
 	"strings"
 	"testing"
@@ -115,6 +116,21 @@ func TestNewRequest_WithPathUri(t *testing.T) {
 	}
 }
 
+func TestNewClient_WithOptions(t *testing.T) {
+	httpClient := &http.Client{}
+	client := NewClient("123",
+		WithEndpoint("https://api.us.onfido.com/v3.5/"),
+		WithHTTPClient(httpClient),
+	).(*client)
+
+	req, err := client.newRequest("GET", "/applicants", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "https://api.us.onfido.com/v3.5/applicants", req.URL.String())
+	assert.Same(t, httpClient, client.httpClient)
+}
+
 func TestNewRequest_TokenSet(t *testing.T) {
 	expectedToken := "io2h54k2j3h52jk"
 	client := NewClient(expectedToken).(*client)
@@ -275,3 +291,125 @@ func (c *stubbedHTTPClient) Do(req *http.Request) (*http.Response, error) {
 	}
 	return c.resp, nil
 }
+
+func TestErrorFields_Shapes(t *testing.T) {
+	for name, tc := range map[string]struct {
+		fields string
+		want   map[string][]string
+	}{
+		"messages": {
+			fields: `{"first_name": ["can't be blank"], "email": "is invalid"}`,
+			want:   map[string][]string{"first_name": {"can't be blank"}, "email": {"is invalid"}},
+		},
+		"nested": {
+			fields: `{"addresses": [{"street": ["can't be longer than 32 characters"]}], "document": {"type": ["is invalid"]}}`,
+			want: map[string][]string{
+				"addresses.0.street": {"can't be longer than 32 characters"},
+				"document.type":      {"is invalid"},
+			},
+		},
+		"unexpected values": {
+			fields: `{"count": 3, "flag": true, "empty": null}`,
+			want:   map[string][]string{"count": {"3"}, "flag": {"true"}},
+		},
+		"array": {
+			fields: `["is invalid"]`,
+			want:   map[string][]string{"": {"is invalid"}},
+		},
+		"string": {
+			fields: `"is invalid"`,
+			want:   map[string][]string{"": {"is invalid"}},
+		},
+		"null": {
+			fields: `null`,
+			want:   map[string][]string{},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			resp := &http.Response{
+				StatusCode: http.StatusUnprocessableEntity,
+				Header:     http.Header{"Content-Type": {"application/json"}},
+				Body:       ioutil.NopCloser(strings.NewReader(`{"error": {"type": "validation_error", "fields": ` + tc.fields + `}}`)),
+			}
+			err := handleResponseErr(resp)
+			onfidoErr, ok := err.(*Error)
+			if !ok {
+				t.Fatalf("expected *Error, got %T: %v", err, err)
+			}
+			assert.Equal(t, tc.want, onfidoErr.Err.Fields.Messages())
+		})
+	}
+}
+
+func TestNextPageURL(t *testing.T) {
+	const endpoint = "https://api.eu.onfido.com/v3.5"
+	for header, want := range map[string]string{
+		``: ``,
+		`<https://api.eu.onfido.com/v3.5/applicants?page=2>; rel="next", <https://api.eu.onfido.com/v3.5/applicants?page=9>; rel="last"`: `https://api.eu.onfido.com/v3.5/applicants?page=2`,
+		`<https://api.onfido.com/v3.5/applicants?page=2>; rel="next"`:                                                                    `https://api.onfido.com/v3.5/applicants?page=2`,
+		`</applicants?page=2>; rel="last next"`:                                                                                          `/applicants?page=2`,
+		`<https://api.eu.onfido.com/v3.5/applicants?page=1>; rel="prev"`:                                                                 ``,
+		`<>; rel="next"`: ``,
+		`<;,>`:           ``,
+	} {
+		got, err := nextPageURL(header, endpoint)
+		assert.NoError(t, err, header)
+		assert.Equal(t, want, got, header)
+	}
+
+	for _, header := range []string{
+		`<https://example.com/v3.5/applicants?page=2>; rel="next"`,
+		`<http://api.eu.onfido.com/v3.5/applicants?page=2>; rel="next"`,
+		`<https://api.eu.onfido.com.example.com/v3.5/applicants?page=2>; rel="next"`,
+		`<https://api.eu.onfido.com:8443/v3.5/applicants?page=2>; rel="next"`,
+		`<https://%zz>; rel="next"`,
+	} {
+		_, err := nextPageURL(header, endpoint)
+		assert.True(t, errors.Is(err, ErrUnexpectedNextPage), "%s: %v", header, err)
+	}
+}
+
+func FuzzHandleResponseErr(f *testing.F) {
+	f.Add([]byte(`{"error":{"id":"123","type":"validation_error","message":"There was a validation error on this request","fields":{"addresses":[{"street":["can't be longer than 32 characters"]}]}}}`))
+	f.Add([]byte(`{"error":{"fields":["is invalid"]}}`))
+	f.Add([]byte(`{"error":"oops"}`))
+	f.Add([]byte(`hello`))
+	f.Fuzz(func(t *testing.T, body []byte) {
+		resp := &http.Response{
+			StatusCode: http.StatusUnprocessableEntity,
+			Header:     http.Header{"Content-Type": {"application/json"}},
+			Body:       ioutil.NopCloser(bytes.NewReader(body)),
+		}
+		err := handleResponseErr(resp)
+		if err == nil {
+			t.Fatal("no error")
+		}
+		if onfidoErr, ok := err.(*Error); ok {
+			if onfidoErr.Error() == "" {
+				t.Fatal("empty error message")
+			}
+			onfidoErr.Err.Fields.Messages()
+		}
+	})
+}
+
+func FuzzNextPageURL(f *testing.F) {
+	f.Add(`<https://api.eu.onfido.com/v3.5/applicants?page=2>; rel="next", <https://api.eu.onfido.com/v3.5/applicants?page=9>; rel="last"`)
+	f.Add(`</applicants?page=2>; rel="next"`)
+	f.Add(`<https://example.com/>; rel=next`)
+	f.Add(`<;,>; rel`)
+	f.Fuzz(func(t *testing.T, header string) {
+		const endpoint = "https://api.eu.onfido.com/v3.5"
+		next, err := nextPageURL(header, endpoint)
+		if err != nil || next == "" {
+			return
+		}
+		u, err := url.Parse(next)
+		if err != nil {
+			t.Fatalf("invalid next page %q accepted", next)
+		}
+		if u.IsAbs() && (u.Scheme != "https" || !strings.HasSuffix(u.Hostname(), ".onfido.com") || u.Port() != "") {
+			t.Fatalf("next page on another host %q accepted", next)
+		}
+	})
+}
@@ -0,0 +1,260 @@
+// Package onfidoapi is a low level client of the Onfido API, with models and
+// endpoints generated from the OpenAPI specification vendored in openapi/.
+//
+// Every operation of the specification is a method of Client named after its
+// operationId, taking its path parameters, required query parameters, optional
+// query parameters and request body, in that order:
+//
+//	c := onfido.NewAPIClient(token)
+//	applicant, err := c.FindApplicant(ctx, applicantID)
+//
+// Most code should use onfido.OnfidoClient, which adds iterators, expanded
+// checks and webhook handling on top of the API. This package covers the
+// operations and fields it doesn't.
+package onfidoapi
+
+//go:generate go run ../internal/gen -spec ../openapi/onfido-v3.5.json -out .
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// UserAgent is the default User-Agent header of requests
+const UserAgent = "Go-Onfido"
+
+// HTTPRequester sends HTTP requests, e.g. *http.Client
+type HTTPRequester interface {
+	Do(*http.Request) (*http.Response, error)
+}
+
+// Client is a client of the Onfido API
+type Client struct {
+	endpoint   string
+	token      string
+	httpClient HTTPRequester
+	// UserAgent is sent with every request.
+	UserAgent string
+}
+
+// NewClient creates a client of the API at endpoint, e.g. https://api.eu.onfido.com/v3.5.
+// A nil httpClient defaults to http.DefaultClient.
+func NewClient(endpoint, token string, httpClient HTTPRequester) *Client {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	return &Client{
+		endpoint:   strings.TrimSuffix(endpoint, "/"),
+		token:      token,
+		httpClient: httpClient,
+		UserAgent:  UserAgent,
+	}
+}
+
+// File is a file uploaded in a multipart request
+type File struct {
+	Name        string
+	ContentType string
+	Content     io.Reader
+}
+
+// APIError is returned when the API responds with a non 2xx status code
+type APIError struct {
+	StatusCode int
+	// Body is the decoded error, empty if the response wasn't JSON.
+	Body Error
+}
+
+func (e *APIError) Error() string {
+	if e.Body.Error != nil && e.Body.Error.Message != "" {
+		return fmt.Sprintf("onfido: %s (status %d)", e.Body.Error.Message, e.StatusCode)
+	}
+	return fmt.Sprintf("onfido: http request failed with status code %d", e.StatusCode)
+}
+
+type multipartField struct {
+	name     string
+	value    interface{}
+	required bool
+}
+
+type request struct {
+	method    string
+	path      string
+	accept    string
+	query     url.Values
+	json      interface{}
+	multipart []multipartField
+}
+
+// do sends the request and decodes the response into out: a pointer to a
+// JSON value, an io.Writer receiving the raw body, or nil to discard it.
+func (c *Client) do(ctx context.Context, r request, out interface{}) error {
+	var (
+		body        io.Reader
+		contentType string
+	)
+	switch {
+	case r.json != nil:
+		b, err := json.Marshal(r.json)
+		if err != nil {
+			return err
+		}
+		body, contentType = bytes.NewReader(b), "application/json"
+	case r.multipart != nil:
+		var buf bytes.Buffer
+		var err error
+		if contentType, err = writeMultipart(&buf, r.multipart); err != nil {
+			return err
+		}
+		body = &buf
+	}
+
+	u := c.endpoint + r.path
+	if len(r.query) > 0 {
+		u += "?" + r.query.Encode()
+	}
+	req, err := http.NewRequest(r.method, u, body)
+	if err != nil {
+		return err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Authorization", "Token token="+c.token)
+	req.Header.Set("Accept", r.accept)
+	req.Header.Set("User-Agent", c.UserAgent)
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		apiErr := &APIError{StatusCode: resp.StatusCode}
+		if strings.Contains(resp.Header.Get("Content-Type"), "application/json") {
+			// The status code is the error even if the body can't be decoded.
+			_ = json.NewDecoder(resp.Body).Decode(&apiErr.Body)
+		}
+		return apiErr
+	}
+
+	switch out := out.(type) {
+	case nil:
+		return nil
+	case io.Writer:
+		_, err = io.Copy(out, resp.Body)
+		return err
+	default:
+		if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+			return fmt.Errorf("failed to decode response: %w", err)
+		}
+		return nil
+	}
+}
+
+// writeMultipart writes the non zero (or required) fields as a multipart form
+// and returns its content type.
+func writeMultipart(w io.Writer, fields []multipartField) (string, error) {
+	mw := multipart.NewWriter(w)
+	for _, f := range fields {
+		v := reflect.ValueOf(f.value)
+		if !f.required && (!v.IsValid() || v.IsZero()) {
+			continue
+		}
+		switch value := f.value.(type) {
+		case *File:
+			if value == nil || value.Content == nil {
+				return "", fmt.Errorf("missing file %s", f.name)
+			}
+			if err := writeFile(mw, f.name, value); err != nil {
+				return "", err
+			}
+			continue
+		case string:
+			err := mw.WriteField(f.name, value)
+			if err != nil {
+				return "", err
+			}
+			continue
+		}
+
+		var s string
+		switch v.Kind() {
+		case reflect.String:
+			s = v.String()
+		case reflect.Bool, reflect.Int, reflect.Float64:
+			s = fmt.Sprint(f.value)
+		default:
+			// Objects and arrays are sent as JSON.
+			b, err := json.Marshal(f.value)
+			if err != nil {
+				return "", err
+			}
+			s = string(b)
+		}
+		if err := mw.WriteField(f.name, s); err != nil {
+			return "", err
+		}
+	}
+	if err := mw.Close(); err != nil {
+		return "", err
+	}
+	return mw.FormDataContentType(), nil
+}
+
+func writeFile(mw *multipart.Writer, name string, f *File) error {
+	h := make(map[string][]string)
+	h["Content-Disposition"] = []string{fmt.Sprintf(`form-data; name=%q; filename=%q`, name, f.Name)}
+	ct := f.ContentType
+	if ct == "" {
+		ct = "application/octet-stream"
+	}
+	h["Content-Type"] = []string{ct}
+	part, err := mw.CreatePart(h)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(part, f.Content)
+	return err
+}
+
+// setQuery sets the query parameter unless its value is zero.
+func setQuery(q url.Values, name string, value interface{}) {
+	v := reflect.ValueOf(value)
+	if !v.IsValid() || v.IsZero() {
+		return
+	}
+	switch value := value.(type) {
+	case *time.Time:
+		q.Set(name, value.UTC().Format(time.RFC3339))
+		return
+	case bool:
+		q.Set(name, strconv.FormatBool(value))
+		return
+	}
+	if v.Kind() == reflect.Slice {
+		s := make([]string, v.Len())
+		for i := range s {
+			s[i] = fmt.Sprint(v.Index(i).Interface())
+		}
+		q.Set(name, strings.Join(s, ","))
+		return
+	}
+	q.Set(name, fmt.Sprint(value))
+}
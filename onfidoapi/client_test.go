@@ -0,0 +1,175 @@
+package onfidoapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestClient(t *testing.T, m *mux.Router) *Client {
+	srv := httptest.NewServer(m)
+	t.Cleanup(srv.Close)
+	return NewClient(srv.URL, "123", nil)
+}
+
+func TestListWorkflowRuns_Query(t *testing.T) {
+	after := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	m := mux.NewRouter()
+	m.HandleFunc("/workflow_runs", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Token token=123", r.Header.Get("Authorization"))
+		assert.Equal(t, "application/json", r.Header.Get("Accept"))
+		assert.Equal(t, "2", r.URL.Query().Get("page"))
+		assert.Equal(t, "2024-01-02T03:04:05Z", r.URL.Query().Get("created_at_gt"))
+		assert.Equal(t, "asc", r.URL.Query().Get("sort"))
+		_, ok := r.URL.Query()["status"]
+		assert.False(t, ok, "zero parameters aren't sent")
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[{"id": "run-id", "status": "approved"}]`))
+	}).Methods("GET")
+	c := newTestClient(t, m)
+
+	runs, err := c.ListWorkflowRuns(context.Background(), &ListWorkflowRunsParams{
+		Page:        2,
+		CreatedAtGt: &after,
+		Sort:        ListWorkflowRunsSortAsc,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if assert.Len(t, runs, 1) {
+		assert.Equal(t, "run-id", runs[0].ID)
+		assert.Equal(t, WorkflowRunStatusApproved, runs[0].Status)
+	}
+}
+
+func TestCreateApplicant(t *testing.T) {
+	m := mux.NewRouter()
+	m.HandleFunc("/applicants", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		var body map[string]interface{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]interface{}{
+			"first_name": "Jane",
+			"last_name":  "",
+			"consents":   []interface{}{map[string]interface{}{"name": "privacy_notices_read", "granted": true}},
+		}, body)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"id": "applicant-id", "first_name": "Jane"}`))
+	}).Methods("POST")
+	c := newTestClient(t, m)
+
+	a, err := c.CreateApplicant(context.Background(), ApplicantBuilder{
+		FirstName: "Jane",
+		Consents:  []*Consent{{Name: ConsentNamePrivacyNoticesRead, Granted: true}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "applicant-id", a.ID)
+}
+
+func TestUploadDocument_Multipart(t *testing.T) {
+	m := mux.NewRouter()
+	m.HandleFunc("/documents", func(w http.ResponseWriter, r *http.Request) {
+		assert.NoError(t, r.ParseMultipartForm(1<<20))
+		assert.Equal(t, "applicant-id", r.FormValue("applicant_id"))
+		assert.Equal(t, "passport", r.FormValue("type"))
+		assert.Equal(t, "front", r.FormValue("side"))
+		assert.Equal(t, "true", r.FormValue("validate_image_quality"))
+		_, ok := r.MultipartForm.Value["issuing_country"]
+		assert.False(t, ok, "zero optional fields aren't sent")
+
+		f, h, err := r.FormFile("file")
+		if assert.NoError(t, err) {
+			assert.Equal(t, "passport.jpg", h.Filename)
+			assert.Equal(t, "image/jpeg", h.Header.Get("Content-Type"))
+			b, _ := ioutil.ReadAll(f)
+			assert.Equal(t, "jpeg", string(b))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"id": "document-id"}`))
+	}).Methods("POST")
+	c := newTestClient(t, m)
+
+	d, err := c.UploadDocument(context.Background(), DocumentUpload{
+		ApplicantID:          "applicant-id",
+		Type:                 "passport",
+		Side:                 DocumentUploadSideFront,
+		ValidateImageQuality: true,
+		File:                 &File{Name: "passport.jpg", ContentType: "image/jpeg", Content: strings.NewReader("jpeg")},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "document-id", d.ID)
+
+	_, err = c.UploadDocument(context.Background(), DocumentUpload{ApplicantID: "applicant-id", Type: "passport"})
+	assert.EqualError(t, err, "missing file file")
+}
+
+func TestDownloadCheck(t *testing.T) {
+	m := mux.NewRouter()
+	m.HandleFunc("/checks/{id}/download", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "check-id", mux.Vars(r)["id"])
+		assert.Equal(t, "application/pdf", r.Header.Get("Accept"))
+		w.Header().Set("Content-Type", "application/pdf")
+		w.Write([]byte("%PDF"))
+	}).Methods("GET")
+	c := newTestClient(t, m)
+
+	var buf bytes.Buffer
+	assert.NoError(t, c.DownloadCheck(context.Background(), "check-id", &buf))
+	assert.Equal(t, "%PDF", buf.String())
+}
+
+func TestResumeCheck_Error(t *testing.T) {
+	m := mux.NewRouter()
+	m.HandleFunc("/checks/{id}/resume", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		w.Write([]byte(`{"error": {"type": "validation_error", "message": "There was a validation error on this request", "fields": {"check": ["is not paused"]}}}`))
+	}).Methods("POST")
+	m.HandleFunc("/reports/{id}/resume", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}).Methods("POST")
+	c := newTestClient(t, m)
+
+	err := c.ResumeCheck(context.Background(), "check-id")
+	if apiErr, ok := err.(*APIError); assert.True(t, ok, "%T", err) {
+		assert.Equal(t, http.StatusUnprocessableEntity, apiErr.StatusCode)
+		assert.Equal(t, "validation_error", apiErr.Body.Error.Type)
+		assert.Contains(t, apiErr.Body.Error.Fields, "check")
+		assert.EqualError(t, err, "onfido: There was a validation error on this request (status 422)")
+	}
+
+	assert.NoError(t, c.ResumeReport(context.Background(), "report-id"))
+}
+
+func TestPathEscape(t *testing.T) {
+	m := mux.NewRouter()
+	m.HandleFunc("/applicants/{id}", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request to %s", r.URL.RawPath)
+	})
+	m.NotFoundHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/applicants/a%2Fb", r.URL.EscapedPath())
+		w.WriteHeader(http.StatusNotFound)
+	})
+	c := newTestClient(t, m)
+
+	err := c.DeleteApplicant(context.Background(), "a/b")
+	assert.EqualError(t, err, "onfido: http request failed with status code 404")
+}
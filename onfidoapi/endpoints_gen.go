@@ -0,0 +1,985 @@
+// Code generated by gen from the Onfido OpenAPI specification; DO NOT EDIT.
+
+package onfidoapi
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// CancelReport calls the cancel_report operation
+// POST /reports/{report_id}/cancel
+func (c *Client) CancelReport(ctx context.Context, reportID string) error {
+	req := request{
+		method: http.MethodPost,
+		path:   "/reports/" + url.PathEscape(reportID) + "/cancel",
+		accept: "application/json",
+	}
+	return c.do(ctx, req, nil)
+}
+
+// CompleteTask calls the complete_task operation
+// POST /workflow_runs/{workflow_run_id}/tasks/{task_id}/complete
+func (c *Client) CompleteTask(ctx context.Context, workflowRunID string, taskID string, body CompleteTaskBuilder) error {
+	req := request{
+		method: http.MethodPost,
+		path:   "/workflow_runs/" + url.PathEscape(workflowRunID) + "/tasks/" + url.PathEscape(taskID) + "/complete",
+		accept: "application/json",
+		json:   body,
+	}
+	return c.do(ctx, req, nil)
+}
+
+// CreateApplicant calls the create_applicant operation
+// POST /applicants
+func (c *Client) CreateApplicant(ctx context.Context, body ApplicantBuilder) (*Applicant, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/applicants",
+		accept: "application/json",
+		json:   body,
+	}
+	var out Applicant
+	if err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateCheck calls the create_check operation
+// POST /checks
+func (c *Client) CreateCheck(ctx context.Context, body CheckBuilder) (*Check, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/checks",
+		accept: "application/json",
+		json:   body,
+	}
+	var out Check
+	if err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateTimelineFile calls the create_timeline_file operation
+// POST /workflow_runs/{workflow_run_id}/timeline_file
+func (c *Client) CreateTimelineFile(ctx context.Context, workflowRunID string) (*TimelineFileReference, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/workflow_runs/" + url.PathEscape(workflowRunID) + "/timeline_file",
+		accept: "application/json",
+	}
+	var out TimelineFileReference
+	if err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateWatchlistMonitor calls the create_watchlist_monitor operation
+// POST /watchlist_monitors
+func (c *Client) CreateWatchlistMonitor(ctx context.Context, body WatchlistMonitorBuilder) (*WatchlistMonitor, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/watchlist_monitors",
+		accept: "application/json",
+		json:   body,
+	}
+	var out WatchlistMonitor
+	if err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateWebhook calls the create_webhook operation
+// POST /webhooks
+func (c *Client) CreateWebhook(ctx context.Context, body WebhookBuilder) (*Webhook, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/webhooks",
+		accept: "application/json",
+		json:   body,
+	}
+	var out Webhook
+	if err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateWorkflowRun calls the create_workflow_run operation
+// POST /workflow_runs
+func (c *Client) CreateWorkflowRun(ctx context.Context, body WorkflowRunBuilder) (*WorkflowRun, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/workflow_runs",
+		accept: "application/json",
+		json:   body,
+	}
+	var out WorkflowRun
+	if err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteApplicant calls the delete_applicant operation
+// DELETE /applicants/{applicant_id}
+func (c *Client) DeleteApplicant(ctx context.Context, applicantID string) error {
+	req := request{
+		method: http.MethodDelete,
+		path:   "/applicants/" + url.PathEscape(applicantID),
+		accept: "application/json",
+	}
+	return c.do(ctx, req, nil)
+}
+
+// DeleteWatchlistMonitor calls the delete_watchlist_monitor operation
+// DELETE /watchlist_monitors/{monitor_id}
+func (c *Client) DeleteWatchlistMonitor(ctx context.Context, monitorID string) error {
+	req := request{
+		method: http.MethodDelete,
+		path:   "/watchlist_monitors/" + url.PathEscape(monitorID),
+		accept: "application/json",
+	}
+	return c.do(ctx, req, nil)
+}
+
+// DeleteWebhook calls the delete_webhook operation
+// DELETE /webhooks/{webhook_id}
+func (c *Client) DeleteWebhook(ctx context.Context, webhookID string) error {
+	req := request{
+		method: http.MethodDelete,
+		path:   "/webhooks/" + url.PathEscape(webhookID),
+		accept: "application/json",
+	}
+	return c.do(ctx, req, nil)
+}
+
+// DownloadCheck calls the download_check operation
+// GET /checks/{check_id}/download
+func (c *Client) DownloadCheck(ctx context.Context, checkID string, w io.Writer) error {
+	req := request{
+		method: http.MethodGet,
+		path:   "/checks/" + url.PathEscape(checkID) + "/download",
+		accept: "application/pdf",
+	}
+	return c.do(ctx, req, w)
+}
+
+// DownloadDocument calls the download_document operation
+// GET /documents/{document_id}/download
+func (c *Client) DownloadDocument(ctx context.Context, documentID string, w io.Writer) error {
+	req := request{
+		method: http.MethodGet,
+		path:   "/documents/" + url.PathEscape(documentID) + "/download",
+		accept: "*/*",
+	}
+	return c.do(ctx, req, w)
+}
+
+// DownloadEvidenceFolder calls the download_evidence_folder operation
+// GET /workflow_runs/{workflow_run_id}/evidence_folder
+func (c *Client) DownloadEvidenceFolder(ctx context.Context, workflowRunID string, w io.Writer) error {
+	req := request{
+		method: http.MethodGet,
+		path:   "/workflow_runs/" + url.PathEscape(workflowRunID) + "/evidence_folder",
+		accept: "application/zip",
+	}
+	return c.do(ctx, req, w)
+}
+
+// DownloadIDPhoto calls the download_id_photo operation
+// GET /id_photos/{id_photo_id}/download
+func (c *Client) DownloadIDPhoto(ctx context.Context, idPhotoID string, w io.Writer) error {
+	req := request{
+		method: http.MethodGet,
+		path:   "/id_photos/" + url.PathEscape(idPhotoID) + "/download",
+		accept: "*/*",
+	}
+	return c.do(ctx, req, w)
+}
+
+// DownloadLivePhoto calls the download_live_photo operation
+// GET /live_photos/{live_photo_id}/download
+func (c *Client) DownloadLivePhoto(ctx context.Context, livePhotoID string, w io.Writer) error {
+	req := request{
+		method: http.MethodGet,
+		path:   "/live_photos/" + url.PathEscape(livePhotoID) + "/download",
+		accept: "*/*",
+	}
+	return c.do(ctx, req, w)
+}
+
+// DownloadLiveVideo calls the download_live_video operation
+// GET /live_videos/{live_video_id}/download
+func (c *Client) DownloadLiveVideo(ctx context.Context, liveVideoID string, w io.Writer) error {
+	req := request{
+		method: http.MethodGet,
+		path:   "/live_videos/" + url.PathEscape(liveVideoID) + "/download",
+		accept: "*/*",
+	}
+	return c.do(ctx, req, w)
+}
+
+// DownloadLiveVideoFrame calls the download_live_video_frame operation
+// GET /live_videos/{live_video_id}/frame
+func (c *Client) DownloadLiveVideoFrame(ctx context.Context, liveVideoID string, w io.Writer) error {
+	req := request{
+		method: http.MethodGet,
+		path:   "/live_videos/" + url.PathEscape(liveVideoID) + "/frame",
+		accept: "*/*",
+	}
+	return c.do(ctx, req, w)
+}
+
+// DownloadMotionCapture calls the download_motion_capture operation
+// GET /motion_captures/{motion_capture_id}/download
+func (c *Client) DownloadMotionCapture(ctx context.Context, motionCaptureID string, w io.Writer) error {
+	req := request{
+		method: http.MethodGet,
+		path:   "/motion_captures/" + url.PathEscape(motionCaptureID) + "/download",
+		accept: "*/*",
+	}
+	return c.do(ctx, req, w)
+}
+
+// DownloadMotionCaptureFrame calls the download_motion_capture_frame operation
+// GET /motion_captures/{motion_capture_id}/frame
+func (c *Client) DownloadMotionCaptureFrame(ctx context.Context, motionCaptureID string, w io.Writer) error {
+	req := request{
+		method: http.MethodGet,
+		path:   "/motion_captures/" + url.PathEscape(motionCaptureID) + "/frame",
+		accept: "*/*",
+	}
+	return c.do(ctx, req, w)
+}
+
+// DownloadQESDocument calls the download_qes_document operation
+// GET /qualified_electronic_signature/documents
+func (c *Client) DownloadQESDocument(ctx context.Context, workflowRunID string, fileID string, w io.Writer) error {
+	req := request{
+		method: http.MethodGet,
+		path:   "/qualified_electronic_signature/documents",
+		accept: "*/*",
+	}
+	req.query = make(url.Values)
+	setQuery(req.query, "workflow_run_id", workflowRunID)
+	setQuery(req.query, "file_id", fileID)
+	return c.do(ctx, req, w)
+}
+
+// DownloadSignedEvidenceFile calls the download_signed_evidence_file operation
+// GET /workflow_runs/{workflow_run_id}/signed_evidence_file
+func (c *Client) DownloadSignedEvidenceFile(ctx context.Context, workflowRunID string, w io.Writer) error {
+	req := request{
+		method: http.MethodGet,
+		path:   "/workflow_runs/" + url.PathEscape(workflowRunID) + "/signed_evidence_file",
+		accept: "application/pdf",
+	}
+	return c.do(ctx, req, w)
+}
+
+// DownloadSigningDocument calls the download_signing_document operation
+// GET /signing_documents/{signing_document_id}/download
+func (c *Client) DownloadSigningDocument(ctx context.Context, signingDocumentID string, w io.Writer) error {
+	req := request{
+		method: http.MethodGet,
+		path:   "/signing_documents/" + url.PathEscape(signingDocumentID) + "/download",
+		accept: "application/pdf",
+	}
+	return c.do(ctx, req, w)
+}
+
+// Extract calls the extract operation
+// POST /extractions
+func (c *Client) Extract(ctx context.Context, body ExtractRequest) (*Extraction, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/extractions",
+		accept: "application/json",
+		json:   body,
+	}
+	var out Extraction
+	if err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// FindAddresses calls the find_addresses operation
+// GET /addresses/pick
+func (c *Client) FindAddresses(ctx context.Context, postcode string) (*AddressesList, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/addresses/pick",
+		accept: "application/json",
+	}
+	req.query = make(url.Values)
+	setQuery(req.query, "postcode", postcode)
+	var out AddressesList
+	if err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// FindApplicant calls the find_applicant operation
+// GET /applicants/{applicant_id}
+func (c *Client) FindApplicant(ctx context.Context, applicantID string) (*Applicant, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/applicants/" + url.PathEscape(applicantID),
+		accept: "application/json",
+	}
+	var out Applicant
+	if err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// FindCheck calls the find_check operation
+// GET /checks/{check_id}
+func (c *Client) FindCheck(ctx context.Context, checkID string) (*Check, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/checks/" + url.PathEscape(checkID),
+		accept: "application/json",
+	}
+	var out Check
+	if err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// FindDocument calls the find_document operation
+// GET /documents/{document_id}
+func (c *Client) FindDocument(ctx context.Context, documentID string) (*Document, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/documents/" + url.PathEscape(documentID),
+		accept: "application/json",
+	}
+	var out Document
+	if err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// FindIDPhoto calls the find_id_photo operation
+// GET /id_photos/{id_photo_id}
+func (c *Client) FindIDPhoto(ctx context.Context, idPhotoID string) (*IDPhoto, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/id_photos/" + url.PathEscape(idPhotoID),
+		accept: "application/json",
+	}
+	var out IDPhoto
+	if err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// FindLivePhoto calls the find_live_photo operation
+// GET /live_photos/{live_photo_id}
+func (c *Client) FindLivePhoto(ctx context.Context, livePhotoID string) (*LivePhoto, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/live_photos/" + url.PathEscape(livePhotoID),
+		accept: "application/json",
+	}
+	var out LivePhoto
+	if err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// FindLiveVideo calls the find_live_video operation
+// GET /live_videos/{live_video_id}
+func (c *Client) FindLiveVideo(ctx context.Context, liveVideoID string) (*LiveVideo, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/live_videos/" + url.PathEscape(liveVideoID),
+		accept: "application/json",
+	}
+	var out LiveVideo
+	if err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// FindMotionCapture calls the find_motion_capture operation
+// GET /motion_captures/{motion_capture_id}
+func (c *Client) FindMotionCapture(ctx context.Context, motionCaptureID string) (*MotionCapture, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/motion_captures/" + url.PathEscape(motionCaptureID),
+		accept: "application/json",
+	}
+	var out MotionCapture
+	if err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// FindReport calls the find_report operation
+// GET /reports/{report_id}
+func (c *Client) FindReport(ctx context.Context, reportID string) (*Report, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/reports/" + url.PathEscape(reportID),
+		accept: "application/json",
+	}
+	var out Report
+	if err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// FindSigningDocument calls the find_signing_document operation
+// GET /signing_documents/{signing_document_id}
+func (c *Client) FindSigningDocument(ctx context.Context, signingDocumentID string) (*SigningDocument, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/signing_documents/" + url.PathEscape(signingDocumentID),
+		accept: "application/json",
+	}
+	var out SigningDocument
+	if err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// FindTask calls the find_task operation
+// GET /workflow_runs/{workflow_run_id}/tasks/{task_id}
+func (c *Client) FindTask(ctx context.Context, workflowRunID string, taskID string) (*Task, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/workflow_runs/" + url.PathEscape(workflowRunID) + "/tasks/" + url.PathEscape(taskID),
+		accept: "application/json",
+	}
+	var out Task
+	if err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// FindTimelineFile calls the find_timeline_file operation
+// GET /workflow_runs/{workflow_run_id}/timeline_file/{timeline_file_id}
+func (c *Client) FindTimelineFile(ctx context.Context, workflowRunID string, timelineFileID string, w io.Writer) error {
+	req := request{
+		method: http.MethodGet,
+		path:   "/workflow_runs/" + url.PathEscape(workflowRunID) + "/timeline_file/" + url.PathEscape(timelineFileID),
+		accept: "application/pdf",
+	}
+	return c.do(ctx, req, w)
+}
+
+// FindWatchlistMonitor calls the find_watchlist_monitor operation
+// GET /watchlist_monitors/{monitor_id}
+func (c *Client) FindWatchlistMonitor(ctx context.Context, monitorID string) (*WatchlistMonitor, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/watchlist_monitors/" + url.PathEscape(monitorID),
+		accept: "application/json",
+	}
+	var out WatchlistMonitor
+	if err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// FindWebhook calls the find_webhook operation
+// GET /webhooks/{webhook_id}
+func (c *Client) FindWebhook(ctx context.Context, webhookID string) (*Webhook, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/webhooks/" + url.PathEscape(webhookID),
+		accept: "application/json",
+	}
+	var out Webhook
+	if err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// FindWorkflowRun calls the find_workflow_run operation
+// GET /workflow_runs/{workflow_run_id}
+func (c *Client) FindWorkflowRun(ctx context.Context, workflowRunID string) (*WorkflowRun, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/workflow_runs/" + url.PathEscape(workflowRunID),
+		accept: "application/json",
+	}
+	var out WorkflowRun
+	if err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ForceReportCreationFromWatchlistMonitor calls the force_report_creation_from_watchlist_monitor operation
+// POST /watchlist_monitors/{monitor_id}/new_report
+func (c *Client) ForceReportCreationFromWatchlistMonitor(ctx context.Context, monitorID string) error {
+	req := request{
+		method: http.MethodPost,
+		path:   "/watchlist_monitors/" + url.PathEscape(monitorID) + "/new_report",
+		accept: "application/json",
+	}
+	return c.do(ctx, req, nil)
+}
+
+// GenerateSdkToken calls the generate_sdk_token operation
+// POST /sdk_token
+func (c *Client) GenerateSdkToken(ctx context.Context, body SdkTokenBuilder) (*SdkToken, error) {
+	req := request{
+		method: http.MethodPost,
+		path:   "/sdk_token",
+		accept: "application/json",
+		json:   body,
+	}
+	var out SdkToken
+	if err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListApplicantsParams are the optional query parameters of ListApplicants
+type ListApplicantsParams struct {
+	Page           int
+	PerPage        int
+	IncludeDeleted bool
+}
+
+func (p *ListApplicantsParams) encode(q url.Values) {
+	if p == nil {
+		return
+	}
+	setQuery(q, "page", p.Page)
+	setQuery(q, "per_page", p.PerPage)
+	setQuery(q, "include_deleted", p.IncludeDeleted)
+}
+
+// ListApplicants calls the list_applicants operation
+// GET /applicants
+func (c *Client) ListApplicants(ctx context.Context, params *ListApplicantsParams) (*ApplicantsList, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/applicants",
+		accept: "application/json",
+	}
+	req.query = make(url.Values)
+	params.encode(req.query)
+	var out ApplicantsList
+	if err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListChecks calls the list_checks operation
+// GET /checks
+func (c *Client) ListChecks(ctx context.Context, applicantID string) (*ChecksList, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/checks",
+		accept: "application/json",
+	}
+	req.query = make(url.Values)
+	setQuery(req.query, "applicant_id", applicantID)
+	var out ChecksList
+	if err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListDocuments calls the list_documents operation
+// GET /documents
+func (c *Client) ListDocuments(ctx context.Context, applicantID string) (*DocumentsList, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/documents",
+		accept: "application/json",
+	}
+	req.query = make(url.Values)
+	setQuery(req.query, "applicant_id", applicantID)
+	var out DocumentsList
+	if err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListIDPhotos calls the list_id_photos operation
+// GET /id_photos
+func (c *Client) ListIDPhotos(ctx context.Context, applicantID string) (*IDPhotosList, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/id_photos",
+		accept: "application/json",
+	}
+	req.query = make(url.Values)
+	setQuery(req.query, "applicant_id", applicantID)
+	var out IDPhotosList
+	if err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListLivePhotos calls the list_live_photos operation
+// GET /live_photos
+func (c *Client) ListLivePhotos(ctx context.Context, applicantID string) (*LivePhotosList, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/live_photos",
+		accept: "application/json",
+	}
+	req.query = make(url.Values)
+	setQuery(req.query, "applicant_id", applicantID)
+	var out LivePhotosList
+	if err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListLiveVideos calls the list_live_videos operation
+// GET /live_videos
+func (c *Client) ListLiveVideos(ctx context.Context, applicantID string) (*LiveVideosList, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/live_videos",
+		accept: "application/json",
+	}
+	req.query = make(url.Values)
+	setQuery(req.query, "applicant_id", applicantID)
+	var out LiveVideosList
+	if err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListMotionCaptures calls the list_motion_captures operation
+// GET /motion_captures
+func (c *Client) ListMotionCaptures(ctx context.Context, applicantID string) (*MotionCapturesList, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/motion_captures",
+		accept: "application/json",
+	}
+	req.query = make(url.Values)
+	setQuery(req.query, "applicant_id", applicantID)
+	var out MotionCapturesList
+	if err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListReports calls the list_reports operation
+// GET /reports
+func (c *Client) ListReports(ctx context.Context, checkID string) (*ReportsList, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/reports",
+		accept: "application/json",
+	}
+	req.query = make(url.Values)
+	setQuery(req.query, "check_id", checkID)
+	var out ReportsList
+	if err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListSigningDocuments calls the list_signing_documents operation
+// GET /signing_documents
+func (c *Client) ListSigningDocuments(ctx context.Context, applicantID string) (*SigningDocumentsList, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/signing_documents",
+		accept: "application/json",
+	}
+	req.query = make(url.Values)
+	setQuery(req.query, "applicant_id", applicantID)
+	var out SigningDocumentsList
+	if err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListTasks calls the list_tasks operation
+// GET /workflow_runs/{workflow_run_id}/tasks
+func (c *Client) ListTasks(ctx context.Context, workflowRunID string) ([]*TaskItem, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/workflow_runs/" + url.PathEscape(workflowRunID) + "/tasks",
+		accept: "application/json",
+	}
+	var out []*TaskItem
+	err := c.do(ctx, req, &out)
+	return out, err
+}
+
+// ListWatchlistMonitorMatches calls the list_watchlist_monitor_matches operation
+// GET /watchlist_monitors/{monitor_id}/matches
+func (c *Client) ListWatchlistMonitorMatches(ctx context.Context, monitorID string) (*WatchlistMonitorMatchesList, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/watchlist_monitors/" + url.PathEscape(monitorID) + "/matches",
+		accept: "application/json",
+	}
+	var out WatchlistMonitorMatchesList
+	if err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListWatchlistMonitorsParams are the optional query parameters of ListWatchlistMonitors
+type ListWatchlistMonitorsParams struct {
+	IncludeDeleted bool
+}
+
+func (p *ListWatchlistMonitorsParams) encode(q url.Values) {
+	if p == nil {
+		return
+	}
+	setQuery(q, "include_deleted", p.IncludeDeleted)
+}
+
+// ListWatchlistMonitors calls the list_watchlist_monitors operation
+// GET /watchlist_monitors
+func (c *Client) ListWatchlistMonitors(ctx context.Context, applicantID string, params *ListWatchlistMonitorsParams) (*WatchlistMonitorsList, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/watchlist_monitors",
+		accept: "application/json",
+	}
+	req.query = make(url.Values)
+	setQuery(req.query, "applicant_id", applicantID)
+	params.encode(req.query)
+	var out WatchlistMonitorsList
+	if err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListWebhooks calls the list_webhooks operation
+// GET /webhooks
+func (c *Client) ListWebhooks(ctx context.Context) (*WebhooksList, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/webhooks",
+		accept: "application/json",
+	}
+	var out WebhooksList
+	if err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListWorkflowRunsParams are the optional query parameters of ListWorkflowRuns
+type ListWorkflowRunsParams struct {
+	Page        int
+	Status      string
+	CreatedAtGt *time.Time
+	CreatedAtLt *time.Time
+	Sort        ListWorkflowRunsSort
+}
+
+func (p *ListWorkflowRunsParams) encode(q url.Values) {
+	if p == nil {
+		return
+	}
+	setQuery(q, "page", p.Page)
+	setQuery(q, "status", p.Status)
+	setQuery(q, "created_at_gt", p.CreatedAtGt)
+	setQuery(q, "created_at_lt", p.CreatedAtLt)
+	setQuery(q, "sort", p.Sort)
+}
+
+// ListWorkflowRuns calls the list_workflow_runs operation
+// GET /workflow_runs
+func (c *Client) ListWorkflowRuns(ctx context.Context, params *ListWorkflowRunsParams) ([]*WorkflowRun, error) {
+	req := request{
+		method: http.MethodGet,
+		path:   "/workflow_runs",
+		accept: "application/json",
+	}
+	req.query = make(url.Values)
+	params.encode(req.query)
+	var out []*WorkflowRun
+	err := c.do(ctx, req, &out)
+	return out, err
+}
+
+// RestoreApplicant calls the restore_applicant operation
+// POST /applicants/{applicant_id}/restore
+func (c *Client) RestoreApplicant(ctx context.Context, applicantID string) error {
+	req := request{
+		method: http.MethodPost,
+		path:   "/applicants/" + url.PathEscape(applicantID) + "/restore",
+		accept: "application/json",
+	}
+	return c.do(ctx, req, nil)
+}
+
+// ResumeCheck calls the resume_check operation
+// POST /checks/{check_id}/resume
+func (c *Client) ResumeCheck(ctx context.Context, checkID string) error {
+	req := request{
+		method: http.MethodPost,
+		path:   "/checks/" + url.PathEscape(checkID) + "/resume",
+		accept: "application/json",
+	}
+	return c.do(ctx, req, nil)
+}
+
+// ResumeReport calls the resume_report operation
+// POST /reports/{report_id}/resume
+func (c *Client) ResumeReport(ctx context.Context, reportID string) error {
+	req := request{
+		method: http.MethodPost,
+		path:   "/reports/" + url.PathEscape(reportID) + "/resume",
+		accept: "application/json",
+	}
+	return c.do(ctx, req, nil)
+}
+
+// UpdateApplicant calls the update_applicant operation
+// PUT /applicants/{applicant_id}
+func (c *Client) UpdateApplicant(ctx context.Context, applicantID string, body ApplicantUpdater) (*Applicant, error) {
+	req := request{
+		method: http.MethodPut,
+		path:   "/applicants/" + url.PathEscape(applicantID),
+		accept: "application/json",
+		json:   body,
+	}
+	var out Applicant
+	if err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateWatchlistMonitorMatch calls the update_watchlist_monitor_match operation
+// PATCH /watchlist_monitors/{monitor_id}/matches
+func (c *Client) UpdateWatchlistMonitorMatch(ctx context.Context, monitorID string, body WatchlistMonitorMatchesUpdater) (*WatchlistMonitorMatchesList, error) {
+	req := request{
+		method: http.MethodPatch,
+		path:   "/watchlist_monitors/" + url.PathEscape(monitorID) + "/matches",
+		accept: "application/json",
+		json:   body,
+	}
+	var out WatchlistMonitorMatchesList
+	if err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateWebhook calls the update_webhook operation
+// PUT /webhooks/{webhook_id}
+func (c *Client) UpdateWebhook(ctx context.Context, webhookID string, body WebhookUpdater) (*Webhook, error) {
+	req := request{
+		method: http.MethodPut,
+		path:   "/webhooks/" + url.PathEscape(webhookID),
+		accept: "application/json",
+		json:   body,
+	}
+	var out Webhook
+	if err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UploadDocument calls the upload_document operation
+// POST /documents
+func (c *Client) UploadDocument(ctx context.Context, body DocumentUpload) (*Document, error) {
+	req := request{
+		method:    http.MethodPost,
+		path:      "/documents",
+		accept:    "application/json",
+		multipart: body.multipartFields(),
+	}
+	var out Document
+	if err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UploadIDPhoto calls the upload_id_photo operation
+// POST /id_photos
+func (c *Client) UploadIDPhoto(ctx context.Context, body IDPhotoUpload) (*IDPhoto, error) {
+	req := request{
+		method:    http.MethodPost,
+		path:      "/id_photos",
+		accept:    "application/json",
+		multipart: body.multipartFields(),
+	}
+	var out IDPhoto
+	if err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UploadLivePhoto calls the upload_live_photo operation
+// POST /live_photos
+func (c *Client) UploadLivePhoto(ctx context.Context, body LivePhotoUpload) (*LivePhoto, error) {
+	req := request{
+		method:    http.MethodPost,
+		path:      "/live_photos",
+		accept:    "application/json",
+		multipart: body.multipartFields(),
+	}
+	var out LivePhoto
+	if err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UploadSigningDocument calls the upload_signing_document operation
+// POST /signing_documents
+func (c *Client) UploadSigningDocument(ctx context.Context, body SigningDocumentUpload) (*SigningDocument, error) {
+	req := request{
+		method:    http.MethodPost,
+		path:      "/signing_documents",
+		accept:    "application/json",
+		multipart: body.multipartFields(),
+	}
+	var out SigningDocument
+	if err := c.do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
@@ -0,0 +1,153 @@
+package onfidoexport
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mbowman100/go-onfido"
+)
+
+// Redacted replaces the values of PII columns when Exporter.Redact is set.
+const Redacted = "[redacted]"
+
+// BreakdownColumn is the column of all the breakdown results of a report,
+// flattened by their path, e.g. "visual_authenticity.fonts". A single result
+// is exported by its path prefixed with "breakdown.".
+const BreakdownColumn = "breakdown"
+
+// Column is an exported field of a row
+type Column struct {
+	Name string
+	// PII columns are redacted when Exporter.Redact is set.
+	PII   bool
+	Value func(r *Row) interface{}
+}
+
+// DefaultColumns are exported when Exporter.Columns is empty.
+var DefaultColumns = []string{
+	"applicant_id", "check_id", "check_created_at", "check_status", "check_result",
+	"report_id", "report_name", "report_status", "report_result", "report_sub_result",
+}
+
+var columns = []Column{
+	{Name: "applicant_id", Value: applicantValue(func(a *onfido.Applicant) interface{} { return a.ID })},
+	{Name: "applicant_created_at", Value: applicantValue(func(a *onfido.Applicant) interface{} { return timestamp(a.CreatedAt) })},
+	{Name: "title", PII: true, Value: applicantValue(func(a *onfido.Applicant) interface{} { return a.Title })},
+	{Name: "first_name", PII: true, Value: applicantValue(func(a *onfido.Applicant) interface{} { return a.FirstName })},
+	{Name: "middle_name", PII: true, Value: applicantValue(func(a *onfido.Applicant) interface{} { return a.MiddleName })},
+	{Name: "last_name", PII: true, Value: applicantValue(func(a *onfido.Applicant) interface{} { return a.LastName })},
+	{Name: "email", PII: true, Value: applicantValue(func(a *onfido.Applicant) interface{} { return a.Email })},
+	{Name: "dob", PII: true, Value: applicantValue(func(a *onfido.Applicant) interface{} { return a.DOB })},
+	{Name: "sandbox", Value: applicantValue(func(a *onfido.Applicant) interface{} { return a.Sandbox })},
+
+	{Name: "check_id", Value: checkValue(func(c *onfido.Check) interface{} { return c.ID })},
+	{Name: "check_created_at", Value: checkValue(func(c *onfido.Check) interface{} { return timestamp(c.CreatedAt) })},
+	{Name: "check_status", Value: checkValue(func(c *onfido.Check) interface{} { return string(c.Status) })},
+	{Name: "check_result", Value: checkValue(func(c *onfido.Check) interface{} { return string(c.Result) })},
+	{Name: "check_tags", Value: checkValue(func(c *onfido.Check) interface{} { return c.Tags })},
+	{Name: "results_uri", Value: checkValue(func(c *onfido.Check) interface{} { return c.ResultsURI })},
+
+	{Name: "report_id", Value: reportValue(func(r *onfido.Report) interface{} { return r.ID })},
+	{Name: "report_name", Value: reportValue(func(r *onfido.Report) interface{} { return string(r.Name) })},
+	{Name: "report_created_at", Value: reportValue(func(r *onfido.Report) interface{} { return timestamp(r.CreatedAt) })},
+	{Name: "report_status", Value: reportValue(func(r *onfido.Report) interface{} { return r.Status })},
+	{Name: "report_result", Value: reportValue(func(r *onfido.Report) interface{} { return string(r.Result) })},
+	{Name: "report_sub_result", Value: reportValue(func(r *onfido.Report) interface{} { return string(r.SubResult) })},
+	{Name: BreakdownColumn, Value: reportValue(func(r *onfido.Report) interface{} { return flattenBreakdown(r.Breakdown) })},
+}
+
+// applicantValue, checkValue and reportValue return the value of a column
+// of the row's applicant, check or report, nil if the row doesn't have one.
+func applicantValue(value func(a *onfido.Applicant) interface{}) func(r *Row) interface{} {
+	return func(r *Row) interface{} {
+		if r.Applicant == nil {
+			return nil
+		}
+		return value(r.Applicant)
+	}
+}
+
+func checkValue(value func(c *onfido.Check) interface{}) func(r *Row) interface{} {
+	return func(r *Row) interface{} {
+		if r.Check == nil {
+			return nil
+		}
+		return value(r.Check)
+	}
+}
+
+func reportValue(value func(r *onfido.Report) interface{}) func(r *Row) interface{} {
+	return func(r *Row) interface{} {
+		if r.Report == nil {
+			return nil
+		}
+		return value(r.Report)
+	}
+}
+
+// ColumnNames returns the names of the known columns, breakdown results
+// can also be selected by their path, e.g. "breakdown.visual_authenticity.fonts".
+func ColumnNames() []string {
+	names := make([]string, len(columns))
+	for i, c := range columns {
+		names[i] = c.Name
+	}
+	return names
+}
+
+// ParseColumns returns the columns with the given names, or an error if one is unknown.
+func ParseColumns(names []string) ([]Column, error) {
+	cols := make([]Column, 0, len(names))
+	for _, name := range names {
+		c, ok := findColumn(name)
+		if !ok {
+			return nil, fmt.Errorf("unknown column %q, expected one of %s or breakdown.<path>", name, strings.Join(ColumnNames(), ", "))
+		}
+		cols = append(cols, c)
+	}
+	return cols, nil
+}
+
+func findColumn(name string) (Column, bool) {
+	for _, c := range columns {
+		if c.Name == name {
+			return c, true
+		}
+	}
+	path := strings.TrimPrefix(name, BreakdownColumn+".")
+	if path == name || path == "" {
+		return Column{}, false
+	}
+	return Column{Name: name, Value: reportValue(func(r *onfido.Report) interface{} {
+		if result, ok := flattenBreakdown(r.Breakdown)[path]; ok {
+			return result
+		}
+		return nil
+	})}, true
+}
+
+// flattenBreakdown returns the results of a breakdown by path, e.g.
+// "visual_authenticity" and "visual_authenticity.fonts". Results which
+// are null are omitted.
+func flattenBreakdown(b onfido.Breakdowns) map[string]string {
+	flat := make(map[string]string)
+	for name, bd := range b {
+		if bd.Result != nil {
+			flat[name] = string(*bd.Result)
+		}
+		for sub, sbd := range bd.SubBreakdowns {
+			if sbd.Result != nil {
+				flat[name+"."+sub] = string(*sbd.Result)
+			}
+		}
+	}
+	return flat
+}
+
+func timestamp(t *time.Time) interface{} {
+	if t == nil {
+		return nil
+	}
+	return t.UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,40 @@
+package onfidoexport
+
+import (
+	"testing"
+
+	"github.com/mbowman100/go-onfido"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseColumns(t *testing.T) {
+	cols, err := ParseColumns(append([]string{"breakdown.data_validation"}, DefaultColumns...))
+	if assert.NoError(t, err) {
+		assert.Len(t, cols, len(DefaultColumns)+1)
+	}
+
+	for _, name := range []string{"", "breakdown.", "breakdowns.x", "first name"} {
+		_, err := ParseColumns([]string{name})
+		assert.Error(t, err, name)
+	}
+
+	cols, _ = ParseColumns([]string{"first_name", "check_id"})
+	assert.True(t, cols[0].PII)
+	assert.False(t, cols[1].PII)
+}
+
+func TestFlattenBreakdown(t *testing.T) {
+	assert.Equal(t, map[string]string{}, flattenBreakdown(nil))
+	assert.Equal(t, map[string]string{
+		"age_validation":                      "clear",
+		"age_validation.minimum_accepted_age": "clear",
+		"image_integrity.supported_document":  "consider",
+	}, flattenBreakdown(onfido.Breakdowns{
+		"age_validation": {Result: result(onfido.BreakdownClear), SubBreakdowns: onfido.SubBreakdowns{
+			"minimum_accepted_age": {Result: subResult(onfido.SubBreakdownClear)},
+		}},
+		"image_integrity": {SubBreakdowns: onfido.SubBreakdowns{
+			"supported_document": {Result: subResult(onfido.SubBreakdownConsider)},
+		}},
+	}))
+}
@@ -0,0 +1,327 @@
+// Package onfidoexport exports the outcomes of verifications in bulk: a row
+// per report, with its check and applicant, written as NDJSON or CSV.
+//
+//	ex := &onfidoexport.Exporter{Client: client, Columns: []string{"check_id", "report_name", "report_result", "breakdown"}}
+//	w, _ := onfidoexport.NewWriter(onfidoexport.FormatCSV, os.Stdout)
+//	res, err := ex.Export(ctx, w)
+//
+// Exports can be incremental: resumed from the Checkpoint of the previous
+// export, only the checks created since, and those which weren't finished
+// yet, are exported. LoadCheckpoint and SaveCheckpoint keep the checkpoint in
+// a file between runs.
+//
+// Applicants without checks and checks without reports have no rows, unless
+// Exporter.IncludeEmpty is set.
+package onfidoexport
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/mbowman100/go-onfido"
+)
+
+// DefaultConcurrency is the number of applicants exported concurrently when
+// Exporter.Concurrency isn't set.
+const DefaultConcurrency = 4
+
+// Row is an exported report, with its check and applicant. Check and Report
+// are nil in the rows of applicants without checks and checks without reports,
+// see Exporter.IncludeEmpty.
+type Row struct {
+	Applicant *onfido.Applicant
+	Check     *onfido.Check
+	Report    *onfido.Report
+}
+
+// Exporter walks applicants, their checks and the checks' reports
+type Exporter struct {
+	Client onfido.OnfidoClient
+	// Columns are the names of the exported columns, DefaultColumns if empty.
+	// See ColumnNames.
+	Columns []string
+	// Concurrency is the number of applicants whose checks and reports are
+	// listed concurrently, DefaultConcurrency if not set. Rows are written in
+	// the order of the applicants regardless.
+	Concurrency int
+	// Checkpoint resumes from the Result.Checkpoint of the previous export,
+	// all the checks are exported if it's zero.
+	Checkpoint Checkpoint
+	// IncludePending exports the checks which aren't complete or withdrawn
+	// yet. By default they are skipped. Either way they are kept in
+	// Result.Checkpoint, so that incremental exports export them again until
+	// they are finished.
+	IncludePending bool
+	// IncludeEmpty writes a row for the applicants without checks, created
+	// since the checkpoint, and for the exported checks without reports. The
+	// columns of their missing check or report are null.
+	IncludeEmpty bool
+	// Redact replaces the values of PII columns, such as names, email and
+	// date of birth, with Redacted.
+	Redact bool
+}
+
+// Result represents the outcome of an export
+type Result struct {
+	// Applicants is the number of applicants walked.
+	Applicants int
+	// Checks and Reports are the numbers of exported checks and reports.
+	Checks  int
+	Reports int
+	// Pending is the number of checks which aren't finished, skipped unless
+	// IncludePending is set.
+	Pending int
+	// Checkpoint is the Exporter.Checkpoint of the next incremental export.
+	// With IncludePending, the rows of pending checks are exported again by
+	// every export until the checks are finished, and should be deduplicated
+	// by report_id, keeping the last.
+	Checkpoint Checkpoint
+}
+
+// Checkpoint is where an incremental export resumes from. Check creation
+// times are only precise to the second, so the checks created at Since which
+// were already exported are kept by ID.
+type Checkpoint struct {
+	// Since only exports the checks created after it, or at it if not in Seen.
+	Since time.Time `json:"since"`
+	// Seen are the IDs of the checks created at Since already exported.
+	Seen []string `json:"seen,omitempty"`
+	// Pending are the IDs of the checks which weren't finished, exported
+	// again regardless of Since.
+	Pending []string `json:"pending,omitempty"`
+}
+
+// exports returns whether the check is exported when resuming from the checkpoint.
+func (cp *Checkpoint) exports(chk *onfido.Check) bool {
+	if cp.Since.IsZero() || contains(cp.Pending, chk.ID) {
+		return true
+	}
+	if chk.CreatedAt == nil {
+		return false
+	}
+	return chk.CreatedAt.After(cp.Since) || chk.CreatedAt.Equal(cp.Since) && !contains(cp.Seen, chk.ID)
+}
+
+// advance moves the checkpoint past the exported check.
+func (cp *Checkpoint) advance(chk *onfido.Check) {
+	switch {
+	case chk.CreatedAt == nil:
+	case chk.CreatedAt.After(cp.Since):
+		cp.Since, cp.Seen = *chk.CreatedAt, []string{chk.ID}
+	case chk.CreatedAt.Equal(cp.Since) && !contains(cp.Seen, chk.ID):
+		cp.Seen = append(cp.Seen, chk.ID)
+	}
+}
+
+func contains(values []string, v string) bool {
+	for _, s := range values {
+		if s == v {
+			return true
+		}
+	}
+	return false
+}
+
+// applicantExport is the export of an applicant's checks, listed concurrently.
+type applicantExport struct {
+	rows []*Row
+	// checks are the exported checks, pending the IDs of those not finished.
+	checks  []*onfido.Check
+	pending []string
+	err     error
+	done    chan struct{}
+}
+
+// Export walks the applicants and writes a row per report of their finished
+// checks created since the checkpoint. It stops at the first error.
+func (e *Exporter) Export(ctx context.Context, w Writer) (*Result, error) {
+	if e.Client == nil {
+		return nil, errors.New("export requires a client")
+	}
+	names := e.Columns
+	if len(names) == 0 {
+		names = DefaultColumns
+	}
+	cols, err := ParseColumns(names)
+	if err != nil {
+		return nil, err
+	}
+	concurrency := e.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultConcurrency
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// Applicants are exported concurrently but queued in order, so that the
+	// rows are written in order. The queue bounds how far ahead the export
+	// of applicants can get from the writer.
+	queue := make(chan *applicantExport, concurrency)
+	go func() {
+		defer close(queue)
+		sem := make(chan struct{}, concurrency)
+		applicants := e.Client.ListApplicants()
+		for applicants.Next(ctx) {
+			a := applicants.Applicant()
+			ae := &applicantExport{done: make(chan struct{})}
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+			go func() {
+				defer func() { <-sem }()
+				e.exportApplicant(ctx, a, ae)
+				close(ae.done)
+			}()
+			select {
+			case queue <- ae:
+			case <-ctx.Done():
+				return
+			}
+		}
+		if err := applicants.Err(); err != nil {
+			ae := &applicantExport{err: err, done: make(chan struct{})}
+			close(ae.done)
+			select {
+			case queue <- ae:
+			case <-ctx.Done():
+			}
+		}
+	}()
+
+	if err := w.WriteHeader(names); err != nil {
+		return nil, err
+	}
+	res := &Result{Checkpoint: Checkpoint{
+		Since: e.Checkpoint.Since,
+		Seen:  append([]string(nil), e.Checkpoint.Seen...),
+	}}
+	values := make([]interface{}, len(cols))
+	for ae := range queue {
+		<-ae.done
+		if ae.err != nil {
+			return res, ae.err
+		}
+		res.Applicants++
+		res.Checks += len(ae.checks)
+		res.Pending += len(ae.pending)
+		for _, chk := range ae.checks {
+			res.Checkpoint.advance(chk)
+		}
+		res.Checkpoint.Pending = append(res.Checkpoint.Pending, ae.pending...)
+
+		for _, row := range ae.rows {
+			for i, c := range cols {
+				if e.Redact && c.PII {
+					values[i] = Redacted
+					continue
+				}
+				values[i] = c.Value(row)
+			}
+			if err := w.WriteRow(values); err != nil {
+				return res, err
+			}
+			if row.Report != nil {
+				res.Reports++
+			}
+		}
+	}
+	// The queue is closed early if the context is done.
+	if err := ctx.Err(); err != nil {
+		return res, err
+	}
+	return res, w.Flush()
+}
+
+// exportApplicant lists the checks of the applicant and the reports of those to export.
+func (e *Exporter) exportApplicant(ctx context.Context, a *onfido.Applicant, ae *applicantExport) {
+	hasChecks := false
+	checks := e.Client.ListChecks(a.ID)
+	for checks.Next(ctx) {
+		chk := checks.Check()
+		hasChecks = true
+		if !e.Checkpoint.exports(chk) {
+			continue
+		}
+		if chk.Status != onfido.CheckStatusComplete && chk.Status != onfido.CheckStatusWithdrawn {
+			ae.pending = append(ae.pending, chk.ID)
+			if !e.IncludePending {
+				continue
+			}
+		}
+
+		hasReports := false
+		reports := e.Client.ListReports(chk.ID)
+		for reports.Next(ctx) {
+			hasReports = true
+			ae.rows = append(ae.rows, &Row{Applicant: a, Check: chk, Report: reports.Report()})
+		}
+		if err := reports.Err(); err != nil {
+			ae.err = err
+			return
+		}
+		if !hasReports && e.IncludeEmpty {
+			ae.rows = append(ae.rows, &Row{Applicant: a, Check: chk})
+		}
+		ae.checks = append(ae.checks, chk)
+	}
+	if err := checks.Err(); err != nil {
+		ae.err = err
+		return
+	}
+
+	// Applicants created at the checkpoint may have been exported already,
+	// they are exported again rather than missed.
+	since := e.Checkpoint.Since
+	if !hasChecks && e.IncludeEmpty && (since.IsZero() || a.CreatedAt == nil || !a.CreatedAt.Before(since)) {
+		ae.rows = append(ae.rows, &Row{Applicant: a})
+	}
+}
+
+// LoadCheckpoint returns the checkpoint saved at path, or the zero checkpoint
+// if there is no file yet.
+func LoadCheckpoint(path string) (Checkpoint, error) {
+	var cp Checkpoint
+	b, err := ioutil.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return cp, nil
+	}
+	if err != nil {
+		return cp, err
+	}
+	err = json.Unmarshal(b, &cp)
+	return cp, err
+}
+
+// SaveCheckpoint saves the checkpoint at path, replacing the file atomically.
+func SaveCheckpoint(path string, cp Checkpoint) error {
+	cp.Since = cp.Since.UTC()
+	b, err := json.Marshal(cp)
+	if err != nil {
+		return err
+	}
+	f, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path)+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	if _, err := f.Write(append(b, '\n')); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), path)
+}
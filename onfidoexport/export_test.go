@@ -0,0 +1,271 @@
+package onfidoexport
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/mbowman100/go-onfido"
+	"github.com/stretchr/testify/assert"
+)
+
+// stubClient serves applicants, checks and reports from memory.
+type stubClient struct {
+	onfido.OnfidoClient
+	applicants []*onfido.Applicant
+	checks     map[string][]*onfido.Check
+	reports    map[string][]*onfido.Report
+	reportsErr error
+
+	mu         sync.Mutex
+	inFlight   int
+	maxFlight  int
+	checkDelay time.Duration
+}
+
+func (c *stubClient) ListApplicants() *onfido.ApplicantIter {
+	return onfido.NewApplicantIter([][]*onfido.Applicant{c.applicants}, nil)
+}
+
+func (c *stubClient) ListChecks(applicantID string) *onfido.CheckIter {
+	c.mu.Lock()
+	c.inFlight++
+	if c.inFlight > c.maxFlight {
+		c.maxFlight = c.inFlight
+	}
+	c.mu.Unlock()
+	time.Sleep(c.checkDelay)
+	c.mu.Lock()
+	c.inFlight--
+	c.mu.Unlock()
+	return onfido.NewCheckIter([][]*onfido.Check{c.checks[applicantID]}, nil)
+}
+
+func (c *stubClient) ListReports(checkID string) *onfido.ReportIter {
+	return onfido.NewReportIter([][]*onfido.Report{c.reports[checkID]}, c.reportsErr)
+}
+
+func at(hour int) *time.Time {
+	t := time.Date(2024, 1, 31, hour, 0, 0, 0, time.UTC)
+	return &t
+}
+
+func result(r onfido.BreakdownResult) *onfido.BreakdownResult { return &r }
+
+func subResult(r onfido.BreakdownSubResult) *onfido.BreakdownSubResult { return &r }
+
+func newStubClient() *stubClient {
+	return &stubClient{
+		applicants: []*onfido.Applicant{
+			{ID: "a1", FirstName: "Jane", LastName: "Doe", Email: "jane@example.com"},
+			{ID: "a2", FirstName: "John", LastName: "Doe"},
+			{ID: "a3", FirstName: "No", LastName: "Checks"},
+		},
+		checks: map[string][]*onfido.Check{
+			"a1": {
+				{ID: "c1", CreatedAt: at(10), Status: onfido.CheckStatusComplete, Result: onfido.CheckResultConsider, Tags: []string{"x", "y"}},
+				{ID: "c2", CreatedAt: at(12), Status: onfido.CheckStatusInProgress},
+			},
+			"a2": {
+				{ID: "c3", CreatedAt: at(14), Status: onfido.CheckStatusComplete, Result: onfido.CheckResultClear},
+			},
+		},
+		reports: map[string][]*onfido.Report{
+			"c1": {
+				{ID: "r1", Name: onfido.ReportNameDocument, Status: "complete", Result: onfido.ReportResultConsider, Breakdown: onfido.Breakdowns{
+					"visual_authenticity": {Result: result(onfido.BreakdownConsider), SubBreakdowns: onfido.SubBreakdowns{
+						"fonts":    {Result: subResult(onfido.SubBreakdownConsider)},
+						"original": {Result: nil},
+					}},
+					"data_validation": {Result: result(onfido.BreakdownClear)},
+				}},
+				{ID: "r2", Name: onfido.ReportNameFacialSimilarityPhoto, Status: "complete", Result: onfido.ReportResultClear},
+			},
+			"c2": {{ID: "r3", Name: onfido.ReportNameDocument, Status: "in_progress"}},
+			"c3": {{ID: "r4", Name: onfido.ReportNameWatchlistStandard, Status: "complete", Result: onfido.ReportResultClear}},
+		},
+	}
+}
+
+func TestExport(t *testing.T) {
+	c := newStubClient()
+	var buf bytes.Buffer
+	ex := &Exporter{
+		Client:  c,
+		Columns: []string{"applicant_id", "email", "check_id", "check_tags", "report_id", "report_result", "breakdown.visual_authenticity.fonts", "breakdown"},
+	}
+	res, err := ex.Export(context.Background(), NewNDJSONWriter(&buf))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Equal(t, `{"applicant_id":"a1","email":"jane@example.com","check_id":"c1","check_tags":["x","y"],"report_id":"r1","report_result":"consider","breakdown.visual_authenticity.fonts":"consider","breakdown":{"data_validation":"clear","visual_authenticity":"consider","visual_authenticity.fonts":"consider"}}
+{"applicant_id":"a1","email":"jane@example.com","check_id":"c1","check_tags":["x","y"],"report_id":"r2","report_result":"clear","breakdown.visual_authenticity.fonts":null,"breakdown":{}}
+{"applicant_id":"a2","email":"","check_id":"c3","check_tags":null,"report_id":"r4","report_result":"clear","breakdown.visual_authenticity.fonts":null,"breakdown":{}}
+`, buf.String())
+	assert.Equal(t, &Result{
+		Applicants: 3,
+		Checks:     2,
+		Reports:    3,
+		Pending:    1,
+		Checkpoint: Checkpoint{Since: *at(14), Seen: []string{"c3"}, Pending: []string{"c2"}},
+	}, res)
+}
+
+func TestExport_Incremental(t *testing.T) {
+	c := newStubClient()
+	var buf bytes.Buffer
+	ex := &Exporter{Client: c, Columns: []string{"report_id"}}
+	res, err := ex.Export(context.Background(), NewCSVWriter(&buf))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	ex.Checkpoint = res.Checkpoint
+	buf.Reset()
+	res, err = ex.Export(context.Background(), NewCSVWriter(&buf))
+	if assert.NoError(t, err) {
+		assert.Equal(t, "report_id\n", buf.String(), "c2 is still pending")
+		assert.Equal(t, ex.Checkpoint, res.Checkpoint)
+	}
+
+	// c4 is created in the same second as c3, after the previous export.
+	c.checks["a1"][1].Status = onfido.CheckStatusComplete
+	c.checks["a2"] = append(c.checks["a2"], &onfido.Check{ID: "c4", CreatedAt: at(14), Status: onfido.CheckStatusComplete})
+	c.reports["c4"] = []*onfido.Report{{ID: "r5"}}
+	ex.Checkpoint = res.Checkpoint
+	buf.Reset()
+	res, err = ex.Export(context.Background(), NewCSVWriter(&buf))
+	if assert.NoError(t, err) {
+		assert.Equal(t, "report_id\nr3\nr5\n", buf.String(), "c2 is exported once finished, c3 isn't exported again")
+		assert.Equal(t, Checkpoint{Since: *at(14), Seen: []string{"c3", "c4"}}, res.Checkpoint)
+		assert.Equal(t, 0, res.Pending)
+	}
+
+	ex.Checkpoint = res.Checkpoint
+	buf.Reset()
+	res, err = ex.Export(context.Background(), NewCSVWriter(&buf))
+	if assert.NoError(t, err) {
+		assert.Equal(t, "report_id\n", buf.String())
+		assert.Equal(t, ex.Checkpoint, res.Checkpoint, "the checkpoint doesn't move without new checks")
+	}
+
+	c.checks["a2"] = append(c.checks["a2"], &onfido.Check{ID: "c5", CreatedAt: at(15), Status: onfido.CheckStatusComplete})
+	c.reports["c5"] = []*onfido.Report{{ID: "r6"}}
+	ex.Checkpoint = res.Checkpoint
+	buf.Reset()
+	res, err = ex.Export(context.Background(), NewCSVWriter(&buf))
+	if assert.NoError(t, err) {
+		assert.Equal(t, "report_id\nr6\n", buf.String())
+		assert.Equal(t, Checkpoint{Since: *at(15), Seen: []string{"c5"}}, res.Checkpoint)
+	}
+}
+
+func TestExport_IncludePendingAndRedact(t *testing.T) {
+	var buf bytes.Buffer
+	ex := &Exporter{
+		Client:         newStubClient(),
+		Columns:        []string{"report_id", "first_name", "email", "check_status"},
+		IncludePending: true,
+		Redact:         true,
+	}
+	res, err := ex.Export(context.Background(), NewCSVWriter(&buf))
+	if assert.NoError(t, err) {
+		assert.Equal(t, `report_id,first_name,email,check_status
+r1,[redacted],[redacted],complete
+r2,[redacted],[redacted],complete
+r3,[redacted],[redacted],in_progress
+r4,[redacted],[redacted],complete
+`, buf.String())
+		assert.Equal(t, 1, res.Pending)
+		assert.Equal(t, []string{"c2"}, res.Checkpoint.Pending, "c2 is exported again until finished")
+	}
+}
+
+func TestExport_IncludeEmpty(t *testing.T) {
+	c := newStubClient()
+	c.applicants[2].CreatedAt = at(9)
+	c.checks["a2"] = append(c.checks["a2"], &onfido.Check{ID: "c4", CreatedAt: at(15), Status: onfido.CheckStatusWithdrawn})
+
+	var buf bytes.Buffer
+	ex := &Exporter{Client: c, Columns: []string{"applicant_id", "check_id", "report_id", "breakdown.data_validation"}, IncludeEmpty: true}
+	res, err := ex.Export(context.Background(), NewCSVWriter(&buf))
+	if assert.NoError(t, err) {
+		assert.Equal(t, `applicant_id,check_id,report_id,breakdown.data_validation
+a1,c1,r1,clear
+a1,c1,r2,
+a2,c3,r4,
+a2,c4,,
+a3,,,
+`, buf.String())
+		assert.Equal(t, 3, res.Checks)
+		assert.Equal(t, 3, res.Reports)
+	}
+
+	// a3 was created before the checkpoint, it was exported already.
+	ex.Checkpoint = res.Checkpoint
+	buf.Reset()
+	if _, err := ex.Export(context.Background(), NewCSVWriter(&buf)); assert.NoError(t, err) {
+		assert.Equal(t, "applicant_id,check_id,report_id,breakdown.data_validation\n", buf.String())
+	}
+}
+
+func TestExport_Concurrency(t *testing.T) {
+	c := newStubClient()
+	c.checkDelay = 20 * time.Millisecond
+	for i := 0; i < 20; i++ {
+		c.applicants = append(c.applicants, &onfido.Applicant{ID: "extra"})
+	}
+
+	var buf bytes.Buffer
+	res, err := (&Exporter{Client: c, Concurrency: 3, Columns: []string{"report_id"}}).Export(context.Background(), NewCSVWriter(&buf))
+	if assert.NoError(t, err) {
+		assert.Equal(t, 23, res.Applicants)
+		assert.Equal(t, "report_id\nr1\nr2\nr4\n", buf.String(), "rows are written in order")
+	}
+	assert.Equal(t, 3, c.maxFlight)
+}
+
+func TestExport_Errors(t *testing.T) {
+	_, err := (&Exporter{}).Export(context.Background(), NewCSVWriter(&bytes.Buffer{}))
+	assert.Error(t, err)
+
+	_, err = (&Exporter{Client: newStubClient(), Columns: []string{"nope"}}).Export(context.Background(), NewCSVWriter(&bytes.Buffer{}))
+	assert.EqualError(t, err, `unknown column "nope", expected one of `+
+		`applicant_id, applicant_created_at, title, first_name, middle_name, last_name, email, dob, sandbox, `+
+		`check_id, check_created_at, check_status, check_result, check_tags, results_uri, `+
+		`report_id, report_name, report_created_at, report_status, report_result, report_sub_result, breakdown `+
+		`or breakdown.<path>`)
+
+	c := newStubClient()
+	c.reportsErr = errors.New("boom")
+	_, err = (&Exporter{Client: c}).Export(context.Background(), NewCSVWriter(&bytes.Buffer{}))
+	assert.EqualError(t, err, "boom")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = (&Exporter{Client: newStubClient()}).Export(ctx, NewCSVWriter(&bytes.Buffer{}))
+	assert.True(t, errors.Is(err, context.Canceled), err)
+}
+
+func TestCheckpoint(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "checkpoint.json")
+	cp, err := LoadCheckpoint(path)
+	if assert.NoError(t, err) {
+		assert.Equal(t, Checkpoint{}, cp, "no checkpoint yet")
+	}
+
+	want := Checkpoint{Since: *at(12), Seen: []string{"c2"}, Pending: []string{"c1"}}
+	if assert.NoError(t, SaveCheckpoint(path, want)) {
+		cp, err := LoadCheckpoint(path)
+		if assert.NoError(t, err) {
+			assert.Equal(t, want, cp)
+		}
+	}
+	matches, _ := filepath.Glob(filepath.Join(filepath.Dir(path), "*.tmp*"))
+	assert.Empty(t, matches)
+}
@@ -0,0 +1,122 @@
+package onfidoexport
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Formats of NewWriter
+const (
+	FormatNDJSON = "ndjson"
+	FormatCSV    = "csv"
+)
+
+// Writer writes the exported rows
+type Writer interface {
+	// WriteHeader is called once with the names of the columns, before the rows.
+	WriteHeader(columns []string) error
+	// WriteRow writes the values of a row, one per column: nil, string, bool,
+	// []string or map[string]string for the flattened breakdown.
+	WriteRow(values []interface{}) error
+	// Flush is called after the last row.
+	Flush() error
+}
+
+// NewWriter returns the writer of the format, FormatNDJSON or FormatCSV.
+func NewWriter(format string, w io.Writer) (Writer, error) {
+	switch format {
+	case FormatNDJSON:
+		return NewNDJSONWriter(w), nil
+	case FormatCSV:
+		return NewCSVWriter(w), nil
+	}
+	return nil, fmt.Errorf("unknown export format %q, expected %s or %s", format, FormatNDJSON, FormatCSV)
+}
+
+type ndjsonWriter struct {
+	w       *bufio.Writer
+	columns []string
+}
+
+// NewNDJSONWriter returns a writer of a JSON object per line, with the
+// columns as keys in order.
+func NewNDJSONWriter(w io.Writer) Writer {
+	return &ndjsonWriter{w: bufio.NewWriter(w)}
+}
+
+func (nw *ndjsonWriter) WriteHeader(columns []string) error {
+	nw.columns = columns
+	return nil
+}
+
+func (nw *ndjsonWriter) WriteRow(values []interface{}) error {
+	nw.w.WriteByte('{')
+	for i, v := range values {
+		if i > 0 {
+			nw.w.WriteByte(',')
+		}
+		key, err := json.Marshal(nw.columns[i])
+		if err != nil {
+			return err
+		}
+		value, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		nw.w.Write(key)
+		nw.w.WriteByte(':')
+		nw.w.Write(value)
+	}
+	nw.w.WriteString("}\n")
+	return nil
+}
+
+func (nw *ndjsonWriter) Flush() error {
+	return nw.w.Flush()
+}
+
+type csvWriter struct {
+	w *csv.Writer
+}
+
+// NewCSVWriter returns a writer of CSV with a header row. Lists are comma
+// separated and the flattened breakdown is a JSON object.
+func NewCSVWriter(w io.Writer) Writer {
+	return &csvWriter{w: csv.NewWriter(w)}
+}
+
+func (cw *csvWriter) WriteHeader(columns []string) error {
+	return cw.w.Write(columns)
+}
+
+func (cw *csvWriter) WriteRow(values []interface{}) error {
+	record := make([]string, len(values))
+	for i, v := range values {
+		switch v := v.(type) {
+		case nil:
+		case string:
+			record[i] = v
+		case bool:
+			record[i] = strconv.FormatBool(v)
+		case []string:
+			record[i] = strings.Join(v, ",")
+		default:
+			b, err := json.Marshal(v)
+			if err != nil {
+				return err
+			}
+			record[i] = string(b)
+		}
+	}
+	return cw.w.Write(record)
+}
+
+func (cw *csvWriter) Flush() error {
+	cw.w.Flush()
+	return cw.w.Error()
+}
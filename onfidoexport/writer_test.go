@@ -0,0 +1,31 @@
+package onfidoexport
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriters(t *testing.T) {
+	columns := []string{"s", "b", "list", "map", "null"}
+	values := []interface{}{"a \"quoted\", value", true, []string{"x", "y"}, map[string]string{"k": "v"}, nil}
+
+	for format, want := range map[string]string{
+		FormatNDJSON: `{"s":"a \"quoted\", value","b":true,"list":["x","y"],"map":{"k":"v"},"null":null}` + "\n",
+		FormatCSV:    "s,b,list,map,null\n\"a \"\"quoted\"\", value\",true,\"x,y\",\"{\"\"k\"\":\"\"v\"\"}\",\n",
+	} {
+		var buf bytes.Buffer
+		w, err := NewWriter(format, &buf)
+		if !assert.NoError(t, err) {
+			continue
+		}
+		assert.NoError(t, w.WriteHeader(columns))
+		assert.NoError(t, w.WriteRow(values))
+		assert.NoError(t, w.Flush())
+		assert.Equal(t, want, buf.String(), format)
+	}
+
+	_, err := NewWriter("xml", &bytes.Buffer{})
+	assert.Error(t, err)
+}
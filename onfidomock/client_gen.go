@@ -0,0 +1,3302 @@
+// Code generated by mockgen from the OnfidoClient interface; DO NOT EDIT.
+
+package onfidomock
+
+import (
+	"context"
+	"io"
+
+	"github.com/mbowman100/go-onfido"
+)
+
+var _ onfido.OnfidoClient = &Client{}
+
+// SetHTTPClientCall is an expected call to SetHTTPClient
+type SetHTTPClientCall struct {
+	*Call
+}
+
+// ExpectSetHTTPClient registers an expected call to SetHTTPClient. Arguments are matched
+// with Eq unless they are a Matcher.
+func (m *Client) ExpectSetHTTPClient(client interface{}) *SetHTTPClientCall {
+	return &SetHTTPClientCall{m.expect("SetHTTPClient", client)}
+}
+
+// Do sets a func called with the arguments of the call.
+func (c *SetHTTPClientCall) Do(fn func(client onfido.HTTPRequester)) *SetHTTPClientCall {
+	c.do = func(args []interface{}) []interface{} {
+		client, _ := args[0].(onfido.HTTPRequester)
+		fn(client)
+		return nil
+	}
+	return c
+}
+
+// Times limits the number of calls matching the expectation.
+func (c *SetHTTPClientCall) Times(n int) *SetHTTPClientCall {
+	c.Call.Times(n)
+	return c
+}
+
+// Once limits the expectation to a single call.
+func (c *SetHTTPClientCall) Once() *SetHTTPClientCall {
+	return c.Times(1)
+}
+
+// SetHTTPClient implements onfido.OnfidoClient.
+func (m *Client) SetHTTPClient(client onfido.HTTPRequester) {
+	m.called(0, "SetHTTPClient", client)
+}
+
+// NewSdkTokenWebCall is an expected call to NewSdkTokenWeb
+type NewSdkTokenWebCall struct {
+	*Call
+}
+
+// ExpectNewSdkTokenWeb registers an expected call to NewSdkTokenWeb. Arguments are matched
+// with Eq unless they are a Matcher.
+func (m *Client) ExpectNewSdkTokenWeb(ctx, applicantID, referrer interface{}) *NewSdkTokenWebCall {
+	return &NewSdkTokenWebCall{m.expect("NewSdkTokenWeb", ctx, applicantID, referrer)}
+}
+
+// Return sets the values returned by the call.
+func (c *NewSdkTokenWebCall) Return(r0 *onfido.SdkToken, r1 error) *NewSdkTokenWebCall {
+	c.returns = []interface{}{r0, r1}
+	return c
+}
+
+// Do sets a func called with the arguments of the call, which returns its values.
+func (c *NewSdkTokenWebCall) Do(fn func(ctx context.Context, applicantID string, referrer string) (*onfido.SdkToken, error)) *NewSdkTokenWebCall {
+	c.do = func(args []interface{}) []interface{} {
+		ctx, _ := args[0].(context.Context)
+		applicantID, _ := args[1].(string)
+		referrer, _ := args[2].(string)
+		r0, r1 := fn(ctx, applicantID, referrer)
+		return []interface{}{r0, r1}
+	}
+	return c
+}
+
+// Times limits the number of calls matching the expectation.
+func (c *NewSdkTokenWebCall) Times(n int) *NewSdkTokenWebCall {
+	c.Call.Times(n)
+	return c
+}
+
+// Once limits the expectation to a single call.
+func (c *NewSdkTokenWebCall) Once() *NewSdkTokenWebCall {
+	return c.Times(1)
+}
+
+// NewSdkTokenWeb implements onfido.OnfidoClient.
+func (m *Client) NewSdkTokenWeb(ctx context.Context, applicantID string, referrer string) (*onfido.SdkToken, error) {
+	rets := m.called(2, "NewSdkTokenWeb", ctx, applicantID, referrer)
+	r0, _ := rets[0].(*onfido.SdkToken)
+	r1, _ := rets[1].(error)
+	return r0, r1
+}
+
+// NewSdkTokenMobileCall is an expected call to NewSdkTokenMobile
+type NewSdkTokenMobileCall struct {
+	*Call
+}
+
+// ExpectNewSdkTokenMobile registers an expected call to NewSdkTokenMobile. Arguments are matched
+// with Eq unless they are a Matcher.
+func (m *Client) ExpectNewSdkTokenMobile(ctx, applicantID, applicationID interface{}) *NewSdkTokenMobileCall {
+	return &NewSdkTokenMobileCall{m.expect("NewSdkTokenMobile", ctx, applicantID, applicationID)}
+}
+
+// Return sets the values returned by the call.
+func (c *NewSdkTokenMobileCall) Return(r0 *onfido.SdkToken, r1 error) *NewSdkTokenMobileCall {
+	c.returns = []interface{}{r0, r1}
+	return c
+}
+
+// Do sets a func called with the arguments of the call, which returns its values.
+func (c *NewSdkTokenMobileCall) Do(fn func(ctx context.Context, applicantID string, applicationID string) (*onfido.SdkToken, error)) *NewSdkTokenMobileCall {
+	c.do = func(args []interface{}) []interface{} {
+		ctx, _ := args[0].(context.Context)
+		applicantID, _ := args[1].(string)
+		applicationID, _ := args[2].(string)
+		r0, r1 := fn(ctx, applicantID, applicationID)
+		return []interface{}{r0, r1}
+	}
+	return c
+}
+
+// Times limits the number of calls matching the expectation.
+func (c *NewSdkTokenMobileCall) Times(n int) *NewSdkTokenMobileCall {
+	c.Call.Times(n)
+	return c
+}
+
+// Once limits the expectation to a single call.
+func (c *NewSdkTokenMobileCall) Once() *NewSdkTokenMobileCall {
+	return c.Times(1)
+}
+
+// NewSdkTokenMobile implements onfido.OnfidoClient.
+func (m *Client) NewSdkTokenMobile(ctx context.Context, applicantID string, applicationID string) (*onfido.SdkToken, error) {
+	rets := m.called(2, "NewSdkTokenMobile", ctx, applicantID, applicationID)
+	r0, _ := rets[0].(*onfido.SdkToken)
+	r1, _ := rets[1].(error)
+	return r0, r1
+}
+
+// GetReportCall is an expected call to GetReport
+type GetReportCall struct {
+	*Call
+}
+
+// ExpectGetReport registers an expected call to GetReport. Arguments are matched
+// with Eq unless they are a Matcher.
+func (m *Client) ExpectGetReport(ctx, id interface{}) *GetReportCall {
+	return &GetReportCall{m.expect("GetReport", ctx, id)}
+}
+
+// Return sets the values returned by the call.
+func (c *GetReportCall) Return(r0 *onfido.Report, r1 error) *GetReportCall {
+	c.returns = []interface{}{r0, r1}
+	return c
+}
+
+// Do sets a func called with the arguments of the call, which returns its values.
+func (c *GetReportCall) Do(fn func(ctx context.Context, id string) (*onfido.Report, error)) *GetReportCall {
+	c.do = func(args []interface{}) []interface{} {
+		ctx, _ := args[0].(context.Context)
+		id, _ := args[1].(string)
+		r0, r1 := fn(ctx, id)
+		return []interface{}{r0, r1}
+	}
+	return c
+}
+
+// Times limits the number of calls matching the expectation.
+func (c *GetReportCall) Times(n int) *GetReportCall {
+	c.Call.Times(n)
+	return c
+}
+
+// Once limits the expectation to a single call.
+func (c *GetReportCall) Once() *GetReportCall {
+	return c.Times(1)
+}
+
+// GetReport implements onfido.OnfidoClient.
+func (m *Client) GetReport(ctx context.Context, id string) (*onfido.Report, error) {
+	rets := m.called(2, "GetReport", ctx, id)
+	r0, _ := rets[0].(*onfido.Report)
+	r1, _ := rets[1].(error)
+	return r0, r1
+}
+
+// ResumeReportCall is an expected call to ResumeReport
+type ResumeReportCall struct {
+	*Call
+}
+
+// ExpectResumeReport registers an expected call to ResumeReport. Arguments are matched
+// with Eq unless they are a Matcher.
+func (m *Client) ExpectResumeReport(ctx, id interface{}) *ResumeReportCall {
+	return &ResumeReportCall{m.expect("ResumeReport", ctx, id)}
+}
+
+// Return sets the values returned by the call.
+func (c *ResumeReportCall) Return(r0 error) *ResumeReportCall {
+	c.returns = []interface{}{r0}
+	return c
+}
+
+// Do sets a func called with the arguments of the call, which returns its values.
+func (c *ResumeReportCall) Do(fn func(ctx context.Context, id string) error) *ResumeReportCall {
+	c.do = func(args []interface{}) []interface{} {
+		ctx, _ := args[0].(context.Context)
+		id, _ := args[1].(string)
+		r0 := fn(ctx, id)
+		return []interface{}{r0}
+	}
+	return c
+}
+
+// Times limits the number of calls matching the expectation.
+func (c *ResumeReportCall) Times(n int) *ResumeReportCall {
+	c.Call.Times(n)
+	return c
+}
+
+// Once limits the expectation to a single call.
+func (c *ResumeReportCall) Once() *ResumeReportCall {
+	return c.Times(1)
+}
+
+// ResumeReport implements onfido.OnfidoClient.
+func (m *Client) ResumeReport(ctx context.Context, id string) error {
+	rets := m.called(1, "ResumeReport", ctx, id)
+	r0, _ := rets[0].(error)
+	return r0
+}
+
+// CancelReportCall is an expected call to CancelReport
+type CancelReportCall struct {
+	*Call
+}
+
+// ExpectCancelReport registers an expected call to CancelReport. Arguments are matched
+// with Eq unless they are a Matcher.
+func (m *Client) ExpectCancelReport(ctx, id interface{}) *CancelReportCall {
+	return &CancelReportCall{m.expect("CancelReport", ctx, id)}
+}
+
+// Return sets the values returned by the call.
+func (c *CancelReportCall) Return(r0 error) *CancelReportCall {
+	c.returns = []interface{}{r0}
+	return c
+}
+
+// Do sets a func called with the arguments of the call, which returns its values.
+func (c *CancelReportCall) Do(fn func(ctx context.Context, id string) error) *CancelReportCall {
+	c.do = func(args []interface{}) []interface{} {
+		ctx, _ := args[0].(context.Context)
+		id, _ := args[1].(string)
+		r0 := fn(ctx, id)
+		return []interface{}{r0}
+	}
+	return c
+}
+
+// Times limits the number of calls matching the expectation.
+func (c *CancelReportCall) Times(n int) *CancelReportCall {
+	c.Call.Times(n)
+	return c
+}
+
+// Once limits the expectation to a single call.
+func (c *CancelReportCall) Once() *CancelReportCall {
+	return c.Times(1)
+}
+
+// CancelReport implements onfido.OnfidoClient.
+func (m *Client) CancelReport(ctx context.Context, id string) error {
+	rets := m.called(1, "CancelReport", ctx, id)
+	r0, _ := rets[0].(error)
+	return r0
+}
+
+// ListReportsCall is an expected call to ListReports
+type ListReportsCall struct {
+	*Call
+}
+
+// ExpectListReports registers an expected call to ListReports. Arguments are matched
+// with Eq unless they are a Matcher.
+func (m *Client) ExpectListReports(checkID interface{}) *ListReportsCall {
+	return &ListReportsCall{m.expect("ListReports", checkID)}
+}
+
+// Return sets the values returned by the call.
+func (c *ListReportsCall) Return(r0 *onfido.ReportIter) *ListReportsCall {
+	c.returns = []interface{}{r0}
+	return c
+}
+
+// Do sets a func called with the arguments of the call, which returns its values.
+func (c *ListReportsCall) Do(fn func(checkID string) *onfido.ReportIter) *ListReportsCall {
+	c.do = func(args []interface{}) []interface{} {
+		checkID, _ := args[0].(string)
+		r0 := fn(checkID)
+		return []interface{}{r0}
+	}
+	return c
+}
+
+// Times limits the number of calls matching the expectation.
+func (c *ListReportsCall) Times(n int) *ListReportsCall {
+	c.Call.Times(n)
+	return c
+}
+
+// Once limits the expectation to a single call.
+func (c *ListReportsCall) Once() *ListReportsCall {
+	return c.Times(1)
+}
+
+// ListReports implements onfido.OnfidoClient.
+func (m *Client) ListReports(checkID string) *onfido.ReportIter {
+	rets := m.called(1, "ListReports", checkID)
+	r0, ok := rets[0].(*onfido.ReportIter)
+	if !ok {
+		r0 = onfido.NewReportIter(nil, nil)
+	}
+	return r0
+}
+
+// GetDocumentCall is an expected call to GetDocument
+type GetDocumentCall struct {
+	*Call
+}
+
+// ExpectGetDocument registers an expected call to GetDocument. Arguments are matched
+// with Eq unless they are a Matcher.
+func (m *Client) ExpectGetDocument(ctx, id interface{}) *GetDocumentCall {
+	return &GetDocumentCall{m.expect("GetDocument", ctx, id)}
+}
+
+// Return sets the values returned by the call.
+func (c *GetDocumentCall) Return(r0 *onfido.Document, r1 error) *GetDocumentCall {
+	c.returns = []interface{}{r0, r1}
+	return c
+}
+
+// Do sets a func called with the arguments of the call, which returns its values.
+func (c *GetDocumentCall) Do(fn func(ctx context.Context, id string) (*onfido.Document, error)) *GetDocumentCall {
+	c.do = func(args []interface{}) []interface{} {
+		ctx, _ := args[0].(context.Context)
+		id, _ := args[1].(string)
+		r0, r1 := fn(ctx, id)
+		return []interface{}{r0, r1}
+	}
+	return c
+}
+
+// Times limits the number of calls matching the expectation.
+func (c *GetDocumentCall) Times(n int) *GetDocumentCall {
+	c.Call.Times(n)
+	return c
+}
+
+// Once limits the expectation to a single call.
+func (c *GetDocumentCall) Once() *GetDocumentCall {
+	return c.Times(1)
+}
+
+// GetDocument implements onfido.OnfidoClient.
+func (m *Client) GetDocument(ctx context.Context, id string) (*onfido.Document, error) {
+	rets := m.called(2, "GetDocument", ctx, id)
+	r0, _ := rets[0].(*onfido.Document)
+	r1, _ := rets[1].(error)
+	return r0, r1
+}
+
+// ListDocumentsCall is an expected call to ListDocuments
+type ListDocumentsCall struct {
+	*Call
+}
+
+// ExpectListDocuments registers an expected call to ListDocuments. Arguments are matched
+// with Eq unless they are a Matcher.
+func (m *Client) ExpectListDocuments(applicantID interface{}) *ListDocumentsCall {
+	return &ListDocumentsCall{m.expect("ListDocuments", applicantID)}
+}
+
+// Return sets the values returned by the call.
+func (c *ListDocumentsCall) Return(r0 *onfido.DocumentIter) *ListDocumentsCall {
+	c.returns = []interface{}{r0}
+	return c
+}
+
+// Do sets a func called with the arguments of the call, which returns its values.
+func (c *ListDocumentsCall) Do(fn func(applicantID string) *onfido.DocumentIter) *ListDocumentsCall {
+	c.do = func(args []interface{}) []interface{} {
+		applicantID, _ := args[0].(string)
+		r0 := fn(applicantID)
+		return []interface{}{r0}
+	}
+	return c
+}
+
+// Times limits the number of calls matching the expectation.
+func (c *ListDocumentsCall) Times(n int) *ListDocumentsCall {
+	c.Call.Times(n)
+	return c
+}
+
+// Once limits the expectation to a single call.
+func (c *ListDocumentsCall) Once() *ListDocumentsCall {
+	return c.Times(1)
+}
+
+// ListDocuments implements onfido.OnfidoClient.
+func (m *Client) ListDocuments(applicantID string) *onfido.DocumentIter {
+	rets := m.called(1, "ListDocuments", applicantID)
+	r0, ok := rets[0].(*onfido.DocumentIter)
+	if !ok {
+		r0 = onfido.NewDocumentIter(nil, nil)
+	}
+	return r0
+}
+
+// UploadDocumentCall is an expected call to UploadDocument
+type UploadDocumentCall struct {
+	*Call
+}
+
+// ExpectUploadDocument registers an expected call to UploadDocument. Arguments are matched
+// with Eq unless they are a Matcher.
+func (m *Client) ExpectUploadDocument(ctx, dr interface{}) *UploadDocumentCall {
+	return &UploadDocumentCall{m.expect("UploadDocument", ctx, dr)}
+}
+
+// Return sets the values returned by the call.
+func (c *UploadDocumentCall) Return(r0 *onfido.Document, r1 error) *UploadDocumentCall {
+	c.returns = []interface{}{r0, r1}
+	return c
+}
+
+// Do sets a func called with the arguments of the call, which returns its values.
+func (c *UploadDocumentCall) Do(fn func(ctx context.Context, dr onfido.DocumentRequest) (*onfido.Document, error)) *UploadDocumentCall {
+	c.do = func(args []interface{}) []interface{} {
+		ctx, _ := args[0].(context.Context)
+		dr, _ := args[1].(onfido.DocumentRequest)
+		r0, r1 := fn(ctx, dr)
+		return []interface{}{r0, r1}
+	}
+	return c
+}
+
+// Times limits the number of calls matching the expectation.
+func (c *UploadDocumentCall) Times(n int) *UploadDocumentCall {
+	c.Call.Times(n)
+	return c
+}
+
+// Once limits the expectation to a single call.
+func (c *UploadDocumentCall) Once() *UploadDocumentCall {
+	return c.Times(1)
+}
+
+// UploadDocument implements onfido.OnfidoClient.
+func (m *Client) UploadDocument(ctx context.Context, dr onfido.DocumentRequest) (*onfido.Document, error) {
+	rets := m.called(2, "UploadDocument", ctx, dr)
+	r0, _ := rets[0].(*onfido.Document)
+	r1, _ := rets[1].(error)
+	return r0, r1
+}
+
+// DownloadDocumentCall is an expected call to DownloadDocument
+type DownloadDocumentCall struct {
+	*Call
+}
+
+// ExpectDownloadDocument registers an expected call to DownloadDocument. Arguments are matched
+// with Eq unless they are a Matcher.
+func (m *Client) ExpectDownloadDocument(ctx, id interface{}) *DownloadDocumentCall {
+	return &DownloadDocumentCall{m.expect("DownloadDocument", ctx, id)}
+}
+
+// Return sets the values returned by the call.
+func (c *DownloadDocumentCall) Return(r0 *onfido.DocumentDownload, r1 error) *DownloadDocumentCall {
+	c.returns = []interface{}{r0, r1}
+	return c
+}
+
+// Do sets a func called with the arguments of the call, which returns its values.
+func (c *DownloadDocumentCall) Do(fn func(ctx context.Context, id string) (*onfido.DocumentDownload, error)) *DownloadDocumentCall {
+	c.do = func(args []interface{}) []interface{} {
+		ctx, _ := args[0].(context.Context)
+		id, _ := args[1].(string)
+		r0, r1 := fn(ctx, id)
+		return []interface{}{r0, r1}
+	}
+	return c
+}
+
+// Times limits the number of calls matching the expectation.
+func (c *DownloadDocumentCall) Times(n int) *DownloadDocumentCall {
+	c.Call.Times(n)
+	return c
+}
+
+// Once limits the expectation to a single call.
+func (c *DownloadDocumentCall) Once() *DownloadDocumentCall {
+	return c.Times(1)
+}
+
+// DownloadDocument implements onfido.OnfidoClient.
+func (m *Client) DownloadDocument(ctx context.Context, id string) (*onfido.DocumentDownload, error) {
+	rets := m.called(2, "DownloadDocument", ctx, id)
+	r0, _ := rets[0].(*onfido.DocumentDownload)
+	r1, _ := rets[1].(error)
+	return r0, r1
+}
+
+// ExtractDocumentCall is an expected call to ExtractDocument
+type ExtractDocumentCall struct {
+	*Call
+}
+
+// ExpectExtractDocument registers an expected call to ExtractDocument. Arguments are matched
+// with Eq unless they are a Matcher.
+func (m *Client) ExpectExtractDocument(ctx, documentID interface{}) *ExtractDocumentCall {
+	return &ExtractDocumentCall{m.expect("ExtractDocument", ctx, documentID)}
+}
+
+// Return sets the values returned by the call.
+func (c *ExtractDocumentCall) Return(r0 *onfido.ExtractionResult, r1 error) *ExtractDocumentCall {
+	c.returns = []interface{}{r0, r1}
+	return c
+}
+
+// Do sets a func called with the arguments of the call, which returns its values.
+func (c *ExtractDocumentCall) Do(fn func(ctx context.Context, documentID string) (*onfido.ExtractionResult, error)) *ExtractDocumentCall {
+	c.do = func(args []interface{}) []interface{} {
+		ctx, _ := args[0].(context.Context)
+		documentID, _ := args[1].(string)
+		r0, r1 := fn(ctx, documentID)
+		return []interface{}{r0, r1}
+	}
+	return c
+}
+
+// Times limits the number of calls matching the expectation.
+func (c *ExtractDocumentCall) Times(n int) *ExtractDocumentCall {
+	c.Call.Times(n)
+	return c
+}
+
+// Once limits the expectation to a single call.
+func (c *ExtractDocumentCall) Once() *ExtractDocumentCall {
+	return c.Times(1)
+}
+
+// ExtractDocument implements onfido.OnfidoClient.
+func (m *Client) ExtractDocument(ctx context.Context, documentID string) (*onfido.ExtractionResult, error) {
+	rets := m.called(2, "ExtractDocument", ctx, documentID)
+	r0, _ := rets[0].(*onfido.ExtractionResult)
+	r1, _ := rets[1].(error)
+	return r0, r1
+}
+
+// UploadAndExtractCall is an expected call to UploadAndExtract
+type UploadAndExtractCall struct {
+	*Call
+}
+
+// ExpectUploadAndExtract registers an expected call to UploadAndExtract. Arguments are matched
+// with Eq unless they are a Matcher.
+func (m *Client) ExpectUploadAndExtract(ctx, dr interface{}) *UploadAndExtractCall {
+	return &UploadAndExtractCall{m.expect("UploadAndExtract", ctx, dr)}
+}
+
+// Return sets the values returned by the call.
+func (c *UploadAndExtractCall) Return(r0 *onfido.Document, r1 *onfido.ExtractionResult, r2 error) *UploadAndExtractCall {
+	c.returns = []interface{}{r0, r1, r2}
+	return c
+}
+
+// Do sets a func called with the arguments of the call, which returns its values.
+func (c *UploadAndExtractCall) Do(fn func(ctx context.Context, dr onfido.DocumentRequest) (*onfido.Document, *onfido.ExtractionResult, error)) *UploadAndExtractCall {
+	c.do = func(args []interface{}) []interface{} {
+		ctx, _ := args[0].(context.Context)
+		dr, _ := args[1].(onfido.DocumentRequest)
+		r0, r1, r2 := fn(ctx, dr)
+		return []interface{}{r0, r1, r2}
+	}
+	return c
+}
+
+// Times limits the number of calls matching the expectation.
+func (c *UploadAndExtractCall) Times(n int) *UploadAndExtractCall {
+	c.Call.Times(n)
+	return c
+}
+
+// Once limits the expectation to a single call.
+func (c *UploadAndExtractCall) Once() *UploadAndExtractCall {
+	return c.Times(1)
+}
+
+// UploadAndExtract implements onfido.OnfidoClient.
+func (m *Client) UploadAndExtract(ctx context.Context, dr onfido.DocumentRequest) (*onfido.Document, *onfido.ExtractionResult, error) {
+	rets := m.called(3, "UploadAndExtract", ctx, dr)
+	r0, _ := rets[0].(*onfido.Document)
+	r1, _ := rets[1].(*onfido.ExtractionResult)
+	r2, _ := rets[2].(error)
+	return r0, r1, r2
+}
+
+// ListLivePhotosCall is an expected call to ListLivePhotos
+type ListLivePhotosCall struct {
+	*Call
+}
+
+// ExpectListLivePhotos registers an expected call to ListLivePhotos. Arguments are matched
+// with Eq unless they are a Matcher.
+func (m *Client) ExpectListLivePhotos(applicantID interface{}) *ListLivePhotosCall {
+	return &ListLivePhotosCall{m.expect("ListLivePhotos", applicantID)}
+}
+
+// Return sets the values returned by the call.
+func (c *ListLivePhotosCall) Return(r0 *onfido.LivePhotoIter) *ListLivePhotosCall {
+	c.returns = []interface{}{r0}
+	return c
+}
+
+// Do sets a func called with the arguments of the call, which returns its values.
+func (c *ListLivePhotosCall) Do(fn func(applicantID string) *onfido.LivePhotoIter) *ListLivePhotosCall {
+	c.do = func(args []interface{}) []interface{} {
+		applicantID, _ := args[0].(string)
+		r0 := fn(applicantID)
+		return []interface{}{r0}
+	}
+	return c
+}
+
+// Times limits the number of calls matching the expectation.
+func (c *ListLivePhotosCall) Times(n int) *ListLivePhotosCall {
+	c.Call.Times(n)
+	return c
+}
+
+// Once limits the expectation to a single call.
+func (c *ListLivePhotosCall) Once() *ListLivePhotosCall {
+	return c.Times(1)
+}
+
+// ListLivePhotos implements onfido.OnfidoClient.
+func (m *Client) ListLivePhotos(applicantID string) *onfido.LivePhotoIter {
+	rets := m.called(1, "ListLivePhotos", applicantID)
+	r0, ok := rets[0].(*onfido.LivePhotoIter)
+	if !ok {
+		r0 = onfido.NewLivePhotoIter(nil, nil)
+	}
+	return r0
+}
+
+// UploadLivePhotoCall is an expected call to UploadLivePhoto
+type UploadLivePhotoCall struct {
+	*Call
+}
+
+// ExpectUploadLivePhoto registers an expected call to UploadLivePhoto. Arguments are matched
+// with Eq unless they are a Matcher.
+func (m *Client) ExpectUploadLivePhoto(ctx, lr interface{}) *UploadLivePhotoCall {
+	return &UploadLivePhotoCall{m.expect("UploadLivePhoto", ctx, lr)}
+}
+
+// Return sets the values returned by the call.
+func (c *UploadLivePhotoCall) Return(r0 *onfido.LivePhoto, r1 error) *UploadLivePhotoCall {
+	c.returns = []interface{}{r0, r1}
+	return c
+}
+
+// Do sets a func called with the arguments of the call, which returns its values.
+func (c *UploadLivePhotoCall) Do(fn func(ctx context.Context, lr onfido.LivePhotoRequest) (*onfido.LivePhoto, error)) *UploadLivePhotoCall {
+	c.do = func(args []interface{}) []interface{} {
+		ctx, _ := args[0].(context.Context)
+		lr, _ := args[1].(onfido.LivePhotoRequest)
+		r0, r1 := fn(ctx, lr)
+		return []interface{}{r0, r1}
+	}
+	return c
+}
+
+// Times limits the number of calls matching the expectation.
+func (c *UploadLivePhotoCall) Times(n int) *UploadLivePhotoCall {
+	c.Call.Times(n)
+	return c
+}
+
+// Once limits the expectation to a single call.
+func (c *UploadLivePhotoCall) Once() *UploadLivePhotoCall {
+	return c.Times(1)
+}
+
+// UploadLivePhoto implements onfido.OnfidoClient.
+func (m *Client) UploadLivePhoto(ctx context.Context, lr onfido.LivePhotoRequest) (*onfido.LivePhoto, error) {
+	rets := m.called(2, "UploadLivePhoto", ctx, lr)
+	r0, _ := rets[0].(*onfido.LivePhoto)
+	r1, _ := rets[1].(error)
+	return r0, r1
+}
+
+// GetLivePhotoCall is an expected call to GetLivePhoto
+type GetLivePhotoCall struct {
+	*Call
+}
+
+// ExpectGetLivePhoto registers an expected call to GetLivePhoto. Arguments are matched
+// with Eq unless they are a Matcher.
+func (m *Client) ExpectGetLivePhoto(ctx, id interface{}) *GetLivePhotoCall {
+	return &GetLivePhotoCall{m.expect("GetLivePhoto", ctx, id)}
+}
+
+// Return sets the values returned by the call.
+func (c *GetLivePhotoCall) Return(r0 *onfido.LivePhoto, r1 error) *GetLivePhotoCall {
+	c.returns = []interface{}{r0, r1}
+	return c
+}
+
+// Do sets a func called with the arguments of the call, which returns its values.
+func (c *GetLivePhotoCall) Do(fn func(ctx context.Context, id string) (*onfido.LivePhoto, error)) *GetLivePhotoCall {
+	c.do = func(args []interface{}) []interface{} {
+		ctx, _ := args[0].(context.Context)
+		id, _ := args[1].(string)
+		r0, r1 := fn(ctx, id)
+		return []interface{}{r0, r1}
+	}
+	return c
+}
+
+// Times limits the number of calls matching the expectation.
+func (c *GetLivePhotoCall) Times(n int) *GetLivePhotoCall {
+	c.Call.Times(n)
+	return c
+}
+
+// Once limits the expectation to a single call.
+func (c *GetLivePhotoCall) Once() *GetLivePhotoCall {
+	return c.Times(1)
+}
+
+// GetLivePhoto implements onfido.OnfidoClient.
+func (m *Client) GetLivePhoto(ctx context.Context, id string) (*onfido.LivePhoto, error) {
+	rets := m.called(2, "GetLivePhoto", ctx, id)
+	r0, _ := rets[0].(*onfido.LivePhoto)
+	r1, _ := rets[1].(error)
+	return r0, r1
+}
+
+// DownloadLivePhotoCall is an expected call to DownloadLivePhoto
+type DownloadLivePhotoCall struct {
+	*Call
+}
+
+// ExpectDownloadLivePhoto registers an expected call to DownloadLivePhoto. Arguments are matched
+// with Eq unless they are a Matcher.
+func (m *Client) ExpectDownloadLivePhoto(ctx, id interface{}) *DownloadLivePhotoCall {
+	return &DownloadLivePhotoCall{m.expect("DownloadLivePhoto", ctx, id)}
+}
+
+// Return sets the values returned by the call.
+func (c *DownloadLivePhotoCall) Return(r0 *onfido.LivePhotoDownload, r1 error) *DownloadLivePhotoCall {
+	c.returns = []interface{}{r0, r1}
+	return c
+}
+
+// Do sets a func called with the arguments of the call, which returns its values.
+func (c *DownloadLivePhotoCall) Do(fn func(ctx context.Context, id string) (*onfido.LivePhotoDownload, error)) *DownloadLivePhotoCall {
+	c.do = func(args []interface{}) []interface{} {
+		ctx, _ := args[0].(context.Context)
+		id, _ := args[1].(string)
+		r0, r1 := fn(ctx, id)
+		return []interface{}{r0, r1}
+	}
+	return c
+}
+
+// Times limits the number of calls matching the expectation.
+func (c *DownloadLivePhotoCall) Times(n int) *DownloadLivePhotoCall {
+	c.Call.Times(n)
+	return c
+}
+
+// Once limits the expectation to a single call.
+func (c *DownloadLivePhotoCall) Once() *DownloadLivePhotoCall {
+	return c.Times(1)
+}
+
+// DownloadLivePhoto implements onfido.OnfidoClient.
+func (m *Client) DownloadLivePhoto(ctx context.Context, id string) (*onfido.LivePhotoDownload, error) {
+	rets := m.called(2, "DownloadLivePhoto", ctx, id)
+	r0, _ := rets[0].(*onfido.LivePhotoDownload)
+	r1, _ := rets[1].(error)
+	return r0, r1
+}
+
+// DownloadLiveVideoCall is an expected call to DownloadLiveVideo
+type DownloadLiveVideoCall struct {
+	*Call
+}
+
+// ExpectDownloadLiveVideo registers an expected call to DownloadLiveVideo. Arguments are matched
+// with Eq unless they are a Matcher.
+func (m *Client) ExpectDownloadLiveVideo(ctx, id interface{}) *DownloadLiveVideoCall {
+	return &DownloadLiveVideoCall{m.expect("DownloadLiveVideo", ctx, id)}
+}
+
+// Return sets the values returned by the call.
+func (c *DownloadLiveVideoCall) Return(r0 *onfido.LiveVideoDownload, r1 error) *DownloadLiveVideoCall {
+	c.returns = []interface{}{r0, r1}
+	return c
+}
+
+// Do sets a func called with the arguments of the call, which returns its values.
+func (c *DownloadLiveVideoCall) Do(fn func(ctx context.Context, id string) (*onfido.LiveVideoDownload, error)) *DownloadLiveVideoCall {
+	c.do = func(args []interface{}) []interface{} {
+		ctx, _ := args[0].(context.Context)
+		id, _ := args[1].(string)
+		r0, r1 := fn(ctx, id)
+		return []interface{}{r0, r1}
+	}
+	return c
+}
+
+// Times limits the number of calls matching the expectation.
+func (c *DownloadLiveVideoCall) Times(n int) *DownloadLiveVideoCall {
+	c.Call.Times(n)
+	return c
+}
+
+// Once limits the expectation to a single call.
+func (c *DownloadLiveVideoCall) Once() *DownloadLiveVideoCall {
+	return c.Times(1)
+}
+
+// DownloadLiveVideo implements onfido.OnfidoClient.
+func (m *Client) DownloadLiveVideo(ctx context.Context, id string) (*onfido.LiveVideoDownload, error) {
+	rets := m.called(2, "DownloadLiveVideo", ctx, id)
+	r0, _ := rets[0].(*onfido.LiveVideoDownload)
+	r1, _ := rets[1].(error)
+	return r0, r1
+}
+
+// UploadIDPhotoCall is an expected call to UploadIDPhoto
+type UploadIDPhotoCall struct {
+	*Call
+}
+
+// ExpectUploadIDPhoto registers an expected call to UploadIDPhoto. Arguments are matched
+// with Eq unless they are a Matcher.
+func (m *Client) ExpectUploadIDPhoto(ctx, ir interface{}) *UploadIDPhotoCall {
+	return &UploadIDPhotoCall{m.expect("UploadIDPhoto", ctx, ir)}
+}
+
+// Return sets the values returned by the call.
+func (c *UploadIDPhotoCall) Return(r0 *onfido.IDPhoto, r1 error) *UploadIDPhotoCall {
+	c.returns = []interface{}{r0, r1}
+	return c
+}
+
+// Do sets a func called with the arguments of the call, which returns its values.
+func (c *UploadIDPhotoCall) Do(fn func(ctx context.Context, ir onfido.IDPhotoRequest) (*onfido.IDPhoto, error)) *UploadIDPhotoCall {
+	c.do = func(args []interface{}) []interface{} {
+		ctx, _ := args[0].(context.Context)
+		ir, _ := args[1].(onfido.IDPhotoRequest)
+		r0, r1 := fn(ctx, ir)
+		return []interface{}{r0, r1}
+	}
+	return c
+}
+
+// Times limits the number of calls matching the expectation.
+func (c *UploadIDPhotoCall) Times(n int) *UploadIDPhotoCall {
+	c.Call.Times(n)
+	return c
+}
+
+// Once limits the expectation to a single call.
+func (c *UploadIDPhotoCall) Once() *UploadIDPhotoCall {
+	return c.Times(1)
+}
+
+// UploadIDPhoto implements onfido.OnfidoClient.
+func (m *Client) UploadIDPhoto(ctx context.Context, ir onfido.IDPhotoRequest) (*onfido.IDPhoto, error) {
+	rets := m.called(2, "UploadIDPhoto", ctx, ir)
+	r0, _ := rets[0].(*onfido.IDPhoto)
+	r1, _ := rets[1].(error)
+	return r0, r1
+}
+
+// GetIDPhotoCall is an expected call to GetIDPhoto
+type GetIDPhotoCall struct {
+	*Call
+}
+
+// ExpectGetIDPhoto registers an expected call to GetIDPhoto. Arguments are matched
+// with Eq unless they are a Matcher.
+func (m *Client) ExpectGetIDPhoto(ctx, id interface{}) *GetIDPhotoCall {
+	return &GetIDPhotoCall{m.expect("GetIDPhoto", ctx, id)}
+}
+
+// Return sets the values returned by the call.
+func (c *GetIDPhotoCall) Return(r0 *onfido.IDPhoto, r1 error) *GetIDPhotoCall {
+	c.returns = []interface{}{r0, r1}
+	return c
+}
+
+// Do sets a func called with the arguments of the call, which returns its values.
+func (c *GetIDPhotoCall) Do(fn func(ctx context.Context, id string) (*onfido.IDPhoto, error)) *GetIDPhotoCall {
+	c.do = func(args []interface{}) []interface{} {
+		ctx, _ := args[0].(context.Context)
+		id, _ := args[1].(string)
+		r0, r1 := fn(ctx, id)
+		return []interface{}{r0, r1}
+	}
+	return c
+}
+
+// Times limits the number of calls matching the expectation.
+func (c *GetIDPhotoCall) Times(n int) *GetIDPhotoCall {
+	c.Call.Times(n)
+	return c
+}
+
+// Once limits the expectation to a single call.
+func (c *GetIDPhotoCall) Once() *GetIDPhotoCall {
+	return c.Times(1)
+}
+
+// GetIDPhoto implements onfido.OnfidoClient.
+func (m *Client) GetIDPhoto(ctx context.Context, id string) (*onfido.IDPhoto, error) {
+	rets := m.called(2, "GetIDPhoto", ctx, id)
+	r0, _ := rets[0].(*onfido.IDPhoto)
+	r1, _ := rets[1].(error)
+	return r0, r1
+}
+
+// DownloadIDPhotoCall is an expected call to DownloadIDPhoto
+type DownloadIDPhotoCall struct {
+	*Call
+}
+
+// ExpectDownloadIDPhoto registers an expected call to DownloadIDPhoto. Arguments are matched
+// with Eq unless they are a Matcher.
+func (m *Client) ExpectDownloadIDPhoto(ctx, id, w interface{}) *DownloadIDPhotoCall {
+	return &DownloadIDPhotoCall{m.expect("DownloadIDPhoto", ctx, id, w)}
+}
+
+// Return sets the values returned by the call.
+func (c *DownloadIDPhotoCall) Return(r0 error) *DownloadIDPhotoCall {
+	c.returns = []interface{}{r0}
+	return c
+}
+
+// Do sets a func called with the arguments of the call, which returns its values.
+func (c *DownloadIDPhotoCall) Do(fn func(ctx context.Context, id string, w io.Writer) error) *DownloadIDPhotoCall {
+	c.do = func(args []interface{}) []interface{} {
+		ctx, _ := args[0].(context.Context)
+		id, _ := args[1].(string)
+		w, _ := args[2].(io.Writer)
+		r0 := fn(ctx, id, w)
+		return []interface{}{r0}
+	}
+	return c
+}
+
+// Times limits the number of calls matching the expectation.
+func (c *DownloadIDPhotoCall) Times(n int) *DownloadIDPhotoCall {
+	c.Call.Times(n)
+	return c
+}
+
+// Once limits the expectation to a single call.
+func (c *DownloadIDPhotoCall) Once() *DownloadIDPhotoCall {
+	return c.Times(1)
+}
+
+// DownloadIDPhoto implements onfido.OnfidoClient.
+func (m *Client) DownloadIDPhoto(ctx context.Context, id string, w io.Writer) error {
+	rets := m.called(1, "DownloadIDPhoto", ctx, id, w)
+	r0, _ := rets[0].(error)
+	return r0
+}
+
+// ListIDPhotosCall is an expected call to ListIDPhotos
+type ListIDPhotosCall struct {
+	*Call
+}
+
+// ExpectListIDPhotos registers an expected call to ListIDPhotos. Arguments are matched
+// with Eq unless they are a Matcher.
+func (m *Client) ExpectListIDPhotos(applicantID interface{}) *ListIDPhotosCall {
+	return &ListIDPhotosCall{m.expect("ListIDPhotos", applicantID)}
+}
+
+// Return sets the values returned by the call.
+func (c *ListIDPhotosCall) Return(r0 *onfido.IDPhotoIter) *ListIDPhotosCall {
+	c.returns = []interface{}{r0}
+	return c
+}
+
+// Do sets a func called with the arguments of the call, which returns its values.
+func (c *ListIDPhotosCall) Do(fn func(applicantID string) *onfido.IDPhotoIter) *ListIDPhotosCall {
+	c.do = func(args []interface{}) []interface{} {
+		applicantID, _ := args[0].(string)
+		r0 := fn(applicantID)
+		return []interface{}{r0}
+	}
+	return c
+}
+
+// Times limits the number of calls matching the expectation.
+func (c *ListIDPhotosCall) Times(n int) *ListIDPhotosCall {
+	c.Call.Times(n)
+	return c
+}
+
+// Once limits the expectation to a single call.
+func (c *ListIDPhotosCall) Once() *ListIDPhotosCall {
+	return c.Times(1)
+}
+
+// ListIDPhotos implements onfido.OnfidoClient.
+func (m *Client) ListIDPhotos(applicantID string) *onfido.IDPhotoIter {
+	rets := m.called(1, "ListIDPhotos", applicantID)
+	r0, ok := rets[0].(*onfido.IDPhotoIter)
+	if !ok {
+		r0 = onfido.NewIDPhotoIter(nil, nil)
+	}
+	return r0
+}
+
+// ListLiveVideosCall is an expected call to ListLiveVideos
+type ListLiveVideosCall struct {
+	*Call
+}
+
+// ExpectListLiveVideos registers an expected call to ListLiveVideos. Arguments are matched
+// with Eq unless they are a Matcher.
+func (m *Client) ExpectListLiveVideos(applicantID interface{}) *ListLiveVideosCall {
+	return &ListLiveVideosCall{m.expect("ListLiveVideos", applicantID)}
+}
+
+// Return sets the values returned by the call.
+func (c *ListLiveVideosCall) Return(r0 onfido.LiveVideoIter) *ListLiveVideosCall {
+	c.returns = []interface{}{r0}
+	return c
+}
+
+// Do sets a func called with the arguments of the call, which returns its values.
+func (c *ListLiveVideosCall) Do(fn func(applicantID string) onfido.LiveVideoIter) *ListLiveVideosCall {
+	c.do = func(args []interface{}) []interface{} {
+		applicantID, _ := args[0].(string)
+		r0 := fn(applicantID)
+		return []interface{}{r0}
+	}
+	return c
+}
+
+// Times limits the number of calls matching the expectation.
+func (c *ListLiveVideosCall) Times(n int) *ListLiveVideosCall {
+	c.Call.Times(n)
+	return c
+}
+
+// Once limits the expectation to a single call.
+func (c *ListLiveVideosCall) Once() *ListLiveVideosCall {
+	return c.Times(1)
+}
+
+// ListLiveVideos implements onfido.OnfidoClient.
+func (m *Client) ListLiveVideos(applicantID string) onfido.LiveVideoIter {
+	rets := m.called(1, "ListLiveVideos", applicantID)
+	r0, ok := rets[0].(onfido.LiveVideoIter)
+	if !ok {
+		r0 = onfido.NewLiveVideoIter(nil, nil)
+	}
+	return r0
+}
+
+// GetLiveVideoCall is an expected call to GetLiveVideo
+type GetLiveVideoCall struct {
+	*Call
+}
+
+// ExpectGetLiveVideo registers an expected call to GetLiveVideo. Arguments are matched
+// with Eq unless they are a Matcher.
+func (m *Client) ExpectGetLiveVideo(ctx, id interface{}) *GetLiveVideoCall {
+	return &GetLiveVideoCall{m.expect("GetLiveVideo", ctx, id)}
+}
+
+// Return sets the values returned by the call.
+func (c *GetLiveVideoCall) Return(r0 *onfido.LiveVideo, r1 error) *GetLiveVideoCall {
+	c.returns = []interface{}{r0, r1}
+	return c
+}
+
+// Do sets a func called with the arguments of the call, which returns its values.
+func (c *GetLiveVideoCall) Do(fn func(ctx context.Context, id string) (*onfido.LiveVideo, error)) *GetLiveVideoCall {
+	c.do = func(args []interface{}) []interface{} {
+		ctx, _ := args[0].(context.Context)
+		id, _ := args[1].(string)
+		r0, r1 := fn(ctx, id)
+		return []interface{}{r0, r1}
+	}
+	return c
+}
+
+// Times limits the number of calls matching the expectation.
+func (c *GetLiveVideoCall) Times(n int) *GetLiveVideoCall {
+	c.Call.Times(n)
+	return c
+}
+
+// Once limits the expectation to a single call.
+func (c *GetLiveVideoCall) Once() *GetLiveVideoCall {
+	return c.Times(1)
+}
+
+// GetLiveVideo implements onfido.OnfidoClient.
+func (m *Client) GetLiveVideo(ctx context.Context, id string) (*onfido.LiveVideo, error) {
+	rets := m.called(2, "GetLiveVideo", ctx, id)
+	r0, _ := rets[0].(*onfido.LiveVideo)
+	r1, _ := rets[1].(error)
+	return r0, r1
+}
+
+// DownloadLiveVideoFrameCall is an expected call to DownloadLiveVideoFrame
+type DownloadLiveVideoFrameCall struct {
+	*Call
+}
+
+// ExpectDownloadLiveVideoFrame registers an expected call to DownloadLiveVideoFrame. Arguments are matched
+// with Eq unless they are a Matcher.
+func (m *Client) ExpectDownloadLiveVideoFrame(ctx, id, w interface{}) *DownloadLiveVideoFrameCall {
+	return &DownloadLiveVideoFrameCall{m.expect("DownloadLiveVideoFrame", ctx, id, w)}
+}
+
+// Return sets the values returned by the call.
+func (c *DownloadLiveVideoFrameCall) Return(r0 error) *DownloadLiveVideoFrameCall {
+	c.returns = []interface{}{r0}
+	return c
+}
+
+// Do sets a func called with the arguments of the call, which returns its values.
+func (c *DownloadLiveVideoFrameCall) Do(fn func(ctx context.Context, id string, w io.Writer) error) *DownloadLiveVideoFrameCall {
+	c.do = func(args []interface{}) []interface{} {
+		ctx, _ := args[0].(context.Context)
+		id, _ := args[1].(string)
+		w, _ := args[2].(io.Writer)
+		r0 := fn(ctx, id, w)
+		return []interface{}{r0}
+	}
+	return c
+}
+
+// Times limits the number of calls matching the expectation.
+func (c *DownloadLiveVideoFrameCall) Times(n int) *DownloadLiveVideoFrameCall {
+	c.Call.Times(n)
+	return c
+}
+
+// Once limits the expectation to a single call.
+func (c *DownloadLiveVideoFrameCall) Once() *DownloadLiveVideoFrameCall {
+	return c.Times(1)
+}
+
+// DownloadLiveVideoFrame implements onfido.OnfidoClient.
+func (m *Client) DownloadLiveVideoFrame(ctx context.Context, id string, w io.Writer) error {
+	rets := m.called(1, "DownloadLiveVideoFrame", ctx, id, w)
+	r0, _ := rets[0].(error)
+	return r0
+}
+
+// ListMotionCapturesCall is an expected call to ListMotionCaptures
+type ListMotionCapturesCall struct {
+	*Call
+}
+
+// ExpectListMotionCaptures registers an expected call to ListMotionCaptures. Arguments are matched
+// with Eq unless they are a Matcher.
+func (m *Client) ExpectListMotionCaptures(applicantID interface{}) *ListMotionCapturesCall {
+	return &ListMotionCapturesCall{m.expect("ListMotionCaptures", applicantID)}
+}
+
+// Return sets the values returned by the call.
+func (c *ListMotionCapturesCall) Return(r0 *onfido.MotionCaptureIter) *ListMotionCapturesCall {
+	c.returns = []interface{}{r0}
+	return c
+}
+
+// Do sets a func called with the arguments of the call, which returns its values.
+func (c *ListMotionCapturesCall) Do(fn func(applicantID string) *onfido.MotionCaptureIter) *ListMotionCapturesCall {
+	c.do = func(args []interface{}) []interface{} {
+		applicantID, _ := args[0].(string)
+		r0 := fn(applicantID)
+		return []interface{}{r0}
+	}
+	return c
+}
+
+// Times limits the number of calls matching the expectation.
+func (c *ListMotionCapturesCall) Times(n int) *ListMotionCapturesCall {
+	c.Call.Times(n)
+	return c
+}
+
+// Once limits the expectation to a single call.
+func (c *ListMotionCapturesCall) Once() *ListMotionCapturesCall {
+	return c.Times(1)
+}
+
+// ListMotionCaptures implements onfido.OnfidoClient.
+func (m *Client) ListMotionCaptures(applicantID string) *onfido.MotionCaptureIter {
+	rets := m.called(1, "ListMotionCaptures", applicantID)
+	r0, ok := rets[0].(*onfido.MotionCaptureIter)
+	if !ok {
+		r0 = onfido.NewMotionCaptureIter(nil, nil)
+	}
+	return r0
+}
+
+// GetMotionCaptureCall is an expected call to GetMotionCapture
+type GetMotionCaptureCall struct {
+	*Call
+}
+
+// ExpectGetMotionCapture registers an expected call to GetMotionCapture. Arguments are matched
+// with Eq unless they are a Matcher.
+func (m *Client) ExpectGetMotionCapture(ctx, id interface{}) *GetMotionCaptureCall {
+	return &GetMotionCaptureCall{m.expect("GetMotionCapture", ctx, id)}
+}
+
+// Return sets the values returned by the call.
+func (c *GetMotionCaptureCall) Return(r0 *onfido.MotionCapture, r1 error) *GetMotionCaptureCall {
+	c.returns = []interface{}{r0, r1}
+	return c
+}
+
+// Do sets a func called with the arguments of the call, which returns its values.
+func (c *GetMotionCaptureCall) Do(fn func(ctx context.Context, id string) (*onfido.MotionCapture, error)) *GetMotionCaptureCall {
+	c.do = func(args []interface{}) []interface{} {
+		ctx, _ := args[0].(context.Context)
+		id, _ := args[1].(string)
+		r0, r1 := fn(ctx, id)
+		return []interface{}{r0, r1}
+	}
+	return c
+}
+
+// Times limits the number of calls matching the expectation.
+func (c *GetMotionCaptureCall) Times(n int) *GetMotionCaptureCall {
+	c.Call.Times(n)
+	return c
+}
+
+// Once limits the expectation to a single call.
+func (c *GetMotionCaptureCall) Once() *GetMotionCaptureCall {
+	return c.Times(1)
+}
+
+// GetMotionCapture implements onfido.OnfidoClient.
+func (m *Client) GetMotionCapture(ctx context.Context, id string) (*onfido.MotionCapture, error) {
+	rets := m.called(2, "GetMotionCapture", ctx, id)
+	r0, _ := rets[0].(*onfido.MotionCapture)
+	r1, _ := rets[1].(error)
+	return r0, r1
+}
+
+// DownloadMotionCaptureCall is an expected call to DownloadMotionCapture
+type DownloadMotionCaptureCall struct {
+	*Call
+}
+
+// ExpectDownloadMotionCapture registers an expected call to DownloadMotionCapture. Arguments are matched
+// with Eq unless they are a Matcher.
+func (m *Client) ExpectDownloadMotionCapture(ctx, id interface{}) *DownloadMotionCaptureCall {
+	return &DownloadMotionCaptureCall{m.expect("DownloadMotionCapture", ctx, id)}
+}
+
+// Return sets the values returned by the call.
+func (c *DownloadMotionCaptureCall) Return(r0 *onfido.MotionCaptureDownload, r1 error) *DownloadMotionCaptureCall {
+	c.returns = []interface{}{r0, r1}
+	return c
+}
+
+// Do sets a func called with the arguments of the call, which returns its values.
+func (c *DownloadMotionCaptureCall) Do(fn func(ctx context.Context, id string) (*onfido.MotionCaptureDownload, error)) *DownloadMotionCaptureCall {
+	c.do = func(args []interface{}) []interface{} {
+		ctx, _ := args[0].(context.Context)
+		id, _ := args[1].(string)
+		r0, r1 := fn(ctx, id)
+		return []interface{}{r0, r1}
+	}
+	return c
+}
+
+// Times limits the number of calls matching the expectation.
+func (c *DownloadMotionCaptureCall) Times(n int) *DownloadMotionCaptureCall {
+	c.Call.Times(n)
+	return c
+}
+
+// Once limits the expectation to a single call.
+func (c *DownloadMotionCaptureCall) Once() *DownloadMotionCaptureCall {
+	return c.Times(1)
+}
+
+// DownloadMotionCapture implements onfido.OnfidoClient.
+func (m *Client) DownloadMotionCapture(ctx context.Context, id string) (*onfido.MotionCaptureDownload, error) {
+	rets := m.called(2, "DownloadMotionCapture", ctx, id)
+	r0, _ := rets[0].(*onfido.MotionCaptureDownload)
+	r1, _ := rets[1].(error)
+	return r0, r1
+}
+
+// DownloadMotionCaptureFrameCall is an expected call to DownloadMotionCaptureFrame
+type DownloadMotionCaptureFrameCall struct {
+	*Call
+}
+
+// ExpectDownloadMotionCaptureFrame registers an expected call to DownloadMotionCaptureFrame. Arguments are matched
+// with Eq unless they are a Matcher.
+func (m *Client) ExpectDownloadMotionCaptureFrame(ctx, id, w interface{}) *DownloadMotionCaptureFrameCall {
+	return &DownloadMotionCaptureFrameCall{m.expect("DownloadMotionCaptureFrame", ctx, id, w)}
+}
+
+// Return sets the values returned by the call.
+func (c *DownloadMotionCaptureFrameCall) Return(r0 error) *DownloadMotionCaptureFrameCall {
+	c.returns = []interface{}{r0}
+	return c
+}
+
+// Do sets a func called with the arguments of the call, which returns its values.
+func (c *DownloadMotionCaptureFrameCall) Do(fn func(ctx context.Context, id string, w io.Writer) error) *DownloadMotionCaptureFrameCall {
+	c.do = func(args []interface{}) []interface{} {
+		ctx, _ := args[0].(context.Context)
+		id, _ := args[1].(string)
+		w, _ := args[2].(io.Writer)
+		r0 := fn(ctx, id, w)
+		return []interface{}{r0}
+	}
+	return c
+}
+
+// Times limits the number of calls matching the expectation.
+func (c *DownloadMotionCaptureFrameCall) Times(n int) *DownloadMotionCaptureFrameCall {
+	c.Call.Times(n)
+	return c
+}
+
+// Once limits the expectation to a single call.
+func (c *DownloadMotionCaptureFrameCall) Once() *DownloadMotionCaptureFrameCall {
+	return c.Times(1)
+}
+
+// DownloadMotionCaptureFrame implements onfido.OnfidoClient.
+func (m *Client) DownloadMotionCaptureFrame(ctx context.Context, id string, w io.Writer) error {
+	rets := m.called(1, "DownloadMotionCaptureFrame", ctx, id, w)
+	r0, _ := rets[0].(error)
+	return r0
+}
+
+// CreateApplicantCall is an expected call to CreateApplicant
+type CreateApplicantCall struct {
+	*Call
+}
+
+// ExpectCreateApplicant registers an expected call to CreateApplicant. Arguments are matched
+// with Eq unless they are a Matcher.
+func (m *Client) ExpectCreateApplicant(ctx, a interface{}) *CreateApplicantCall {
+	return &CreateApplicantCall{m.expect("CreateApplicant", ctx, a)}
+}
+
+// Return sets the values returned by the call.
+func (c *CreateApplicantCall) Return(r0 *onfido.Applicant, r1 error) *CreateApplicantCall {
+	c.returns = []interface{}{r0, r1}
+	return c
+}
+
+// Do sets a func called with the arguments of the call, which returns its values.
+func (c *CreateApplicantCall) Do(fn func(ctx context.Context, a onfido.Applicant) (*onfido.Applicant, error)) *CreateApplicantCall {
+	c.do = func(args []interface{}) []interface{} {
+		ctx, _ := args[0].(context.Context)
+		a, _ := args[1].(onfido.Applicant)
+		r0, r1 := fn(ctx, a)
+		return []interface{}{r0, r1}
+	}
+	return c
+}
+
+// Times limits the number of calls matching the expectation.
+func (c *CreateApplicantCall) Times(n int) *CreateApplicantCall {
+	c.Call.Times(n)
+	return c
+}
+
+// Once limits the expectation to a single call.
+func (c *CreateApplicantCall) Once() *CreateApplicantCall {
+	return c.Times(1)
+}
+
+// CreateApplicant implements onfido.OnfidoClient.
+func (m *Client) CreateApplicant(ctx context.Context, a onfido.Applicant) (*onfido.Applicant, error) {
+	rets := m.called(2, "CreateApplicant", ctx, a)
+	r0, _ := rets[0].(*onfido.Applicant)
+	r1, _ := rets[1].(error)
+	return r0, r1
+}
+
+// DeleteApplicantCall is an expected call to DeleteApplicant
+type DeleteApplicantCall struct {
+	*Call
+}
+
+// ExpectDeleteApplicant registers an expected call to DeleteApplicant. Arguments are matched
+// with Eq unless they are a Matcher.
+func (m *Client) ExpectDeleteApplicant(ctx, id interface{}) *DeleteApplicantCall {
+	return &DeleteApplicantCall{m.expect("DeleteApplicant", ctx, id)}
+}
+
+// Return sets the values returned by the call.
+func (c *DeleteApplicantCall) Return(r0 error) *DeleteApplicantCall {
+	c.returns = []interface{}{r0}
+	return c
+}
+
+// Do sets a func called with the arguments of the call, which returns its values.
+func (c *DeleteApplicantCall) Do(fn func(ctx context.Context, id string) error) *DeleteApplicantCall {
+	c.do = func(args []interface{}) []interface{} {
+		ctx, _ := args[0].(context.Context)
+		id, _ := args[1].(string)
+		r0 := fn(ctx, id)
+		return []interface{}{r0}
+	}
+	return c
+}
+
+// Times limits the number of calls matching the expectation.
+func (c *DeleteApplicantCall) Times(n int) *DeleteApplicantCall {
+	c.Call.Times(n)
+	return c
+}
+
+// Once limits the expectation to a single call.
+func (c *DeleteApplicantCall) Once() *DeleteApplicantCall {
+	return c.Times(1)
+}
+
+// DeleteApplicant implements onfido.OnfidoClient.
+func (m *Client) DeleteApplicant(ctx context.Context, id string) error {
+	rets := m.called(1, "DeleteApplicant", ctx, id)
+	r0, _ := rets[0].(error)
+	return r0
+}
+
+// RestoreApplicantCall is an expected call to RestoreApplicant
+type RestoreApplicantCall struct {
+	*Call
+}
+
+// ExpectRestoreApplicant registers an expected call to RestoreApplicant. Arguments are matched
+// with Eq unless they are a Matcher.
+func (m *Client) ExpectRestoreApplicant(ctx, id interface{}) *RestoreApplicantCall {
+	return &RestoreApplicantCall{m.expect("RestoreApplicant", ctx, id)}
+}
+
+// Return sets the values returned by the call.
+func (c *RestoreApplicantCall) Return(r0 error) *RestoreApplicantCall {
+	c.returns = []interface{}{r0}
+	return c
+}
+
+// Do sets a func called with the arguments of the call, which returns its values.
+func (c *RestoreApplicantCall) Do(fn func(ctx context.Context, id string) error) *RestoreApplicantCall {
+	c.do = func(args []interface{}) []interface{} {
+		ctx, _ := args[0].(context.Context)
+		id, _ := args[1].(string)
+		r0 := fn(ctx, id)
+		return []interface{}{r0}
+	}
+	return c
+}
+
+// Times limits the number of calls matching the expectation.
+func (c *RestoreApplicantCall) Times(n int) *RestoreApplicantCall {
+	c.Call.Times(n)
+	return c
+}
+
+// Once limits the expectation to a single call.
+func (c *RestoreApplicantCall) Once() *RestoreApplicantCall {
+	return c.Times(1)
+}
+
+// RestoreApplicant implements onfido.OnfidoClient.
+func (m *Client) RestoreApplicant(ctx context.Context, id string) error {
+	rets := m.called(1, "RestoreApplicant", ctx, id)
+	r0, _ := rets[0].(error)
+	return r0
+}
+
+// GetApplicantCall is an expected call to GetApplicant
+type GetApplicantCall struct {
+	*Call
+}
+
+// ExpectGetApplicant registers an expected call to GetApplicant. Arguments are matched
+// with Eq unless they are a Matcher.
+func (m *Client) ExpectGetApplicant(ctx, id interface{}) *GetApplicantCall {
+	return &GetApplicantCall{m.expect("GetApplicant", ctx, id)}
+}
+
+// Return sets the values returned by the call.
+func (c *GetApplicantCall) Return(r0 *onfido.Applicant, r1 error) *GetApplicantCall {
+	c.returns = []interface{}{r0, r1}
+	return c
+}
+
+// Do sets a func called with the arguments of the call, which returns its values.
+func (c *GetApplicantCall) Do(fn func(ctx context.Context, id string) (*onfido.Applicant, error)) *GetApplicantCall {
+	c.do = func(args []interface{}) []interface{} {
+		ctx, _ := args[0].(context.Context)
+		id, _ := args[1].(string)
+		r0, r1 := fn(ctx, id)
+		return []interface{}{r0, r1}
+	}
+	return c
+}
+
+// Times limits the number of calls matching the expectation.
+func (c *GetApplicantCall) Times(n int) *GetApplicantCall {
+	c.Call.Times(n)
+	return c
+}
+
+// Once limits the expectation to a single call.
+func (c *GetApplicantCall) Once() *GetApplicantCall {
+	return c.Times(1)
+}
+
+// GetApplicant implements onfido.OnfidoClient.
+func (m *Client) GetApplicant(ctx context.Context, id string) (*onfido.Applicant, error) {
+	rets := m.called(2, "GetApplicant", ctx, id)
+	r0, _ := rets[0].(*onfido.Applicant)
+	r1, _ := rets[1].(error)
+	return r0, r1
+}
+
+// ListApplicantsCall is an expected call to ListApplicants
+type ListApplicantsCall struct {
+	*Call
+}
+
+// ExpectListApplicants registers an expected call to ListApplicants. Arguments are matched
+// with Eq unless they are a Matcher.
+func (m *Client) ExpectListApplicants() *ListApplicantsCall {
+	return &ListApplicantsCall{m.expect("ListApplicants")}
+}
+
+// Return sets the values returned by the call.
+func (c *ListApplicantsCall) Return(r0 *onfido.ApplicantIter) *ListApplicantsCall {
+	c.returns = []interface{}{r0}
+	return c
+}
+
+// Do sets a func called with the arguments of the call, which returns its values.
+func (c *ListApplicantsCall) Do(fn func() *onfido.ApplicantIter) *ListApplicantsCall {
+	c.do = func(args []interface{}) []interface{} {
+		r0 := fn()
+		return []interface{}{r0}
+	}
+	return c
+}
+
+// Times limits the number of calls matching the expectation.
+func (c *ListApplicantsCall) Times(n int) *ListApplicantsCall {
+	c.Call.Times(n)
+	return c
+}
+
+// Once limits the expectation to a single call.
+func (c *ListApplicantsCall) Once() *ListApplicantsCall {
+	return c.Times(1)
+}
+
+// ListApplicants implements onfido.OnfidoClient.
+func (m *Client) ListApplicants() *onfido.ApplicantIter {
+	rets := m.called(1, "ListApplicants")
+	r0, ok := rets[0].(*onfido.ApplicantIter)
+	if !ok {
+		r0 = onfido.NewApplicantIter(nil, nil)
+	}
+	return r0
+}
+
+// UpdateApplicantCall is an expected call to UpdateApplicant
+type UpdateApplicantCall struct {
+	*Call
+}
+
+// ExpectUpdateApplicant registers an expected call to UpdateApplicant. Arguments are matched
+// with Eq unless they are a Matcher.
+func (m *Client) ExpectUpdateApplicant(ctx, a interface{}) *UpdateApplicantCall {
+	return &UpdateApplicantCall{m.expect("UpdateApplicant", ctx, a)}
+}
+
+// Return sets the values returned by the call.
+func (c *UpdateApplicantCall) Return(r0 *onfido.Applicant, r1 error) *UpdateApplicantCall {
+	c.returns = []interface{}{r0, r1}
+	return c
+}
+
+// Do sets a func called with the arguments of the call, which returns its values.
+func (c *UpdateApplicantCall) Do(fn func(ctx context.Context, a onfido.Applicant) (*onfido.Applicant, error)) *UpdateApplicantCall {
+	c.do = func(args []interface{}) []interface{} {
+		ctx, _ := args[0].(context.Context)
+		a, _ := args[1].(onfido.Applicant)
+		r0, r1 := fn(ctx, a)
+		return []interface{}{r0, r1}
+	}
+	return c
+}
+
+// Times limits the number of calls matching the expectation.
+func (c *UpdateApplicantCall) Times(n int) *UpdateApplicantCall {
+	c.Call.Times(n)
+	return c
+}
+
+// Once limits the expectation to a single call.
+func (c *UpdateApplicantCall) Once() *UpdateApplicantCall {
+	return c.Times(1)
+}
+
+// UpdateApplicant implements onfido.OnfidoClient.
+func (m *Client) UpdateApplicant(ctx context.Context, a onfido.Applicant) (*onfido.Applicant, error) {
+	rets := m.called(2, "UpdateApplicant", ctx, a)
+	r0, _ := rets[0].(*onfido.Applicant)
+	r1, _ := rets[1].(error)
+	return r0, r1
+}
+
+// CreateCheckCall is an expected call to CreateCheck
+type CreateCheckCall struct {
+	*Call
+}
+
+// ExpectCreateCheck registers an expected call to CreateCheck. Arguments are matched
+// with Eq unless they are a Matcher.
+func (m *Client) ExpectCreateCheck(ctx, cr interface{}) *CreateCheckCall {
+	return &CreateCheckCall{m.expect("CreateCheck", ctx, cr)}
+}
+
+// Return sets the values returned by the call.
+func (c *CreateCheckCall) Return(r0 *onfido.Check, r1 error) *CreateCheckCall {
+	c.returns = []interface{}{r0, r1}
+	return c
+}
+
+// Do sets a func called with the arguments of the call, which returns its values.
+func (c *CreateCheckCall) Do(fn func(ctx context.Context, cr onfido.CheckRequest) (*onfido.Check, error)) *CreateCheckCall {
+	c.do = func(args []interface{}) []interface{} {
+		ctx, _ := args[0].(context.Context)
+		cr, _ := args[1].(onfido.CheckRequest)
+		r0, r1 := fn(ctx, cr)
+		return []interface{}{r0, r1}
+	}
+	return c
+}
+
+// Times limits the number of calls matching the expectation.
+func (c *CreateCheckCall) Times(n int) *CreateCheckCall {
+	c.Call.Times(n)
+	return c
+}
+
+// Once limits the expectation to a single call.
+func (c *CreateCheckCall) Once() *CreateCheckCall {
+	return c.Times(1)
+}
+
+// CreateCheck implements onfido.OnfidoClient.
+func (m *Client) CreateCheck(ctx context.Context, cr onfido.CheckRequest) (*onfido.Check, error) {
+	rets := m.called(2, "CreateCheck", ctx, cr)
+	r0, _ := rets[0].(*onfido.Check)
+	r1, _ := rets[1].(error)
+	return r0, r1
+}
+
+// GetCheckCall is an expected call to GetCheck
+type GetCheckCall struct {
+	*Call
+}
+
+// ExpectGetCheck registers an expected call to GetCheck. Arguments are matched
+// with Eq unless they are a Matcher.
+func (m *Client) ExpectGetCheck(ctx, id interface{}) *GetCheckCall {
+	return &GetCheckCall{m.expect("GetCheck", ctx, id)}
+}
+
+// Return sets the values returned by the call.
+func (c *GetCheckCall) Return(r0 *onfido.CheckRetrieved, r1 error) *GetCheckCall {
+	c.returns = []interface{}{r0, r1}
+	return c
+}
+
+// Do sets a func called with the arguments of the call, which returns its values.
+func (c *GetCheckCall) Do(fn func(ctx context.Context, id string) (*onfido.CheckRetrieved, error)) *GetCheckCall {
+	c.do = func(args []interface{}) []interface{} {
+		ctx, _ := args[0].(context.Context)
+		id, _ := args[1].(string)
+		r0, r1 := fn(ctx, id)
+		return []interface{}{r0, r1}
+	}
+	return c
+}
+
+// Times limits the number of calls matching the expectation.
+func (c *GetCheckCall) Times(n int) *GetCheckCall {
+	c.Call.Times(n)
+	return c
+}
+
+// Once limits the expectation to a single call.
+func (c *GetCheckCall) Once() *GetCheckCall {
+	return c.Times(1)
+}
+
+// GetCheck implements onfido.OnfidoClient.
+func (m *Client) GetCheck(ctx context.Context, id string) (*onfido.CheckRetrieved, error) {
+	rets := m.called(2, "GetCheck", ctx, id)
+	r0, _ := rets[0].(*onfido.CheckRetrieved)
+	r1, _ := rets[1].(error)
+	return r0, r1
+}
+
+// GetCheckExpandedCall is an expected call to GetCheckExpanded
+type GetCheckExpandedCall struct {
+	*Call
+}
+
+// ExpectGetCheckExpanded registers an expected call to GetCheckExpanded. Arguments are matched
+// with Eq unless they are a Matcher.
+func (m *Client) ExpectGetCheckExpanded(ctx, id interface{}) *GetCheckExpandedCall {
+	return &GetCheckExpandedCall{m.expect("GetCheckExpanded", ctx, id)}
+}
+
+// Return sets the values returned by the call.
+func (c *GetCheckExpandedCall) Return(r0 *onfido.Check, r1 error) *GetCheckExpandedCall {
+	c.returns = []interface{}{r0, r1}
+	return c
+}
+
+// Do sets a func called with the arguments of the call, which returns its values.
+func (c *GetCheckExpandedCall) Do(fn func(ctx context.Context, id string) (*onfido.Check, error)) *GetCheckExpandedCall {
+	c.do = func(args []interface{}) []interface{} {
+		ctx, _ := args[0].(context.Context)
+		id, _ := args[1].(string)
+		r0, r1 := fn(ctx, id)
+		return []interface{}{r0, r1}
+	}
+	return c
+}
+
+// Times limits the number of calls matching the expectation.
+func (c *GetCheckExpandedCall) Times(n int) *GetCheckExpandedCall {
+	c.Call.Times(n)
+	return c
+}
+
+// Once limits the expectation to a single call.
+func (c *GetCheckExpandedCall) Once() *GetCheckExpandedCall {
+	return c.Times(1)
+}
+
+// GetCheckExpanded implements onfido.OnfidoClient.
+func (m *Client) GetCheckExpanded(ctx context.Context, id string) (*onfido.Check, error) {
+	rets := m.called(2, "GetCheckExpanded", ctx, id)
+	r0, _ := rets[0].(*onfido.Check)
+	r1, _ := rets[1].(error)
+	return r0, r1
+}
+
+// ResumeCheckCall is an expected call to ResumeCheck
+type ResumeCheckCall struct {
+	*Call
+}
+
+// ExpectResumeCheck registers an expected call to ResumeCheck. Arguments are matched
+// with Eq unless they are a Matcher.
+func (m *Client) ExpectResumeCheck(ctx, id interface{}) *ResumeCheckCall {
+	return &ResumeCheckCall{m.expect("ResumeCheck", ctx, id)}
+}
+
+// Return sets the values returned by the call.
+func (c *ResumeCheckCall) Return(r0 *onfido.Check, r1 error) *ResumeCheckCall {
+	c.returns = []interface{}{r0, r1}
+	return c
+}
+
+// Do sets a func called with the arguments of the call, which returns its values.
+func (c *ResumeCheckCall) Do(fn func(ctx context.Context, id string) (*onfido.Check, error)) *ResumeCheckCall {
+	c.do = func(args []interface{}) []interface{} {
+		ctx, _ := args[0].(context.Context)
+		id, _ := args[1].(string)
+		r0, r1 := fn(ctx, id)
+		return []interface{}{r0, r1}
+	}
+	return c
+}
+
+// Times limits the number of calls matching the expectation.
+func (c *ResumeCheckCall) Times(n int) *ResumeCheckCall {
+	c.Call.Times(n)
+	return c
+}
+
+// Once limits the expectation to a single call.
+func (c *ResumeCheckCall) Once() *ResumeCheckCall {
+	return c.Times(1)
+}
+
+// ResumeCheck implements onfido.OnfidoClient.
+func (m *Client) ResumeCheck(ctx context.Context, id string) (*onfido.Check, error) {
+	rets := m.called(2, "ResumeCheck", ctx, id)
+	r0, _ := rets[0].(*onfido.Check)
+	r1, _ := rets[1].(error)
+	return r0, r1
+}
+
+// ListChecksCall is an expected call to ListChecks
+type ListChecksCall struct {
+	*Call
+}
+
+// ExpectListChecks registers an expected call to ListChecks. Arguments are matched
+// with Eq unless they are a Matcher.
+func (m *Client) ExpectListChecks(applicantID interface{}) *ListChecksCall {
+	return &ListChecksCall{m.expect("ListChecks", applicantID)}
+}
+
+// Return sets the values returned by the call.
+func (c *ListChecksCall) Return(r0 *onfido.CheckIter) *ListChecksCall {
+	c.returns = []interface{}{r0}
+	return c
+}
+
+// Do sets a func called with the arguments of the call, which returns its values.
+func (c *ListChecksCall) Do(fn func(applicantID string) *onfido.CheckIter) *ListChecksCall {
+	c.do = func(args []interface{}) []interface{} {
+		applicantID, _ := args[0].(string)
+		r0 := fn(applicantID)
+		return []interface{}{r0}
+	}
+	return c
+}
+
+// Times limits the number of calls matching the expectation.
+func (c *ListChecksCall) Times(n int) *ListChecksCall {
+	c.Call.Times(n)
+	return c
+}
+
+// Once limits the expectation to a single call.
+func (c *ListChecksCall) Once() *ListChecksCall {
+	return c.Times(1)
+}
+
+// ListChecks implements onfido.OnfidoClient.
+func (m *Client) ListChecks(applicantID string) *onfido.CheckIter {
+	rets := m.called(1, "ListChecks", applicantID)
+	r0, ok := rets[0].(*onfido.CheckIter)
+	if !ok {
+		r0 = onfido.NewCheckIter(nil, nil)
+	}
+	return r0
+}
+
+// DownloadCheckCall is an expected call to DownloadCheck
+type DownloadCheckCall struct {
+	*Call
+}
+
+// ExpectDownloadCheck registers an expected call to DownloadCheck. Arguments are matched
+// with Eq unless they are a Matcher.
+func (m *Client) ExpectDownloadCheck(ctx, id, w interface{}) *DownloadCheckCall {
+	return &DownloadCheckCall{m.expect("DownloadCheck", ctx, id, w)}
+}
+
+// Return sets the values returned by the call.
+func (c *DownloadCheckCall) Return(r0 error) *DownloadCheckCall {
+	c.returns = []interface{}{r0}
+	return c
+}
+
+// Do sets a func called with the arguments of the call, which returns its values.
+func (c *DownloadCheckCall) Do(fn func(ctx context.Context, id string, w io.Writer) error) *DownloadCheckCall {
+	c.do = func(args []interface{}) []interface{} {
+		ctx, _ := args[0].(context.Context)
+		id, _ := args[1].(string)
+		w, _ := args[2].(io.Writer)
+		r0 := fn(ctx, id, w)
+		return []interface{}{r0}
+	}
+	return c
+}
+
+// Times limits the number of calls matching the expectation.
+func (c *DownloadCheckCall) Times(n int) *DownloadCheckCall {
+	c.Call.Times(n)
+	return c
+}
+
+// Once limits the expectation to a single call.
+func (c *DownloadCheckCall) Once() *DownloadCheckCall {
+	return c.Times(1)
+}
+
+// DownloadCheck implements onfido.OnfidoClient.
+func (m *Client) DownloadCheck(ctx context.Context, id string, w io.Writer) error {
+	rets := m.called(1, "DownloadCheck", ctx, id, w)
+	r0, _ := rets[0].(error)
+	return r0
+}
+
+// CreateWebhookCall is an expected call to CreateWebhook
+type CreateWebhookCall struct {
+	*Call
+}
+
+// ExpectCreateWebhook registers an expected call to CreateWebhook. Arguments are matched
+// with Eq unless they are a Matcher.
+func (m *Client) ExpectCreateWebhook(ctx, wr interface{}) *CreateWebhookCall {
+	return &CreateWebhookCall{m.expect("CreateWebhook", ctx, wr)}
+}
+
+// Return sets the values returned by the call.
+func (c *CreateWebhookCall) Return(r0 *onfido.WebhookRef, r1 error) *CreateWebhookCall {
+	c.returns = []interface{}{r0, r1}
+	return c
+}
+
+// Do sets a func called with the arguments of the call, which returns its values.
+func (c *CreateWebhookCall) Do(fn func(ctx context.Context, wr onfido.WebhookRefRequest) (*onfido.WebhookRef, error)) *CreateWebhookCall {
+	c.do = func(args []interface{}) []interface{} {
+		ctx, _ := args[0].(context.Context)
+		wr, _ := args[1].(onfido.WebhookRefRequest)
+		r0, r1 := fn(ctx, wr)
+		return []interface{}{r0, r1}
+	}
+	return c
+}
+
+// Times limits the number of calls matching the expectation.
+func (c *CreateWebhookCall) Times(n int) *CreateWebhookCall {
+	c.Call.Times(n)
+	return c
+}
+
+// Once limits the expectation to a single call.
+func (c *CreateWebhookCall) Once() *CreateWebhookCall {
+	return c.Times(1)
+}
+
+// CreateWebhook implements onfido.OnfidoClient.
+func (m *Client) CreateWebhook(ctx context.Context, wr onfido.WebhookRefRequest) (*onfido.WebhookRef, error) {
+	rets := m.called(2, "CreateWebhook", ctx, wr)
+	r0, _ := rets[0].(*onfido.WebhookRef)
+	r1, _ := rets[1].(error)
+	return r0, r1
+}
+
+// UpdateWebhookCall is an expected call to UpdateWebhook
+type UpdateWebhookCall struct {
+	*Call
+}
+
+// ExpectUpdateWebhook registers an expected call to UpdateWebhook. Arguments are matched
+// with Eq unless they are a Matcher.
+func (m *Client) ExpectUpdateWebhook(ctx, id, wr interface{}) *UpdateWebhookCall {
+	return &UpdateWebhookCall{m.expect("UpdateWebhook", ctx, id, wr)}
+}
+
+// Return sets the values returned by the call.
+func (c *UpdateWebhookCall) Return(r0 *onfido.WebhookRef, r1 error) *UpdateWebhookCall {
+	c.returns = []interface{}{r0, r1}
+	return c
+}
+
+// Do sets a func called with the arguments of the call, which returns its values.
+func (c *UpdateWebhookCall) Do(fn func(ctx context.Context, id string, wr onfido.WebhookRefRequest) (*onfido.WebhookRef, error)) *UpdateWebhookCall {
+	c.do = func(args []interface{}) []interface{} {
+		ctx, _ := args[0].(context.Context)
+		id, _ := args[1].(string)
+		wr, _ := args[2].(onfido.WebhookRefRequest)
+		r0, r1 := fn(ctx, id, wr)
+		return []interface{}{r0, r1}
+	}
+	return c
+}
+
+// Times limits the number of calls matching the expectation.
+func (c *UpdateWebhookCall) Times(n int) *UpdateWebhookCall {
+	c.Call.Times(n)
+	return c
+}
+
+// Once limits the expectation to a single call.
+func (c *UpdateWebhookCall) Once() *UpdateWebhookCall {
+	return c.Times(1)
+}
+
+// UpdateWebhook implements onfido.OnfidoClient.
+func (m *Client) UpdateWebhook(ctx context.Context, id string, wr onfido.WebhookRefRequest) (*onfido.WebhookRef, error) {
+	rets := m.called(2, "UpdateWebhook", ctx, id, wr)
+	r0, _ := rets[0].(*onfido.WebhookRef)
+	r1, _ := rets[1].(error)
+	return r0, r1
+}
+
+// DeleteWebhookCall is an expected call to DeleteWebhook
+type DeleteWebhookCall struct {
+	*Call
+}
+
+// ExpectDeleteWebhook registers an expected call to DeleteWebhook. Arguments are matched
+// with Eq unless they are a Matcher.
+func (m *Client) ExpectDeleteWebhook(ctx, id interface{}) *DeleteWebhookCall {
+	return &DeleteWebhookCall{m.expect("DeleteWebhook", ctx, id)}
+}
+
+// Return sets the values returned by the call.
+func (c *DeleteWebhookCall) Return(r0 error) *DeleteWebhookCall {
+	c.returns = []interface{}{r0}
+	return c
+}
+
+// Do sets a func called with the arguments of the call, which returns its values.
+func (c *DeleteWebhookCall) Do(fn func(ctx context.Context, id string) error) *DeleteWebhookCall {
+	c.do = func(args []interface{}) []interface{} {
+		ctx, _ := args[0].(context.Context)
+		id, _ := args[1].(string)
+		r0 := fn(ctx, id)
+		return []interface{}{r0}
+	}
+	return c
+}
+
+// Times limits the number of calls matching the expectation.
+func (c *DeleteWebhookCall) Times(n int) *DeleteWebhookCall {
+	c.Call.Times(n)
+	return c
+}
+
+// Once limits the expectation to a single call.
+func (c *DeleteWebhookCall) Once() *DeleteWebhookCall {
+	return c.Times(1)
+}
+
+// DeleteWebhook implements onfido.OnfidoClient.
+func (m *Client) DeleteWebhook(ctx context.Context, id string) error {
+	rets := m.called(1, "DeleteWebhook", ctx, id)
+	r0, _ := rets[0].(error)
+	return r0
+}
+
+// ListWebhooksCall is an expected call to ListWebhooks
+type ListWebhooksCall struct {
+	*Call
+}
+
+// ExpectListWebhooks registers an expected call to ListWebhooks. Arguments are matched
+// with Eq unless they are a Matcher.
+func (m *Client) ExpectListWebhooks() *ListWebhooksCall {
+	return &ListWebhooksCall{m.expect("ListWebhooks")}
+}
+
+// Return sets the values returned by the call.
+func (c *ListWebhooksCall) Return(r0 *onfido.WebhookRefIter) *ListWebhooksCall {
+	c.returns = []interface{}{r0}
+	return c
+}
+
+// Do sets a func called with the arguments of the call, which returns its values.
+func (c *ListWebhooksCall) Do(fn func() *onfido.WebhookRefIter) *ListWebhooksCall {
+	c.do = func(args []interface{}) []interface{} {
+		r0 := fn()
+		return []interface{}{r0}
+	}
+	return c
+}
+
+// Times limits the number of calls matching the expectation.
+func (c *ListWebhooksCall) Times(n int) *ListWebhooksCall {
+	c.Call.Times(n)
+	return c
+}
+
+// Once limits the expectation to a single call.
+func (c *ListWebhooksCall) Once() *ListWebhooksCall {
+	return c.Times(1)
+}
+
+// ListWebhooks implements onfido.OnfidoClient.
+func (m *Client) ListWebhooks() *onfido.WebhookRefIter {
+	rets := m.called(1, "ListWebhooks")
+	r0, ok := rets[0].(*onfido.WebhookRefIter)
+	if !ok {
+		r0 = onfido.NewWebhookRefIter(nil, nil)
+	}
+	return r0
+}
+
+// CreateWorkflowRunCall is an expected call to CreateWorkflowRun
+type CreateWorkflowRunCall struct {
+	*Call
+}
+
+// ExpectCreateWorkflowRun registers an expected call to CreateWorkflowRun. Arguments are matched
+// with Eq unless they are a Matcher.
+func (m *Client) ExpectCreateWorkflowRun(ctx, wr interface{}) *CreateWorkflowRunCall {
+	return &CreateWorkflowRunCall{m.expect("CreateWorkflowRun", ctx, wr)}
+}
+
+// Return sets the values returned by the call.
+func (c *CreateWorkflowRunCall) Return(r0 *onfido.WorkflowRun, r1 error) *CreateWorkflowRunCall {
+	c.returns = []interface{}{r0, r1}
+	return c
+}
+
+// Do sets a func called with the arguments of the call, which returns its values.
+func (c *CreateWorkflowRunCall) Do(fn func(ctx context.Context, wr onfido.WorkflowRunRequest) (*onfido.WorkflowRun, error)) *CreateWorkflowRunCall {
+	c.do = func(args []interface{}) []interface{} {
+		ctx, _ := args[0].(context.Context)
+		wr, _ := args[1].(onfido.WorkflowRunRequest)
+		r0, r1 := fn(ctx, wr)
+		return []interface{}{r0, r1}
+	}
+	return c
+}
+
+// Times limits the number of calls matching the expectation.
+func (c *CreateWorkflowRunCall) Times(n int) *CreateWorkflowRunCall {
+	c.Call.Times(n)
+	return c
+}
+
+// Once limits the expectation to a single call.
+func (c *CreateWorkflowRunCall) Once() *CreateWorkflowRunCall {
+	return c.Times(1)
+}
+
+// CreateWorkflowRun implements onfido.OnfidoClient.
+func (m *Client) CreateWorkflowRun(ctx context.Context, wr onfido.WorkflowRunRequest) (*onfido.WorkflowRun, error) {
+	rets := m.called(2, "CreateWorkflowRun", ctx, wr)
+	r0, _ := rets[0].(*onfido.WorkflowRun)
+	r1, _ := rets[1].(error)
+	return r0, r1
+}
+
+// GetWorkflowRunCall is an expected call to GetWorkflowRun
+type GetWorkflowRunCall struct {
+	*Call
+}
+
+// ExpectGetWorkflowRun registers an expected call to GetWorkflowRun. Arguments are matched
+// with Eq unless they are a Matcher.
+func (m *Client) ExpectGetWorkflowRun(ctx, id interface{}) *GetWorkflowRunCall {
+	return &GetWorkflowRunCall{m.expect("GetWorkflowRun", ctx, id)}
+}
+
+// Return sets the values returned by the call.
+func (c *GetWorkflowRunCall) Return(r0 *onfido.WorkflowRun, r1 error) *GetWorkflowRunCall {
+	c.returns = []interface{}{r0, r1}
+	return c
+}
+
+// Do sets a func called with the arguments of the call, which returns its values.
+func (c *GetWorkflowRunCall) Do(fn func(ctx context.Context, id string) (*onfido.WorkflowRun, error)) *GetWorkflowRunCall {
+	c.do = func(args []interface{}) []interface{} {
+		ctx, _ := args[0].(context.Context)
+		id, _ := args[1].(string)
+		r0, r1 := fn(ctx, id)
+		return []interface{}{r0, r1}
+	}
+	return c
+}
+
+// Times limits the number of calls matching the expectation.
+func (c *GetWorkflowRunCall) Times(n int) *GetWorkflowRunCall {
+	c.Call.Times(n)
+	return c
+}
+
+// Once limits the expectation to a single call.
+func (c *GetWorkflowRunCall) Once() *GetWorkflowRunCall {
+	return c.Times(1)
+}
+
+// GetWorkflowRun implements onfido.OnfidoClient.
+func (m *Client) GetWorkflowRun(ctx context.Context, id string) (*onfido.WorkflowRun, error) {
+	rets := m.called(2, "GetWorkflowRun", ctx, id)
+	r0, _ := rets[0].(*onfido.WorkflowRun)
+	r1, _ := rets[1].(error)
+	return r0, r1
+}
+
+// ListWorkflowRunsCall is an expected call to ListWorkflowRuns
+type ListWorkflowRunsCall struct {
+	*Call
+}
+
+// ExpectListWorkflowRuns registers an expected call to ListWorkflowRuns. Arguments are matched
+// with Eq unless they are a Matcher.
+func (m *Client) ExpectListWorkflowRuns(filter interface{}) *ListWorkflowRunsCall {
+	return &ListWorkflowRunsCall{m.expect("ListWorkflowRuns", filter)}
+}
+
+// Return sets the values returned by the call.
+func (c *ListWorkflowRunsCall) Return(r0 *onfido.WorkflowRunIter) *ListWorkflowRunsCall {
+	c.returns = []interface{}{r0}
+	return c
+}
+
+// Do sets a func called with the arguments of the call, which returns its values.
+func (c *ListWorkflowRunsCall) Do(fn func(filter onfido.WorkflowRunFilter) *onfido.WorkflowRunIter) *ListWorkflowRunsCall {
+	c.do = func(args []interface{}) []interface{} {
+		filter, _ := args[0].(onfido.WorkflowRunFilter)
+		r0 := fn(filter)
+		return []interface{}{r0}
+	}
+	return c
+}
+
+// Times limits the number of calls matching the expectation.
+func (c *ListWorkflowRunsCall) Times(n int) *ListWorkflowRunsCall {
+	c.Call.Times(n)
+	return c
+}
+
+// Once limits the expectation to a single call.
+func (c *ListWorkflowRunsCall) Once() *ListWorkflowRunsCall {
+	return c.Times(1)
+}
+
+// ListWorkflowRuns implements onfido.OnfidoClient.
+func (m *Client) ListWorkflowRuns(filter onfido.WorkflowRunFilter) *onfido.WorkflowRunIter {
+	rets := m.called(1, "ListWorkflowRuns", filter)
+	r0, ok := rets[0].(*onfido.WorkflowRunIter)
+	if !ok {
+		r0 = onfido.NewWorkflowRunIter(nil, nil)
+	}
+	return r0
+}
+
+// DownloadSignedEvidenceFileCall is an expected call to DownloadSignedEvidenceFile
+type DownloadSignedEvidenceFileCall struct {
+	*Call
+}
+
+// ExpectDownloadSignedEvidenceFile registers an expected call to DownloadSignedEvidenceFile. Arguments are matched
+// with Eq unless they are a Matcher.
+func (m *Client) ExpectDownloadSignedEvidenceFile(ctx, workflowRunID, w interface{}) *DownloadSignedEvidenceFileCall {
+	return &DownloadSignedEvidenceFileCall{m.expect("DownloadSignedEvidenceFile", ctx, workflowRunID, w)}
+}
+
+// Return sets the values returned by the call.
+func (c *DownloadSignedEvidenceFileCall) Return(r0 error) *DownloadSignedEvidenceFileCall {
+	c.returns = []interface{}{r0}
+	return c
+}
+
+// Do sets a func called with the arguments of the call, which returns its values.
+func (c *DownloadSignedEvidenceFileCall) Do(fn func(ctx context.Context, workflowRunID string, w io.Writer) error) *DownloadSignedEvidenceFileCall {
+	c.do = func(args []interface{}) []interface{} {
+		ctx, _ := args[0].(context.Context)
+		workflowRunID, _ := args[1].(string)
+		w, _ := args[2].(io.Writer)
+		r0 := fn(ctx, workflowRunID, w)
+		return []interface{}{r0}
+	}
+	return c
+}
+
+// Times limits the number of calls matching the expectation.
+func (c *DownloadSignedEvidenceFileCall) Times(n int) *DownloadSignedEvidenceFileCall {
+	c.Call.Times(n)
+	return c
+}
+
+// Once limits the expectation to a single call.
+func (c *DownloadSignedEvidenceFileCall) Once() *DownloadSignedEvidenceFileCall {
+	return c.Times(1)
+}
+
+// DownloadSignedEvidenceFile implements onfido.OnfidoClient.
+func (m *Client) DownloadSignedEvidenceFile(ctx context.Context, workflowRunID string, w io.Writer) error {
+	rets := m.called(1, "DownloadSignedEvidenceFile", ctx, workflowRunID, w)
+	r0, _ := rets[0].(error)
+	return r0
+}
+
+// DownloadEvidenceFolderCall is an expected call to DownloadEvidenceFolder
+type DownloadEvidenceFolderCall struct {
+	*Call
+}
+
+// ExpectDownloadEvidenceFolder registers an expected call to DownloadEvidenceFolder. Arguments are matched
+// with Eq unless they are a Matcher.
+func (m *Client) ExpectDownloadEvidenceFolder(ctx, workflowRunID, w interface{}) *DownloadEvidenceFolderCall {
+	return &DownloadEvidenceFolderCall{m.expect("DownloadEvidenceFolder", ctx, workflowRunID, w)}
+}
+
+// Return sets the values returned by the call.
+func (c *DownloadEvidenceFolderCall) Return(r0 error) *DownloadEvidenceFolderCall {
+	c.returns = []interface{}{r0}
+	return c
+}
+
+// Do sets a func called with the arguments of the call, which returns its values.
+func (c *DownloadEvidenceFolderCall) Do(fn func(ctx context.Context, workflowRunID string, w io.Writer) error) *DownloadEvidenceFolderCall {
+	c.do = func(args []interface{}) []interface{} {
+		ctx, _ := args[0].(context.Context)
+		workflowRunID, _ := args[1].(string)
+		w, _ := args[2].(io.Writer)
+		r0 := fn(ctx, workflowRunID, w)
+		return []interface{}{r0}
+	}
+	return c
+}
+
+// Times limits the number of calls matching the expectation.
+func (c *DownloadEvidenceFolderCall) Times(n int) *DownloadEvidenceFolderCall {
+	c.Call.Times(n)
+	return c
+}
+
+// Once limits the expectation to a single call.
+func (c *DownloadEvidenceFolderCall) Once() *DownloadEvidenceFolderCall {
+	return c.Times(1)
+}
+
+// DownloadEvidenceFolder implements onfido.OnfidoClient.
+func (m *Client) DownloadEvidenceFolder(ctx context.Context, workflowRunID string, w io.Writer) error {
+	rets := m.called(1, "DownloadEvidenceFolder", ctx, workflowRunID, w)
+	r0, _ := rets[0].(error)
+	return r0
+}
+
+// CreateTimelineFileCall is an expected call to CreateTimelineFile
+type CreateTimelineFileCall struct {
+	*Call
+}
+
+// ExpectCreateTimelineFile registers an expected call to CreateTimelineFile. Arguments are matched
+// with Eq unless they are a Matcher.
+func (m *Client) ExpectCreateTimelineFile(ctx, workflowRunID interface{}) *CreateTimelineFileCall {
+	return &CreateTimelineFileCall{m.expect("CreateTimelineFile", ctx, workflowRunID)}
+}
+
+// Return sets the values returned by the call.
+func (c *CreateTimelineFileCall) Return(r0 *onfido.TimelineFileReference, r1 error) *CreateTimelineFileCall {
+	c.returns = []interface{}{r0, r1}
+	return c
+}
+
+// Do sets a func called with the arguments of the call, which returns its values.
+func (c *CreateTimelineFileCall) Do(fn func(ctx context.Context, workflowRunID string) (*onfido.TimelineFileReference, error)) *CreateTimelineFileCall {
+	c.do = func(args []interface{}) []interface{} {
+		ctx, _ := args[0].(context.Context)
+		workflowRunID, _ := args[1].(string)
+		r0, r1 := fn(ctx, workflowRunID)
+		return []interface{}{r0, r1}
+	}
+	return c
+}
+
+// Times limits the number of calls matching the expectation.
+func (c *CreateTimelineFileCall) Times(n int) *CreateTimelineFileCall {
+	c.Call.Times(n)
+	return c
+}
+
+// Once limits the expectation to a single call.
+func (c *CreateTimelineFileCall) Once() *CreateTimelineFileCall {
+	return c.Times(1)
+}
+
+// CreateTimelineFile implements onfido.OnfidoClient.
+func (m *Client) CreateTimelineFile(ctx context.Context, workflowRunID string) (*onfido.TimelineFileReference, error) {
+	rets := m.called(2, "CreateTimelineFile", ctx, workflowRunID)
+	r0, _ := rets[0].(*onfido.TimelineFileReference)
+	r1, _ := rets[1].(error)
+	return r0, r1
+}
+
+// DownloadTimelineFileCall is an expected call to DownloadTimelineFile
+type DownloadTimelineFileCall struct {
+	*Call
+}
+
+// ExpectDownloadTimelineFile registers an expected call to DownloadTimelineFile. Arguments are matched
+// with Eq unless they are a Matcher.
+func (m *Client) ExpectDownloadTimelineFile(ctx, workflowRunID, timelineFileID, w interface{}) *DownloadTimelineFileCall {
+	return &DownloadTimelineFileCall{m.expect("DownloadTimelineFile", ctx, workflowRunID, timelineFileID, w)}
+}
+
+// Return sets the values returned by the call.
+func (c *DownloadTimelineFileCall) Return(r0 error) *DownloadTimelineFileCall {
+	c.returns = []interface{}{r0}
+	return c
+}
+
+// Do sets a func called with the arguments of the call, which returns its values.
+func (c *DownloadTimelineFileCall) Do(fn func(ctx context.Context, workflowRunID string, timelineFileID string, w io.Writer) error) *DownloadTimelineFileCall {
+	c.do = func(args []interface{}) []interface{} {
+		ctx, _ := args[0].(context.Context)
+		workflowRunID, _ := args[1].(string)
+		timelineFileID, _ := args[2].(string)
+		w, _ := args[3].(io.Writer)
+		r0 := fn(ctx, workflowRunID, timelineFileID, w)
+		return []interface{}{r0}
+	}
+	return c
+}
+
+// Times limits the number of calls matching the expectation.
+func (c *DownloadTimelineFileCall) Times(n int) *DownloadTimelineFileCall {
+	c.Call.Times(n)
+	return c
+}
+
+// Once limits the expectation to a single call.
+func (c *DownloadTimelineFileCall) Once() *DownloadTimelineFileCall {
+	return c.Times(1)
+}
+
+// DownloadTimelineFile implements onfido.OnfidoClient.
+func (m *Client) DownloadTimelineFile(ctx context.Context, workflowRunID string, timelineFileID string, w io.Writer) error {
+	rets := m.called(1, "DownloadTimelineFile", ctx, workflowRunID, timelineFileID, w)
+	r0, _ := rets[0].(error)
+	return r0
+}
+
+// ListTasksCall is an expected call to ListTasks
+type ListTasksCall struct {
+	*Call
+}
+
+// ExpectListTasks registers an expected call to ListTasks. Arguments are matched
+// with Eq unless they are a Matcher.
+func (m *Client) ExpectListTasks(workflowRunID interface{}) *ListTasksCall {
+	return &ListTasksCall{m.expect("ListTasks", workflowRunID)}
+}
+
+// Return sets the values returned by the call.
+func (c *ListTasksCall) Return(r0 *onfido.TaskIter) *ListTasksCall {
+	c.returns = []interface{}{r0}
+	return c
+}
+
+// Do sets a func called with the arguments of the call, which returns its values.
+func (c *ListTasksCall) Do(fn func(workflowRunID string) *onfido.TaskIter) *ListTasksCall {
+	c.do = func(args []interface{}) []interface{} {
+		workflowRunID, _ := args[0].(string)
+		r0 := fn(workflowRunID)
+		return []interface{}{r0}
+	}
+	return c
+}
+
+// Times limits the number of calls matching the expectation.
+func (c *ListTasksCall) Times(n int) *ListTasksCall {
+	c.Call.Times(n)
+	return c
+}
+
+// Once limits the expectation to a single call.
+func (c *ListTasksCall) Once() *ListTasksCall {
+	return c.Times(1)
+}
+
+// ListTasks implements onfido.OnfidoClient.
+func (m *Client) ListTasks(workflowRunID string) *onfido.TaskIter {
+	rets := m.called(1, "ListTasks", workflowRunID)
+	r0, ok := rets[0].(*onfido.TaskIter)
+	if !ok {
+		r0 = onfido.NewTaskIter(nil, nil)
+	}
+	return r0
+}
+
+// UploadSigningDocumentCall is an expected call to UploadSigningDocument
+type UploadSigningDocumentCall struct {
+	*Call
+}
+
+// ExpectUploadSigningDocument registers an expected call to UploadSigningDocument. Arguments are matched
+// with Eq unless they are a Matcher.
+func (m *Client) ExpectUploadSigningDocument(ctx, sr interface{}) *UploadSigningDocumentCall {
+	return &UploadSigningDocumentCall{m.expect("UploadSigningDocument", ctx, sr)}
+}
+
+// Return sets the values returned by the call.
+func (c *UploadSigningDocumentCall) Return(r0 *onfido.SigningDocument, r1 error) *UploadSigningDocumentCall {
+	c.returns = []interface{}{r0, r1}
+	return c
+}
+
+// Do sets a func called with the arguments of the call, which returns its values.
+func (c *UploadSigningDocumentCall) Do(fn func(ctx context.Context, sr onfido.SigningDocumentRequest) (*onfido.SigningDocument, error)) *UploadSigningDocumentCall {
+	c.do = func(args []interface{}) []interface{} {
+		ctx, _ := args[0].(context.Context)
+		sr, _ := args[1].(onfido.SigningDocumentRequest)
+		r0, r1 := fn(ctx, sr)
+		return []interface{}{r0, r1}
+	}
+	return c
+}
+
+// Times limits the number of calls matching the expectation.
+func (c *UploadSigningDocumentCall) Times(n int) *UploadSigningDocumentCall {
+	c.Call.Times(n)
+	return c
+}
+
+// Once limits the expectation to a single call.
+func (c *UploadSigningDocumentCall) Once() *UploadSigningDocumentCall {
+	return c.Times(1)
+}
+
+// UploadSigningDocument implements onfido.OnfidoClient.
+func (m *Client) UploadSigningDocument(ctx context.Context, sr onfido.SigningDocumentRequest) (*onfido.SigningDocument, error) {
+	rets := m.called(2, "UploadSigningDocument", ctx, sr)
+	r0, _ := rets[0].(*onfido.SigningDocument)
+	r1, _ := rets[1].(error)
+	return r0, r1
+}
+
+// GetSigningDocumentCall is an expected call to GetSigningDocument
+type GetSigningDocumentCall struct {
+	*Call
+}
+
+// ExpectGetSigningDocument registers an expected call to GetSigningDocument. Arguments are matched
+// with Eq unless they are a Matcher.
+func (m *Client) ExpectGetSigningDocument(ctx, id interface{}) *GetSigningDocumentCall {
+	return &GetSigningDocumentCall{m.expect("GetSigningDocument", ctx, id)}
+}
+
+// Return sets the values returned by the call.
+func (c *GetSigningDocumentCall) Return(r0 *onfido.SigningDocument, r1 error) *GetSigningDocumentCall {
+	c.returns = []interface{}{r0, r1}
+	return c
+}
+
+// Do sets a func called with the arguments of the call, which returns its values.
+func (c *GetSigningDocumentCall) Do(fn func(ctx context.Context, id string) (*onfido.SigningDocument, error)) *GetSigningDocumentCall {
+	c.do = func(args []interface{}) []interface{} {
+		ctx, _ := args[0].(context.Context)
+		id, _ := args[1].(string)
+		r0, r1 := fn(ctx, id)
+		return []interface{}{r0, r1}
+	}
+	return c
+}
+
+// Times limits the number of calls matching the expectation.
+func (c *GetSigningDocumentCall) Times(n int) *GetSigningDocumentCall {
+	c.Call.Times(n)
+	return c
+}
+
+// Once limits the expectation to a single call.
+func (c *GetSigningDocumentCall) Once() *GetSigningDocumentCall {
+	return c.Times(1)
+}
+
+// GetSigningDocument implements onfido.OnfidoClient.
+func (m *Client) GetSigningDocument(ctx context.Context, id string) (*onfido.SigningDocument, error) {
+	rets := m.called(2, "GetSigningDocument", ctx, id)
+	r0, _ := rets[0].(*onfido.SigningDocument)
+	r1, _ := rets[1].(error)
+	return r0, r1
+}
+
+// DownloadSigningDocumentCall is an expected call to DownloadSigningDocument
+type DownloadSigningDocumentCall struct {
+	*Call
+}
+
+// ExpectDownloadSigningDocument registers an expected call to DownloadSigningDocument. Arguments are matched
+// with Eq unless they are a Matcher.
+func (m *Client) ExpectDownloadSigningDocument(ctx, id, w interface{}) *DownloadSigningDocumentCall {
+	return &DownloadSigningDocumentCall{m.expect("DownloadSigningDocument", ctx, id, w)}
+}
+
+// Return sets the values returned by the call.
+func (c *DownloadSigningDocumentCall) Return(r0 error) *DownloadSigningDocumentCall {
+	c.returns = []interface{}{r0}
+	return c
+}
+
+// Do sets a func called with the arguments of the call, which returns its values.
+func (c *DownloadSigningDocumentCall) Do(fn func(ctx context.Context, id string, w io.Writer) error) *DownloadSigningDocumentCall {
+	c.do = func(args []interface{}) []interface{} {
+		ctx, _ := args[0].(context.Context)
+		id, _ := args[1].(string)
+		w, _ := args[2].(io.Writer)
+		r0 := fn(ctx, id, w)
+		return []interface{}{r0}
+	}
+	return c
+}
+
+// Times limits the number of calls matching the expectation.
+func (c *DownloadSigningDocumentCall) Times(n int) *DownloadSigningDocumentCall {
+	c.Call.Times(n)
+	return c
+}
+
+// Once limits the expectation to a single call.
+func (c *DownloadSigningDocumentCall) Once() *DownloadSigningDocumentCall {
+	return c.Times(1)
+}
+
+// DownloadSigningDocument implements onfido.OnfidoClient.
+func (m *Client) DownloadSigningDocument(ctx context.Context, id string, w io.Writer) error {
+	rets := m.called(1, "DownloadSigningDocument", ctx, id, w)
+	r0, _ := rets[0].(error)
+	return r0
+}
+
+// ListSigningDocumentsCall is an expected call to ListSigningDocuments
+type ListSigningDocumentsCall struct {
+	*Call
+}
+
+// ExpectListSigningDocuments registers an expected call to ListSigningDocuments. Arguments are matched
+// with Eq unless they are a Matcher.
+func (m *Client) ExpectListSigningDocuments(applicantID interface{}) *ListSigningDocumentsCall {
+	return &ListSigningDocumentsCall{m.expect("ListSigningDocuments", applicantID)}
+}
+
+// Return sets the values returned by the call.
+func (c *ListSigningDocumentsCall) Return(r0 *onfido.SigningDocumentIter) *ListSigningDocumentsCall {
+	c.returns = []interface{}{r0}
+	return c
+}
+
+// Do sets a func called with the arguments of the call, which returns its values.
+func (c *ListSigningDocumentsCall) Do(fn func(applicantID string) *onfido.SigningDocumentIter) *ListSigningDocumentsCall {
+	c.do = func(args []interface{}) []interface{} {
+		applicantID, _ := args[0].(string)
+		r0 := fn(applicantID)
+		return []interface{}{r0}
+	}
+	return c
+}
+
+// Times limits the number of calls matching the expectation.
+func (c *ListSigningDocumentsCall) Times(n int) *ListSigningDocumentsCall {
+	c.Call.Times(n)
+	return c
+}
+
+// Once limits the expectation to a single call.
+func (c *ListSigningDocumentsCall) Once() *ListSigningDocumentsCall {
+	return c.Times(1)
+}
+
+// ListSigningDocuments implements onfido.OnfidoClient.
+func (m *Client) ListSigningDocuments(applicantID string) *onfido.SigningDocumentIter {
+	rets := m.called(1, "ListSigningDocuments", applicantID)
+	r0, ok := rets[0].(*onfido.SigningDocumentIter)
+	if !ok {
+		r0 = onfido.NewSigningDocumentIter(nil, nil)
+	}
+	return r0
+}
+
+// DownloadQESDocumentCall is an expected call to DownloadQESDocument
+type DownloadQESDocumentCall struct {
+	*Call
+}
+
+// ExpectDownloadQESDocument registers an expected call to DownloadQESDocument. Arguments are matched
+// with Eq unless they are a Matcher.
+func (m *Client) ExpectDownloadQESDocument(ctx, doc, w interface{}) *DownloadQESDocumentCall {
+	return &DownloadQESDocumentCall{m.expect("DownloadQESDocument", ctx, doc, w)}
+}
+
+// Return sets the values returned by the call.
+func (c *DownloadQESDocumentCall) Return(r0 error) *DownloadQESDocumentCall {
+	c.returns = []interface{}{r0}
+	return c
+}
+
+// Do sets a func called with the arguments of the call, which returns its values.
+func (c *DownloadQESDocumentCall) Do(fn func(ctx context.Context, doc onfido.QESDocument, w io.Writer) error) *DownloadQESDocumentCall {
+	c.do = func(args []interface{}) []interface{} {
+		ctx, _ := args[0].(context.Context)
+		doc, _ := args[1].(onfido.QESDocument)
+		w, _ := args[2].(io.Writer)
+		r0 := fn(ctx, doc, w)
+		return []interface{}{r0}
+	}
+	return c
+}
+
+// Times limits the number of calls matching the expectation.
+func (c *DownloadQESDocumentCall) Times(n int) *DownloadQESDocumentCall {
+	c.Call.Times(n)
+	return c
+}
+
+// Once limits the expectation to a single call.
+func (c *DownloadQESDocumentCall) Once() *DownloadQESDocumentCall {
+	return c.Times(1)
+}
+
+// DownloadQESDocument implements onfido.OnfidoClient.
+func (m *Client) DownloadQESDocument(ctx context.Context, doc onfido.QESDocument, w io.Writer) error {
+	rets := m.called(1, "DownloadQESDocument", ctx, doc, w)
+	r0, _ := rets[0].(error)
+	return r0
+}
+
+// GetTaskCall is an expected call to GetTask
+type GetTaskCall struct {
+	*Call
+}
+
+// ExpectGetTask registers an expected call to GetTask. Arguments are matched
+// with Eq unless they are a Matcher.
+func (m *Client) ExpectGetTask(ctx, workflowRunID, taskID interface{}) *GetTaskCall {
+	return &GetTaskCall{m.expect("GetTask", ctx, workflowRunID, taskID)}
+}
+
+// Return sets the values returned by the call.
+func (c *GetTaskCall) Return(r0 *onfido.Task, r1 error) *GetTaskCall {
+	c.returns = []interface{}{r0, r1}
+	return c
+}
+
+// Do sets a func called with the arguments of the call, which returns its values.
+func (c *GetTaskCall) Do(fn func(ctx context.Context, workflowRunID string, taskID string) (*onfido.Task, error)) *GetTaskCall {
+	c.do = func(args []interface{}) []interface{} {
+		ctx, _ := args[0].(context.Context)
+		workflowRunID, _ := args[1].(string)
+		taskID, _ := args[2].(string)
+		r0, r1 := fn(ctx, workflowRunID, taskID)
+		return []interface{}{r0, r1}
+	}
+	return c
+}
+
+// Times limits the number of calls matching the expectation.
+func (c *GetTaskCall) Times(n int) *GetTaskCall {
+	c.Call.Times(n)
+	return c
+}
+
+// Once limits the expectation to a single call.
+func (c *GetTaskCall) Once() *GetTaskCall {
+	return c.Times(1)
+}
+
+// GetTask implements onfido.OnfidoClient.
+func (m *Client) GetTask(ctx context.Context, workflowRunID string, taskID string) (*onfido.Task, error) {
+	rets := m.called(2, "GetTask", ctx, workflowRunID, taskID)
+	r0, _ := rets[0].(*onfido.Task)
+	r1, _ := rets[1].(error)
+	return r0, r1
+}
+
+// CompleteTaskCall is an expected call to CompleteTask
+type CompleteTaskCall struct {
+	*Call
+}
+
+// ExpectCompleteTask registers an expected call to CompleteTask. Arguments are matched
+// with Eq unless they are a Matcher.
+func (m *Client) ExpectCompleteTask(ctx, workflowRunID, taskID, data interface{}) *CompleteTaskCall {
+	return &CompleteTaskCall{m.expect("CompleteTask", ctx, workflowRunID, taskID, data)}
+}
+
+// Return sets the values returned by the call.
+func (c *CompleteTaskCall) Return(r0 error) *CompleteTaskCall {
+	c.returns = []interface{}{r0}
+	return c
+}
+
+// Do sets a func called with the arguments of the call, which returns its values.
+func (c *CompleteTaskCall) Do(fn func(ctx context.Context, workflowRunID string, taskID string, data interface{}) error) *CompleteTaskCall {
+	c.do = func(args []interface{}) []interface{} {
+		ctx, _ := args[0].(context.Context)
+		workflowRunID, _ := args[1].(string)
+		taskID, _ := args[2].(string)
+		data := args[3]
+		r0 := fn(ctx, workflowRunID, taskID, data)
+		return []interface{}{r0}
+	}
+	return c
+}
+
+// Times limits the number of calls matching the expectation.
+func (c *CompleteTaskCall) Times(n int) *CompleteTaskCall {
+	c.Call.Times(n)
+	return c
+}
+
+// Once limits the expectation to a single call.
+func (c *CompleteTaskCall) Once() *CompleteTaskCall {
+	return c.Times(1)
+}
+
+// CompleteTask implements onfido.OnfidoClient.
+func (m *Client) CompleteTask(ctx context.Context, workflowRunID string, taskID string, data interface{}) error {
+	rets := m.called(1, "CompleteTask", ctx, workflowRunID, taskID, data)
+	r0, _ := rets[0].(error)
+	return r0
+}
+
+// CreateWatchlistMonitorCall is an expected call to CreateWatchlistMonitor
+type CreateWatchlistMonitorCall struct {
+	*Call
+}
+
+// ExpectCreateWatchlistMonitor registers an expected call to CreateWatchlistMonitor. Arguments are matched
+// with Eq unless they are a Matcher.
+func (m *Client) ExpectCreateWatchlistMonitor(ctx, wr interface{}) *CreateWatchlistMonitorCall {
+	return &CreateWatchlistMonitorCall{m.expect("CreateWatchlistMonitor", ctx, wr)}
+}
+
+// Return sets the values returned by the call.
+func (c *CreateWatchlistMonitorCall) Return(r0 *onfido.WatchlistMonitor, r1 error) *CreateWatchlistMonitorCall {
+	c.returns = []interface{}{r0, r1}
+	return c
+}
+
+// Do sets a func called with the arguments of the call, which returns its values.
+func (c *CreateWatchlistMonitorCall) Do(fn func(ctx context.Context, wr onfido.WatchlistMonitorRequest) (*onfido.WatchlistMonitor, error)) *CreateWatchlistMonitorCall {
+	c.do = func(args []interface{}) []interface{} {
+		ctx, _ := args[0].(context.Context)
+		wr, _ := args[1].(onfido.WatchlistMonitorRequest)
+		r0, r1 := fn(ctx, wr)
+		return []interface{}{r0, r1}
+	}
+	return c
+}
+
+// Times limits the number of calls matching the expectation.
+func (c *CreateWatchlistMonitorCall) Times(n int) *CreateWatchlistMonitorCall {
+	c.Call.Times(n)
+	return c
+}
+
+// Once limits the expectation to a single call.
+func (c *CreateWatchlistMonitorCall) Once() *CreateWatchlistMonitorCall {
+	return c.Times(1)
+}
+
+// CreateWatchlistMonitor implements onfido.OnfidoClient.
+func (m *Client) CreateWatchlistMonitor(ctx context.Context, wr onfido.WatchlistMonitorRequest) (*onfido.WatchlistMonitor, error) {
+	rets := m.called(2, "CreateWatchlistMonitor", ctx, wr)
+	r0, _ := rets[0].(*onfido.WatchlistMonitor)
+	r1, _ := rets[1].(error)
+	return r0, r1
+}
+
+// GetWatchlistMonitorCall is an expected call to GetWatchlistMonitor
+type GetWatchlistMonitorCall struct {
+	*Call
+}
+
+// ExpectGetWatchlistMonitor registers an expected call to GetWatchlistMonitor. Arguments are matched
+// with Eq unless they are a Matcher.
+func (m *Client) ExpectGetWatchlistMonitor(ctx, id interface{}) *GetWatchlistMonitorCall {
+	return &GetWatchlistMonitorCall{m.expect("GetWatchlistMonitor", ctx, id)}
+}
+
+// Return sets the values returned by the call.
+func (c *GetWatchlistMonitorCall) Return(r0 *onfido.WatchlistMonitor, r1 error) *GetWatchlistMonitorCall {
+	c.returns = []interface{}{r0, r1}
+	return c
+}
+
+// Do sets a func called with the arguments of the call, which returns its values.
+func (c *GetWatchlistMonitorCall) Do(fn func(ctx context.Context, id string) (*onfido.WatchlistMonitor, error)) *GetWatchlistMonitorCall {
+	c.do = func(args []interface{}) []interface{} {
+		ctx, _ := args[0].(context.Context)
+		id, _ := args[1].(string)
+		r0, r1 := fn(ctx, id)
+		return []interface{}{r0, r1}
+	}
+	return c
+}
+
+// Times limits the number of calls matching the expectation.
+func (c *GetWatchlistMonitorCall) Times(n int) *GetWatchlistMonitorCall {
+	c.Call.Times(n)
+	return c
+}
+
+// Once limits the expectation to a single call.
+func (c *GetWatchlistMonitorCall) Once() *GetWatchlistMonitorCall {
+	return c.Times(1)
+}
+
+// GetWatchlistMonitor implements onfido.OnfidoClient.
+func (m *Client) GetWatchlistMonitor(ctx context.Context, id string) (*onfido.WatchlistMonitor, error) {
+	rets := m.called(2, "GetWatchlistMonitor", ctx, id)
+	r0, _ := rets[0].(*onfido.WatchlistMonitor)
+	r1, _ := rets[1].(error)
+	return r0, r1
+}
+
+// DeleteWatchlistMonitorCall is an expected call to DeleteWatchlistMonitor
+type DeleteWatchlistMonitorCall struct {
+	*Call
+}
+
+// ExpectDeleteWatchlistMonitor registers an expected call to DeleteWatchlistMonitor. Arguments are matched
+// with Eq unless they are a Matcher.
+func (m *Client) ExpectDeleteWatchlistMonitor(ctx, id interface{}) *DeleteWatchlistMonitorCall {
+	return &DeleteWatchlistMonitorCall{m.expect("DeleteWatchlistMonitor", ctx, id)}
+}
+
+// Return sets the values returned by the call.
+func (c *DeleteWatchlistMonitorCall) Return(r0 error) *DeleteWatchlistMonitorCall {
+	c.returns = []interface{}{r0}
+	return c
+}
+
+// Do sets a func called with the arguments of the call, which returns its values.
+func (c *DeleteWatchlistMonitorCall) Do(fn func(ctx context.Context, id string) error) *DeleteWatchlistMonitorCall {
+	c.do = func(args []interface{}) []interface{} {
+		ctx, _ := args[0].(context.Context)
+		id, _ := args[1].(string)
+		r0 := fn(ctx, id)
+		return []interface{}{r0}
+	}
+	return c
+}
+
+// Times limits the number of calls matching the expectation.
+func (c *DeleteWatchlistMonitorCall) Times(n int) *DeleteWatchlistMonitorCall {
+	c.Call.Times(n)
+	return c
+}
+
+// Once limits the expectation to a single call.
+func (c *DeleteWatchlistMonitorCall) Once() *DeleteWatchlistMonitorCall {
+	return c.Times(1)
+}
+
+// DeleteWatchlistMonitor implements onfido.OnfidoClient.
+func (m *Client) DeleteWatchlistMonitor(ctx context.Context, id string) error {
+	rets := m.called(1, "DeleteWatchlistMonitor", ctx, id)
+	r0, _ := rets[0].(error)
+	return r0
+}
+
+// ListWatchlistMonitorsCall is an expected call to ListWatchlistMonitors
+type ListWatchlistMonitorsCall struct {
+	*Call
+}
+
+// ExpectListWatchlistMonitors registers an expected call to ListWatchlistMonitors. Arguments are matched
+// with Eq unless they are a Matcher.
+func (m *Client) ExpectListWatchlistMonitors(applicantID interface{}) *ListWatchlistMonitorsCall {
+	return &ListWatchlistMonitorsCall{m.expect("ListWatchlistMonitors", applicantID)}
+}
+
+// Return sets the values returned by the call.
+func (c *ListWatchlistMonitorsCall) Return(r0 *onfido.WatchlistMonitorIter) *ListWatchlistMonitorsCall {
+	c.returns = []interface{}{r0}
+	return c
+}
+
+// Do sets a func called with the arguments of the call, which returns its values.
+func (c *ListWatchlistMonitorsCall) Do(fn func(applicantID string) *onfido.WatchlistMonitorIter) *ListWatchlistMonitorsCall {
+	c.do = func(args []interface{}) []interface{} {
+		applicantID, _ := args[0].(string)
+		r0 := fn(applicantID)
+		return []interface{}{r0}
+	}
+	return c
+}
+
+// Times limits the number of calls matching the expectation.
+func (c *ListWatchlistMonitorsCall) Times(n int) *ListWatchlistMonitorsCall {
+	c.Call.Times(n)
+	return c
+}
+
+// Once limits the expectation to a single call.
+func (c *ListWatchlistMonitorsCall) Once() *ListWatchlistMonitorsCall {
+	return c.Times(1)
+}
+
+// ListWatchlistMonitors implements onfido.OnfidoClient.
+func (m *Client) ListWatchlistMonitors(applicantID string) *onfido.WatchlistMonitorIter {
+	rets := m.called(1, "ListWatchlistMonitors", applicantID)
+	r0, ok := rets[0].(*onfido.WatchlistMonitorIter)
+	if !ok {
+		r0 = onfido.NewWatchlistMonitorIter(nil, nil)
+	}
+	return r0
+}
+
+// ListMonitorMatchesCall is an expected call to ListMonitorMatches
+type ListMonitorMatchesCall struct {
+	*Call
+}
+
+// ExpectListMonitorMatches registers an expected call to ListMonitorMatches. Arguments are matched
+// with Eq unless they are a Matcher.
+func (m *Client) ExpectListMonitorMatches(ctx, monitorID interface{}) *ListMonitorMatchesCall {
+	return &ListMonitorMatchesCall{m.expect("ListMonitorMatches", ctx, monitorID)}
+}
+
+// Return sets the values returned by the call.
+func (c *ListMonitorMatchesCall) Return(r0 []*onfido.WatchlistMonitorMatch, r1 error) *ListMonitorMatchesCall {
+	c.returns = []interface{}{r0, r1}
+	return c
+}
+
+// Do sets a func called with the arguments of the call, which returns its values.
+func (c *ListMonitorMatchesCall) Do(fn func(ctx context.Context, monitorID string) ([]*onfido.WatchlistMonitorMatch, error)) *ListMonitorMatchesCall {
+	c.do = func(args []interface{}) []interface{} {
+		ctx, _ := args[0].(context.Context)
+		monitorID, _ := args[1].(string)
+		r0, r1 := fn(ctx, monitorID)
+		return []interface{}{r0, r1}
+	}
+	return c
+}
+
+// Times limits the number of calls matching the expectation.
+func (c *ListMonitorMatchesCall) Times(n int) *ListMonitorMatchesCall {
+	c.Call.Times(n)
+	return c
+}
+
+// Once limits the expectation to a single call.
+func (c *ListMonitorMatchesCall) Once() *ListMonitorMatchesCall {
+	return c.Times(1)
+}
+
+// ListMonitorMatches implements onfido.OnfidoClient.
+func (m *Client) ListMonitorMatches(ctx context.Context, monitorID string) ([]*onfido.WatchlistMonitorMatch, error) {
+	rets := m.called(2, "ListMonitorMatches", ctx, monitorID)
+	r0, _ := rets[0].([]*onfido.WatchlistMonitorMatch)
+	r1, _ := rets[1].(error)
+	return r0, r1
+}
+
+// UpdateMonitorMatchesCall is an expected call to UpdateMonitorMatches
+type UpdateMonitorMatchesCall struct {
+	*Call
+}
+
+// ExpectUpdateMonitorMatches registers an expected call to UpdateMonitorMatches. Arguments are matched
+// with Eq unless they are a Matcher.
+func (m *Client) ExpectUpdateMonitorMatches(ctx, monitorID, mu interface{}) *UpdateMonitorMatchesCall {
+	return &UpdateMonitorMatchesCall{m.expect("UpdateMonitorMatches", ctx, monitorID, mu)}
+}
+
+// Return sets the values returned by the call.
+func (c *UpdateMonitorMatchesCall) Return(r0 []*onfido.WatchlistMonitorMatch, r1 error) *UpdateMonitorMatchesCall {
+	c.returns = []interface{}{r0, r1}
+	return c
+}
+
+// Do sets a func called with the arguments of the call, which returns its values.
+func (c *UpdateMonitorMatchesCall) Do(fn func(ctx context.Context, monitorID string, mu onfido.WatchlistMonitorMatchesUpdate) ([]*onfido.WatchlistMonitorMatch, error)) *UpdateMonitorMatchesCall {
+	c.do = func(args []interface{}) []interface{} {
+		ctx, _ := args[0].(context.Context)
+		monitorID, _ := args[1].(string)
+		mu, _ := args[2].(onfido.WatchlistMonitorMatchesUpdate)
+		r0, r1 := fn(ctx, monitorID, mu)
+		return []interface{}{r0, r1}
+	}
+	return c
+}
+
+// Times limits the number of calls matching the expectation.
+func (c *UpdateMonitorMatchesCall) Times(n int) *UpdateMonitorMatchesCall {
+	c.Call.Times(n)
+	return c
+}
+
+// Once limits the expectation to a single call.
+func (c *UpdateMonitorMatchesCall) Once() *UpdateMonitorMatchesCall {
+	return c.Times(1)
+}
+
+// UpdateMonitorMatches implements onfido.OnfidoClient.
+func (m *Client) UpdateMonitorMatches(ctx context.Context, monitorID string, mu onfido.WatchlistMonitorMatchesUpdate) ([]*onfido.WatchlistMonitorMatch, error) {
+	rets := m.called(2, "UpdateMonitorMatches", ctx, monitorID, mu)
+	r0, _ := rets[0].([]*onfido.WatchlistMonitorMatch)
+	r1, _ := rets[1].(error)
+	return r0, r1
+}
+
+// ForceReportCreationCall is an expected call to ForceReportCreation
+type ForceReportCreationCall struct {
+	*Call
+}
+
+// ExpectForceReportCreation registers an expected call to ForceReportCreation. Arguments are matched
+// with Eq unless they are a Matcher.
+func (m *Client) ExpectForceReportCreation(ctx, monitorID interface{}) *ForceReportCreationCall {
+	return &ForceReportCreationCall{m.expect("ForceReportCreation", ctx, monitorID)}
+}
+
+// Return sets the values returned by the call.
+func (c *ForceReportCreationCall) Return(r0 error) *ForceReportCreationCall {
+	c.returns = []interface{}{r0}
+	return c
+}
+
+// Do sets a func called with the arguments of the call, which returns its values.
+func (c *ForceReportCreationCall) Do(fn func(ctx context.Context, monitorID string) error) *ForceReportCreationCall {
+	c.do = func(args []interface{}) []interface{} {
+		ctx, _ := args[0].(context.Context)
+		monitorID, _ := args[1].(string)
+		r0 := fn(ctx, monitorID)
+		return []interface{}{r0}
+	}
+	return c
+}
+
+// Times limits the number of calls matching the expectation.
+func (c *ForceReportCreationCall) Times(n int) *ForceReportCreationCall {
+	c.Call.Times(n)
+	return c
+}
+
+// Once limits the expectation to a single call.
+func (c *ForceReportCreationCall) Once() *ForceReportCreationCall {
+	return c.Times(1)
+}
+
+// ForceReportCreation implements onfido.OnfidoClient.
+func (m *Client) ForceReportCreation(ctx context.Context, monitorID string) error {
+	rets := m.called(1, "ForceReportCreation", ctx, monitorID)
+	r0, _ := rets[0].(error)
+	return r0
+}
+
+// PickAddressesCall is an expected call to PickAddresses
+type PickAddressesCall struct {
+	*Call
+}
+
+// ExpectPickAddresses registers an expected call to PickAddresses. Arguments are matched
+// with Eq unless they are a Matcher.
+func (m *Client) ExpectPickAddresses(postcode interface{}) *PickAddressesCall {
+	return &PickAddressesCall{m.expect("PickAddresses", postcode)}
+}
+
+// Return sets the values returned by the call.
+func (c *PickAddressesCall) Return(r0 *onfido.PickerIter) *PickAddressesCall {
+	c.returns = []interface{}{r0}
+	return c
+}
+
+// Do sets a func called with the arguments of the call, which returns its values.
+func (c *PickAddressesCall) Do(fn func(postcode string) *onfido.PickerIter) *PickAddressesCall {
+	c.do = func(args []interface{}) []interface{} {
+		postcode, _ := args[0].(string)
+		r0 := fn(postcode)
+		return []interface{}{r0}
+	}
+	return c
+}
+
+// Times limits the number of calls matching the expectation.
+func (c *PickAddressesCall) Times(n int) *PickAddressesCall {
+	c.Call.Times(n)
+	return c
+}
+
+// Once limits the expectation to a single call.
+func (c *PickAddressesCall) Once() *PickAddressesCall {
+	return c.Times(1)
+}
+
+// PickAddresses implements onfido.OnfidoClient.
+func (m *Client) PickAddresses(postcode string) *onfido.PickerIter {
+	rets := m.called(1, "PickAddresses", postcode)
+	r0, ok := rets[0].(*onfido.PickerIter)
+	if !ok {
+		r0 = onfido.NewPickerIter(nil, nil)
+	}
+	return r0
+}
+
+// GetResourceCall is an expected call to GetResource
+type GetResourceCall struct {
+	*Call
+}
+
+// ExpectGetResource registers an expected call to GetResource. Arguments are matched
+// with Eq unless they are a Matcher.
+func (m *Client) ExpectGetResource(ctx, href, v interface{}) *GetResourceCall {
+	return &GetResourceCall{m.expect("GetResource", ctx, href, v)}
+}
+
+// Return sets the values returned by the call.
+func (c *GetResourceCall) Return(r0 error) *GetResourceCall {
+	c.returns = []interface{}{r0}
+	return c
+}
+
+// Do sets a func called with the arguments of the call, which returns its values.
+func (c *GetResourceCall) Do(fn func(ctx context.Context, href string, v interface{}) error) *GetResourceCall {
+	c.do = func(args []interface{}) []interface{} {
+		ctx, _ := args[0].(context.Context)
+		href, _ := args[1].(string)
+		v := args[2]
+		r0 := fn(ctx, href, v)
+		return []interface{}{r0}
+	}
+	return c
+}
+
+// Times limits the number of calls matching the expectation.
+func (c *GetResourceCall) Times(n int) *GetResourceCall {
+	c.Call.Times(n)
+	return c
+}
+
+// Once limits the expectation to a single call.
+func (c *GetResourceCall) Once() *GetResourceCall {
+	return c.Times(1)
+}
+
+// GetResource implements onfido.OnfidoClient.
+func (m *Client) GetResource(ctx context.Context, href string, v interface{}) error {
+	rets := m.called(1, "GetResource", ctx, href, v)
+	r0, _ := rets[0].(error)
+	return r0
+}
+
+// TokenCall is an expected call to Token
+type TokenCall struct {
+	*Call
+}
+
+// ExpectToken registers an expected call to Token. Arguments are matched
+// with Eq unless they are a Matcher.
+func (m *Client) ExpectToken() *TokenCall {
+	return &TokenCall{m.expect("Token")}
+}
+
+// Return sets the values returned by the call.
+func (c *TokenCall) Return(r0 onfido.Token) *TokenCall {
+	c.returns = []interface{}{r0}
+	return c
+}
+
+// Do sets a func called with the arguments of the call, which returns its values.
+func (c *TokenCall) Do(fn func() onfido.Token) *TokenCall {
+	c.do = func(args []interface{}) []interface{} {
+		r0 := fn()
+		return []interface{}{r0}
+	}
+	return c
+}
+
+// Times limits the number of calls matching the expectation.
+func (c *TokenCall) Times(n int) *TokenCall {
+	c.Call.Times(n)
+	return c
+}
+
+// Once limits the expectation to a single call.
+func (c *TokenCall) Once() *TokenCall {
+	return c.Times(1)
+}
+
+// Token implements onfido.OnfidoClient.
+func (m *Client) Token() onfido.Token {
+	rets := m.called(1, "Token")
+	r0, _ := rets[0].(onfido.Token)
+	return r0
+}
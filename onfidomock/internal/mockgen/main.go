@@ -0,0 +1,369 @@
+// Command mockgen generates the methods of onfidomock.Client from the
+// OnfidoClient interface, see the go:generate directive in onfidomock.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"sort"
+	"strings"
+	"text/template"
+	"unicode"
+)
+
+const interfaceName = "OnfidoClient"
+
+func main() {
+	src := flag.String("src", "../onfido.go", "file declaring the OnfidoClient interface")
+	out := flag.String("out", "client_gen.go", "generated file")
+	flag.Parse()
+
+	b, err := ioutil.ReadFile(*src)
+	if err != nil {
+		log.Fatal(err)
+	}
+	code, err := generate(b)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile(*out, code, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+type param struct {
+	Name string
+	Type string
+}
+
+type result struct {
+	Type string
+	// Zero is the value returned when no value was set, empty for the type's zero value.
+	Zero string
+}
+
+type method struct {
+	Name    string
+	Params  []param
+	Results []result
+}
+
+// generate returns the generated code of the mock methods of the
+// OnfidoClient interface declared in src.
+func generate(src []byte) ([]byte, error) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "onfido.go", src, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	iface := findInterface(f)
+	if iface == nil {
+		return nil, fmt.Errorf("interface %s not found", interfaceName)
+	}
+
+	imports := map[string]bool{"github.com/mbowman100/go-onfido": true}
+	importPaths := make(map[string]string)
+	for _, spec := range f.Imports {
+		p := strings.Trim(spec.Path.Value, `"`)
+		name := p[strings.LastIndex(p, "/")+1:]
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+		importPaths[name] = p
+	}
+
+	var methods []method
+	for _, field := range iface.Methods.List {
+		fn, ok := field.Type.(*ast.FuncType)
+		if !ok || len(field.Names) == 0 {
+			return nil, fmt.Errorf("unsupported embedded interface in %s", interfaceName)
+		}
+		m := method{Name: field.Names[0].Name}
+
+		for _, p := range fn.Params.List {
+			typ, err := typeString(p.Type, importPaths, imports)
+			if err != nil {
+				return nil, err
+			}
+			if len(p.Names) == 0 {
+				m.Params = append(m.Params, param{Name: fmt.Sprintf("arg%d", len(m.Params)), Type: typ})
+			}
+			for _, n := range p.Names {
+				name := n.Name
+				if name == "m" || name == "rets" || name == "fn" || name == "args" || name == "_" {
+					name = fmt.Sprintf("arg%d", len(m.Params))
+				}
+				m.Params = append(m.Params, param{Name: name, Type: typ})
+			}
+		}
+
+		if fn.Results != nil {
+			for _, r := range fn.Results.List {
+				typ, err := typeString(r.Type, importPaths, imports)
+				if err != nil {
+					return nil, err
+				}
+				n := len(r.Names)
+				if n == 0 {
+					n = 1
+				}
+				for i := 0; i < n; i++ {
+					m.Results = append(m.Results, result{Type: typ, Zero: zeroValue(typ)})
+				}
+			}
+		}
+		methods = append(methods, m)
+	}
+
+	var std, third []string
+	for p := range imports {
+		if strings.Contains(strings.SplitN(p, "/", 2)[0], ".") {
+			third = append(third, p)
+		} else {
+			std = append(std, p)
+		}
+	}
+	sort.Strings(std)
+	sort.Strings(third)
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, struct {
+		Imports [][]string
+		Methods []method
+	}{[][]string{std, third}, methods}); err != nil {
+		return nil, err
+	}
+	return format.Source(buf.Bytes())
+}
+
+func findInterface(f *ast.File) *ast.InterfaceType {
+	for _, decl := range f.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			ts := spec.(*ast.TypeSpec)
+			if it, ok := ts.Type.(*ast.InterfaceType); ok && ts.Name.Name == interfaceName {
+				return it
+			}
+		}
+	}
+	return nil
+}
+
+// typeString returns the type as written in the onfidomock package, where the
+// types declared by onfido are qualified, and records the imports it needs.
+func typeString(expr ast.Expr, importPaths map[string]string, imports map[string]bool) (string, error) {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		if unicode.IsUpper(rune(t.Name[0])) {
+			return "onfido." + t.Name, nil
+		}
+		return t.Name, nil
+	case *ast.SelectorExpr:
+		pkg, ok := t.X.(*ast.Ident)
+		if !ok || importPaths[pkg.Name] == "" {
+			return "", fmt.Errorf("unsupported type %T", t.X)
+		}
+		imports[importPaths[pkg.Name]] = true
+		return pkg.Name + "." + t.Sel.Name, nil
+	case *ast.StarExpr:
+		s, err := typeString(t.X, importPaths, imports)
+		return "*" + s, err
+	case *ast.ArrayType:
+		if t.Len != nil {
+			return "", fmt.Errorf("unsupported array type")
+		}
+		s, err := typeString(t.Elt, importPaths, imports)
+		return "[]" + s, err
+	case *ast.Ellipsis:
+		s, err := typeString(t.Elt, importPaths, imports)
+		return "..." + s, err
+	case *ast.MapType:
+		k, err := typeString(t.Key, importPaths, imports)
+		if err != nil {
+			return "", err
+		}
+		v, err := typeString(t.Value, importPaths, imports)
+		return "map[" + k + "]" + v, err
+	case *ast.InterfaceType:
+		if len(t.Methods.List) > 0 {
+			return "", fmt.Errorf("unsupported interface literal")
+		}
+		return "interface{}", nil
+	}
+	return "", fmt.Errorf("unsupported type %T", expr)
+}
+
+// zeroValue returns the value returned by mocked iterator methods when no
+// value was set: an empty iterator from the matching onfido.New*Iter func.
+func zeroValue(typ string) string {
+	name := strings.TrimPrefix(typ, "*")
+	if !strings.HasPrefix(name, "onfido.") || !strings.HasSuffix(name, "Iter") {
+		return ""
+	}
+	return "onfido.New" + strings.TrimPrefix(name, "onfido.") + "(nil, nil)"
+}
+
+var tmpl = template.Must(template.New("mock").Funcs(template.FuncMap{
+	"params": func(ps []param) string {
+		s := make([]string, len(ps))
+		for i, p := range ps {
+			s[i] = p.Name + " " + p.Type
+		}
+		return strings.Join(s, ", ")
+	},
+	"names": func(ps []param) string {
+		s := make([]string, len(ps))
+		for i, p := range ps {
+			s[i] = p.Name
+			if strings.HasPrefix(p.Type, "...") {
+				s[i] += "..."
+			}
+		}
+		return strings.Join(s, ", ")
+	},
+	"args": func(ps []param) string {
+		s := make([]string, len(ps))
+		for i, p := range ps {
+			s[i] = p.Name
+		}
+		return strings.Join(s, ", ")
+	},
+	"matchers": func(ps []param) string {
+		s := make([]string, len(ps))
+		for i, p := range ps {
+			s[i] = p.Name
+		}
+		if len(s) == 0 {
+			return ""
+		}
+		return strings.Join(s, ", ") + " interface{}"
+	},
+	"results": func(rs []result) string {
+		s := make([]string, len(rs))
+		for i, r := range rs {
+			s[i] = r.Type
+		}
+		if len(s) > 1 {
+			return "(" + strings.Join(s, ", ") + ")"
+		}
+		return strings.Join(s, "")
+	},
+	"resultVars": func(rs []result) string {
+		s := make([]string, len(rs))
+		for i := range rs {
+			s[i] = fmt.Sprintf("r%d", i)
+		}
+		return strings.Join(s, ", ")
+	},
+	"returnParams": func(rs []result) string {
+		s := make([]string, len(rs))
+		for i, r := range rs {
+			s[i] = fmt.Sprintf("r%d %s", i, r.Type)
+		}
+		return strings.Join(s, ", ")
+	},
+	"argType": func(typ string) string {
+		// Variadic arguments are recorded as slices.
+		if strings.HasPrefix(typ, "...") {
+			return "[]" + typ[3:]
+		}
+		return typ
+	},
+}).Parse(`// Code generated by mockgen from the OnfidoClient interface; DO NOT EDIT.
+
+package onfidomock
+
+import (
+{{- range $i, $group := .Imports}}
+{{- if $i}}
+{{end}}
+{{- range $group}}
+	"{{.}}"
+{{- end}}
+{{- end}}
+)
+
+var _ onfido.OnfidoClient = &Client{}
+{{range $m := .Methods}}
+// {{.Name}}Call is an expected call to {{.Name}}
+type {{.Name}}Call struct {
+	*Call
+}
+
+// Expect{{.Name}} registers an expected call to {{.Name}}. Arguments are matched
+// with Eq unless they are a Matcher.
+func (m *Client) Expect{{.Name}}({{matchers .Params}}) *{{.Name}}Call {
+	return &{{.Name}}Call{m.expect("{{.Name}}"{{range .Params}}, {{.Name}}{{end}})}
+}
+{{if .Results}}
+// Return sets the values returned by the call.
+func (c *{{.Name}}Call) Return({{returnParams .Results}}) *{{.Name}}Call {
+	c.returns = []interface{}{ {{- resultVars .Results -}} }
+	return c
+}
+{{end}}
+// Do sets a func called with the arguments of the call{{if .Results}}, which returns its values{{end}}.
+func (c *{{.Name}}Call) Do(fn func({{params .Params}}) {{results .Results}}) *{{.Name}}Call {
+	c.do = func(args []interface{}) []interface{} {
+		{{- range $i, $p := .Params}}
+		{{- if eq (argType $p.Type) "interface{}"}}
+		{{$p.Name}} := args[{{$i}}]
+		{{- else}}
+		{{$p.Name}}, _ := args[{{$i}}].({{argType $p.Type}})
+		{{- end}}
+		{{- end}}
+		{{- if .Results}}
+		{{resultVars .Results}} := fn({{names .Params}})
+		return []interface{}{ {{- resultVars .Results -}} }
+		{{- else}}
+		fn({{names .Params}})
+		return nil
+		{{- end}}
+	}
+	return c
+}
+
+// Times limits the number of calls matching the expectation.
+func (c *{{.Name}}Call) Times(n int) *{{.Name}}Call {
+	c.Call.Times(n)
+	return c
+}
+
+// Once limits the expectation to a single call.
+func (c *{{.Name}}Call) Once() *{{.Name}}Call {
+	return c.Times(1)
+}
+
+// {{.Name}} implements onfido.OnfidoClient.
+func (m *Client) {{.Name}}({{params .Params}}) {{results .Results}} {
+	{{- if .Results}}
+	rets := m.called({{len .Results}}, "{{.Name}}"{{range .Params}}, {{.Name}}{{end}})
+	{{- range $i, $r := .Results}}
+	{{- if eq $r.Type "interface{}"}}
+	r{{$i}} := rets[{{$i}}]
+	{{- else if $r.Zero}}
+	r{{$i}}, ok := rets[{{$i}}].({{$r.Type}})
+	if !ok {
+		r{{$i}} = {{$r.Zero}}
+	}
+	{{- else}}
+	r{{$i}}, _ := rets[{{$i}}].({{$r.Type}})
+	{{- end}}
+	{{- end}}
+	return {{resultVars .Results}}
+	{{- else}}
+	m.called(0, "{{.Name}}"{{range .Params}}, {{.Name}}{{end}})
+	{{- end}}
+}
+{{end}}`))
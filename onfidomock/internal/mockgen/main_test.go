@@ -0,0 +1,34 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"testing"
+)
+
+// TestGenerate_UpToDate fails when the OnfidoClient interface changed
+// without running go generate in onfidomock.
+func TestGenerate_UpToDate(t *testing.T) {
+	src, err := ioutil.ReadFile("../../../onfido.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := generate(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := ioutil.ReadFile("../../client_gen.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(want, got) {
+		t.Fatal("onfidomock/client_gen.go is out of date, run go generate ./onfidomock")
+	}
+}
+
+func TestGenerate_UnknownInterface(t *testing.T) {
+	_, err := generate([]byte("package onfido\n\ntype Other interface{}\n"))
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+}
@@ -0,0 +1,237 @@
+// Package onfidomock provides a programmable mock of onfido.OnfidoClient.
+//
+// Expectations are registered per method with arguments matched by value
+// or by a Matcher, and every call is recorded:
+//
+//	m := onfidomock.New(t)
+//	m.ExpectGetApplicant(onfidomock.Any(), "applicant-id").
+//		Return(&onfido.Applicant{ID: "applicant-id"}, nil).
+//		Once()
+//	m.ExpectListChecks("applicant-id").
+//		Return(onfido.NewCheckIter([][]*onfido.Check{{chk1, chk2}, {chk3}}, nil))
+//
+//	// ... exercise the code using m as its onfido.OnfidoClient
+//
+//	m.AssertExpectations(t)
+//
+// The methods of Client are generated from the OnfidoClient interface with
+// go generate, so the mock always implements the whole interface.
+package onfidomock
+
+//go:generate go run ./internal/mockgen -src ../onfido.go -out client_gen.go
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// TestingT is the subset of testing.TB used by the mock
+type TestingT interface {
+	Helper()
+	Errorf(format string, args ...interface{})
+}
+
+// Client is a mock onfido.OnfidoClient.
+// Calls which don't match any expectation are reported to the TestingT
+// and return zero values (iterator methods return empty iterators).
+type Client struct {
+	t TestingT
+
+	mu       sync.Mutex
+	expected []*Call
+	calls    []Invocation
+}
+
+// New creates a mock client reporting unexpected calls to t.
+func New(t TestingT) *Client {
+	return &Client{t: t}
+}
+
+// Invocation is a recorded call to the mock
+type Invocation struct {
+	Method string
+	Args   []interface{}
+}
+
+// Call is an expected call to the mock. The typed *XCall values returned by the
+// ExpectX methods embed it and set the values returned by the call.
+type Call struct {
+	method  string
+	args    []Matcher
+	returns []interface{}
+	do      func(args []interface{}) []interface{}
+	times   int
+	count   int
+}
+
+// Times limits the number of calls matching the expectation,
+// by default it matches any number of calls.
+func (c *Call) Times(n int) *Call {
+	c.times = n
+	return c
+}
+
+// Once limits the expectation to a single call.
+func (c *Call) Once() *Call {
+	return c.Times(1)
+}
+
+func (c *Call) String() string {
+	args := make([]string, len(c.args))
+	for i, a := range c.args {
+		args[i] = a.String()
+	}
+	return c.method + "(" + strings.Join(args, ", ") + ")"
+}
+
+func (c *Call) matches(method string, args []interface{}) bool {
+	if c.method != method || len(c.args) != len(args) {
+		return false
+	}
+	if c.times > 0 && c.count >= c.times {
+		return false
+	}
+	for i, m := range c.args {
+		if !m.Matches(args[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *Client) expect(method string, args ...interface{}) *Call {
+	c := &Call{method: method, args: make([]Matcher, len(args))}
+	for i, a := range args {
+		if matcher, ok := a.(Matcher); ok {
+			c.args[i] = matcher
+		} else {
+			c.args[i] = Eq(a)
+		}
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.expected = append(m.expected, c)
+	return c
+}
+
+// called records the call and returns the n values set by the first matching expectation.
+func (m *Client) called(n int, method string, args ...interface{}) []interface{} {
+	m.mu.Lock()
+	m.calls = append(m.calls, Invocation{Method: method, Args: args})
+	var match *Call
+	for _, c := range m.expected {
+		if c.matches(method, args) {
+			match = c
+			match.count++
+			break
+		}
+	}
+	m.mu.Unlock()
+
+	rets := make([]interface{}, n)
+	if match == nil {
+		if m.t != nil {
+			m.t.Helper()
+			m.t.Errorf("onfidomock: unexpected call to %s%s", method, formatArgs(args))
+		}
+		return rets
+	}
+	if match.do != nil {
+		copy(rets, match.do(args))
+	} else {
+		copy(rets, match.returns)
+	}
+	return rets
+}
+
+// Calls returns the calls made to the mock, in order.
+func (m *Client) Calls() []Invocation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Invocation(nil), m.calls...)
+}
+
+// CallsTo returns the calls made to the given method, in order.
+func (m *Client) CallsTo(method string) []Invocation {
+	var calls []Invocation
+	for _, c := range m.Calls() {
+		if c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+// AssertExpectations reports expectations which weren't called, or weren't
+// called the number of times set with Times, and returns whether all were met.
+func (m *Client) AssertExpectations(t TestingT) bool {
+	t.Helper()
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	ok := true
+	for _, c := range m.expected {
+		switch {
+		case c.times == 0 && c.count == 0:
+			t.Errorf("onfidomock: expected call to %s wasn't made", c)
+			ok = false
+		case c.times > 0 && c.count != c.times:
+			t.Errorf("onfidomock: expected %d calls to %s, got %d", c.times, c, c.count)
+			ok = false
+		}
+	}
+	return ok
+}
+
+func formatArgs(args []interface{}) string {
+	s := make([]string, len(args))
+	for i, a := range args {
+		s[i] = fmt.Sprintf("%#v", a)
+	}
+	return "(" + strings.Join(s, ", ") + ")"
+}
+
+// Matcher matches the argument of an expected call
+type Matcher interface {
+	Matches(arg interface{}) bool
+	String() string
+}
+
+type anyMatcher struct{}
+
+func (anyMatcher) Matches(interface{}) bool { return true }
+func (anyMatcher) String() string           { return "Any()" }
+
+// Any matches any argument, typically the context.
+func Any() Matcher {
+	return anyMatcher{}
+}
+
+type eqMatcher struct {
+	v interface{}
+}
+
+func (m eqMatcher) Matches(arg interface{}) bool { return reflect.DeepEqual(m.v, arg) }
+func (m eqMatcher) String() string               { return fmt.Sprintf("%#v", m.v) }
+
+// Eq matches arguments deeply equal to v. Arguments which aren't Matchers use Eq.
+func Eq(v interface{}) Matcher {
+	return eqMatcher{v}
+}
+
+type funcMatcher struct {
+	desc string
+	fn   func(interface{}) bool
+}
+
+func (m funcMatcher) Matches(arg interface{}) bool { return m.fn(arg) }
+func (m funcMatcher) String() string               { return m.desc }
+
+// Match matches the arguments for which fn returns true, desc describes
+// the matcher in failure messages.
+func Match(desc string, fn func(arg interface{}) bool) Matcher {
+	return funcMatcher{desc, fn}
+}
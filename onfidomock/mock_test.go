@@ -0,0 +1,96 @@
+package onfidomock
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/mbowman100/go-onfido"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeT records the errors reported by the mock.
+type fakeT struct {
+	errors []string
+}
+
+func (t *fakeT) Helper() {}
+
+func (t *fakeT) Errorf(format string, args ...interface{}) {
+	t.errors = append(t.errors, fmt.Sprintf(format, args...))
+}
+
+func TestClient_Return(t *testing.T) {
+	m := New(t)
+	ctx := context.Background()
+	m.ExpectGetApplicant(Any(), "app-1").Return(&onfido.Applicant{ID: "app-1"}, nil).Once()
+	errNotFound := errors.New("not found")
+	m.ExpectGetApplicant(Any(), Any()).Return(nil, errNotFound)
+
+	a, err := m.GetApplicant(ctx, "app-1")
+	assert.NoError(t, err)
+	assert.Equal(t, "app-1", a.ID)
+
+	a, err = m.GetApplicant(ctx, "app-1")
+	assert.Nil(t, a)
+	assert.Equal(t, errNotFound, err)
+
+	assert.Len(t, m.CallsTo("GetApplicant"), 2)
+	assert.Equal(t, []interface{}{ctx, "app-1"}, m.Calls()[0].Args)
+	m.AssertExpectations(t)
+}
+
+func TestClient_Do(t *testing.T) {
+	m := New(t)
+	m.ExpectCreateCheck(Any(), Match("has reports", func(arg interface{}) bool {
+		return len(arg.(onfido.CheckRequest).ReportNames) > 0
+	})).Do(func(ctx context.Context, cr onfido.CheckRequest) (*onfido.Check, error) {
+		return &onfido.Check{ApplicantID: cr.ApplicantID}, nil
+	})
+
+	chk, err := m.CreateCheck(context.Background(), onfido.CheckRequest{
+		ApplicantID: "app-1",
+		ReportNames: []onfido.ReportName{onfido.ReportNameDocument},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "app-1", chk.ApplicantID)
+}
+
+func TestClient_Iterators(t *testing.T) {
+	m := New(t)
+	m.ExpectListChecks("app-1").Return(onfido.NewCheckIter([][]*onfido.Check{
+		{{ID: "1"}, {ID: "2"}},
+		{{ID: "3"}},
+	}, nil))
+	ft := &fakeT{}
+	unexpected := New(ft)
+
+	var ids []string
+	it := m.ListChecks("app-1")
+	for it.Next(context.Background()) {
+		ids = append(ids, it.Check().ID)
+	}
+	assert.NoError(t, it.Err())
+	assert.Equal(t, []string{"1", "2", "3"}, ids)
+
+	// Iterators default to empty rather than nil.
+	assert.False(t, unexpected.ListApplicants().Next(context.Background()))
+	assert.False(t, unexpected.ListLiveVideos("app-1").Next(context.Background()))
+	assert.Len(t, ft.errors, 2)
+}
+
+func TestClient_UnexpectedCall(t *testing.T) {
+	ft := &fakeT{}
+	m := New(ft)
+	m.ExpectDeleteApplicant(Any(), "app-1").Once()
+
+	assert.NoError(t, m.DeleteApplicant(context.Background(), "app-2"))
+	if assert.Len(t, ft.errors, 1) {
+		assert.Contains(t, ft.errors[0], `unexpected call to DeleteApplicant`)
+		assert.Contains(t, ft.errors[0], `"app-2"`)
+	}
+
+	assert.False(t, m.AssertExpectations(ft))
+	assert.Contains(t, ft.errors[1], `expected 1 calls to DeleteApplicant(Any(), "app-1"), got 0`)
+}
@@ -0,0 +1,160 @@
+package onfidotest
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/mbowman100/go-onfido"
+)
+
+func validateApplicant(a *onfido.Applicant) fieldErrors {
+	fe := make(fieldErrors)
+	if a.FirstName == "" {
+		fe.add("first_name", "can't be blank")
+	}
+	if a.LastName == "" {
+		fe.add("last_name", "can't be blank")
+	}
+	return fe
+}
+
+func (s *Server) createApplicant(w http.ResponseWriter, r *http.Request) {
+	var a onfido.Applicant
+	if err := decodeJSON(r, &a); err != nil {
+		writeBadRequest(w, err)
+		return
+	}
+	if fe := validateApplicant(&a); len(fe) > 0 {
+		writeValidationError(w, fe)
+		return
+	}
+
+	s.mu.Lock()
+	a.ID = newID()
+	a.CreatedAt = s.timestamp()
+	a.Sandbox = true
+	s.applicants = append(s.applicants, &a)
+	resp := a
+	s.mu.Unlock()
+
+	writeJSON(w, http.StatusCreated, resp)
+}
+
+func (s *Server) findApplicant(id string) *onfido.Applicant {
+	for _, a := range s.applicants {
+		if a.ID == id {
+			return a
+		}
+	}
+	return nil
+}
+
+func (s *Server) getApplicant(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	a := s.findApplicant(mux.Vars(r)["id"])
+	var resp onfido.Applicant
+	if a != nil {
+		resp = *a
+	}
+	s.mu.Unlock()
+
+	if a == nil {
+		writeNotFound(w)
+		return
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func (s *Server) updateApplicant(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	a := s.findApplicant(mux.Vars(r)["id"])
+	if a == nil {
+		writeNotFound(w)
+		return
+	}
+
+	// Fields which aren't sent are kept.
+	update := *a
+	if err := decodeJSON(r, &update); err != nil {
+		writeBadRequest(w, err)
+		return
+	}
+	update.ID, update.CreatedAt, update.Sandbox = a.ID, a.CreatedAt, a.Sandbox
+	if fe := validateApplicant(&update); len(fe) > 0 {
+		writeValidationError(w, fe)
+		return
+	}
+	*a = update
+	writeJSON(w, http.StatusOK, update)
+}
+
+func (s *Server) deleteApplicant(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	id := mux.Vars(r)["id"]
+	for i, a := range s.applicants {
+		if a.ID == id {
+			s.applicants = append(s.applicants[:i], s.applicants[i+1:]...)
+			s.deleted[id] = a
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+	}
+	writeNotFound(w)
+}
+
+func (s *Server) restoreApplicant(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	id := mux.Vars(r)["id"]
+	a, ok := s.deleted[id]
+	if !ok {
+		writeNotFound(w)
+		return
+	}
+	delete(s.deleted, id)
+	s.applicants = append(s.applicants, a)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) listApplicants(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	items := make([]interface{}, 0, len(s.applicants))
+	// Onfido lists the newest applicants first.
+	for i := len(s.applicants) - 1; i >= 0; i-- {
+		items = append(items, *s.applicants[i])
+	}
+	s.mu.Unlock()
+
+	writePage(w, r, "applicants", items)
+}
+
+// createSdkToken returns an opaque token for an existing applicant, it isn't a valid JWT.
+func (s *Server) createSdkToken(w http.ResponseWriter, r *http.Request) {
+	var t onfido.SdkToken
+	if err := decodeJSON(r, &t); err != nil {
+		writeBadRequest(w, err)
+		return
+	}
+	fe := make(fieldErrors)
+	if t.ApplicantID == "" {
+		fe.add("applicant_id", "can't be blank")
+	}
+	if t.Referrer == "" && t.ApplicationID == "" {
+		fe.add("referrer", "either referrer or application_id must be provided")
+	}
+	if len(fe) > 0 {
+		writeValidationError(w, fe)
+		return
+	}
+
+	s.mu.Lock()
+	a := s.findApplicant(t.ApplicantID)
+	s.mu.Unlock()
+	if a == nil {
+		writeNotFound(w)
+		return
+	}
+	writeJSON(w, http.StatusOK, onfido.SdkToken{Token: "onfidotest." + newID()})
+}
@@ -0,0 +1,390 @@
+package onfidotest
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/mbowman100/go-onfido"
+)
+
+// RecorderMode is the mode of a Recorder
+type RecorderMode int
+
+// Supported recorder modes
+const (
+	// ModeReplay serves responses from the cassette and never touches the network.
+	ModeReplay RecorderMode = iota
+	// ModeRecord sends requests to the API and records the interactions.
+	ModeRecord
+)
+
+// ErrInteractionNotFound means that a replayed request isn't in the cassette
+var ErrInteractionNotFound = errors.New("onfidotest: no recorded interaction matches the request")
+
+// DefaultScrubFields are the JSON fields whose values are scrubbed from
+// recorded responses: the applicants' personal data and tokens.
+var DefaultScrubFields = []string{
+	"first_name", "last_name", "middle_name", "email", "dob", "phone_number",
+	"flat_number", "building_number", "building_name", "street", "sub_street", "town", "postcode",
+	"value", "token",
+}
+
+// Scrubbed replaces the values of scrubbed fields.
+const Scrubbed = "SCRUBBED"
+
+// recordedHeaders are the response headers kept in cassettes.
+var recordedHeaders = []string{"Content-Type", "Content-Disposition", "Link", "X-Total-Count"}
+
+// Cassette is a recording of HTTP interactions with the Onfido API
+type Cassette struct {
+	Interactions []*Interaction `json:"interactions"`
+}
+
+// Interaction is a recorded request and its response
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest is the part of a request used to match replayed requests.
+// Headers, including the Authorization token, aren't recorded.
+type RecordedRequest struct {
+	Method string `json:"method"`
+	Path   string `json:"path"`
+	Query  string `json:"query,omitempty"`
+	Body   Body   `json:"body,omitempty"`
+}
+
+// RecordedResponse is a recorded response
+type RecordedResponse struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       Body        `json:"body,omitempty"`
+}
+
+// Body is a recorded body, stored as text unless it's binary.
+type Body []byte
+
+// MarshalJSON encodes text bodies as strings and binary ones as {"base64": "..."}.
+func (b Body) MarshalJSON() ([]byte, error) {
+	if utf8.Valid(b) {
+		return json.Marshal(string(b))
+	}
+	return json.Marshal(map[string]string{"base64": base64.StdEncoding.EncodeToString(b)})
+}
+
+// UnmarshalJSON decodes bodies encoded by MarshalJSON.
+func (b *Body) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		*b = Body(s)
+		return nil
+	}
+	var enc struct {
+		Base64 string `json:"base64"`
+	}
+	if err := json.Unmarshal(data, &enc); err != nil {
+		return err
+	}
+	decoded, err := base64.StdEncoding.DecodeString(enc.Base64)
+	*b = decoded
+	return err
+}
+
+// Recorder is an onfido.HTTPRequester which records interactions with the API into
+// a cassette, or replays them from it. It's used with OnfidoClient.SetHTTPClient
+// or onfido.WithHTTPClient.
+//
+// Replayed requests are matched on their method, path, query and body, JSON
+// bodies are compared regardless of formatting and multipart boundaries are
+// ignored. Each recorded interaction is replayed once, in order, so repeated
+// requests get the responses they got when recording.
+//
+// Recorded responses have the values of scrubbed fields (DefaultScrubFields by
+// default) replaced, except values which were sent in the recorded requests,
+// so tests should send made up personal data.
+type Recorder struct {
+	mode  RecorderMode
+	path  string
+	next  onfido.HTTPRequester
+	scrub map[string]bool
+
+	mu       sync.Mutex
+	cassette Cassette
+	used     []bool
+	sent     map[string]bool
+}
+
+var _ onfido.HTTPRequester = &Recorder{}
+
+// RecorderOption configures a Recorder
+type RecorderOption func(*Recorder)
+
+// WithScrubFields sets the JSON fields scrubbed from recorded responses.
+func WithScrubFields(fields ...string) RecorderOption {
+	return func(r *Recorder) {
+		r.scrub = make(map[string]bool, len(fields))
+		for _, f := range fields {
+			r.scrub[f] = true
+		}
+	}
+}
+
+// WithTransport sets the HTTP client used to send requests when recording,
+// http.DefaultClient by default.
+func WithTransport(next onfido.HTTPRequester) RecorderOption {
+	return func(r *Recorder) {
+		r.next = next
+	}
+}
+
+// NewRecorder creates a recorder of the cassette at path. In replay mode the
+// cassette must exist. In record mode it's written by Save.
+func NewRecorder(path string, mode RecorderMode, opts ...RecorderOption) (*Recorder, error) {
+	r := &Recorder{
+		mode: mode,
+		path: path,
+		next: http.DefaultClient,
+		sent: make(map[string]bool),
+	}
+	WithScrubFields(DefaultScrubFields...)(r)
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	if mode == ModeReplay {
+		b, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(b, &r.cassette); err != nil {
+			return nil, fmt.Errorf("invalid cassette %s: %w", path, err)
+		}
+		r.used = make([]bool, len(r.cassette.Interactions))
+	}
+	return r, nil
+}
+
+// Do records or replays the request.
+func (r *Recorder) Do(req *http.Request) (*http.Response, error) {
+	rr, err := recordRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	if r.mode == ModeReplay {
+		return r.replay(req, rr)
+	}
+	return r.record(req, rr)
+}
+
+func (r *Recorder) replay(req *http.Request, rr RecordedRequest) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, in := range r.cassette.Interactions {
+		if r.used[i] || !in.Request.matches(rr) {
+			continue
+		}
+		r.used[i] = true
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", in.Response.StatusCode, http.StatusText(in.Response.StatusCode)),
+			StatusCode:    in.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        in.Response.Header.Clone(),
+			Body:          ioutil.NopCloser(bytes.NewReader(in.Response.Body)),
+			ContentLength: int64(len(in.Response.Body)),
+			Request:       req,
+		}, nil
+	}
+	return nil, fmt.Errorf("%w: %s %s", ErrInteractionNotFound, rr.Method, req.URL.RequestURI())
+}
+
+func (r *Recorder) record(req *http.Request, rr RecordedRequest) (*http.Response, error) {
+	resp, err := r.next.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	header := make(http.Header)
+	for _, h := range recordedHeaders {
+		if v := resp.Header.Values(h); len(v) > 0 {
+			header[h] = v
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.collectSent(rr.Body)
+	r.cassette.Interactions = append(r.cassette.Interactions, &Interaction{
+		Request: rr,
+		Response: RecordedResponse{
+			StatusCode: resp.StatusCode,
+			Header:     header,
+			Body:       r.scrubBody(body),
+		},
+	})
+	return resp, nil
+}
+
+// Save writes the recorded cassette to its path, creating its directory.
+// It does nothing in replay mode.
+func (r *Recorder) Save() error {
+	if r.mode != ModeRecord {
+		return nil
+	}
+	r.mu.Lock()
+	b, err := json.MarshalIndent(r.cassette, "", "  ")
+	r.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(r.path), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(r.path, append(b, '\n'), 0644)
+}
+
+// Unused returns the interactions which haven't been replayed.
+func (r *Recorder) Unused() []*Interaction {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.mode != ModeReplay {
+		return nil
+	}
+	var unused []*Interaction
+	for i, in := range r.cassette.Interactions {
+		if !r.used[i] {
+			unused = append(unused, in)
+		}
+	}
+	return unused
+}
+
+// recordRequest reads the request body, restoring it for the request to be sent.
+func recordRequest(req *http.Request) (RecordedRequest, error) {
+	rr := RecordedRequest{
+		Method: req.Method,
+		Path:   req.URL.Path,
+		Query:  normalizeQuery(req.URL.RawQuery),
+	}
+	if req.Body == nil || req.Body == http.NoBody {
+		return rr, nil
+	}
+	body, err := ioutil.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return rr, err
+	}
+	req.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	// Multipart boundaries are random, use a fixed one so that bodies can be matched.
+	if mt, params, err := mime.ParseMediaType(req.Header.Get("Content-Type")); err == nil &&
+		strings.HasPrefix(mt, "multipart/") && params["boundary"] != "" {
+		body = bytes.ReplaceAll(body, []byte(params["boundary"]), []byte("onfidotest-boundary"))
+	}
+	rr.Body = body
+	return rr, nil
+}
+
+func normalizeQuery(raw string) string {
+	q, err := url.ParseQuery(raw)
+	if err != nil {
+		return raw
+	}
+	return q.Encode()
+}
+
+func (rr RecordedRequest) matches(other RecordedRequest) bool {
+	return rr.Method == other.Method &&
+		rr.Path == other.Path &&
+		rr.Query == other.Query &&
+		sameBody(rr.Body, other.Body)
+}
+
+func sameBody(a, b []byte) bool {
+	if bytes.Equal(a, b) {
+		return true
+	}
+	var va, vb interface{}
+	if json.Unmarshal(a, &va) != nil || json.Unmarshal(b, &vb) != nil {
+		return false
+	}
+	na, _ := json.Marshal(va)
+	nb, _ := json.Marshal(vb)
+	return bytes.Equal(na, nb)
+}
+
+// collectSent records the values of scrubbed fields sent in a request body,
+// which aren't scrubbed from responses.
+func (r *Recorder) collectSent(body []byte) {
+	var v interface{}
+	if json.Unmarshal(body, &v) != nil {
+		return
+	}
+	walkJSON(v, func(key string, value interface{}) interface{} {
+		if s, ok := value.(string); ok && r.scrub[key] {
+			r.sent[s] = true
+		}
+		return value
+	})
+}
+
+// scrubBody replaces the values of scrubbed fields of a JSON body.
+func (r *Recorder) scrubBody(body []byte) []byte {
+	var v interface{}
+	if len(r.scrub) == 0 || json.Unmarshal(body, &v) != nil {
+		return body
+	}
+	v = walkJSON(v, func(key string, value interface{}) interface{} {
+		if !r.scrub[key] {
+			return value
+		}
+		if s, ok := value.(string); ok && (s == "" || r.sent[s]) {
+			return value
+		}
+		if value == nil {
+			return nil
+		}
+		return Scrubbed
+	})
+	b, err := json.Marshal(v)
+	if err != nil {
+		return body
+	}
+	return b
+}
+
+// walkJSON calls fn with every object field of v, replacing the field's value
+// by the returned one.
+func walkJSON(v interface{}, fn func(key string, value interface{}) interface{}) interface{} {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		for k, val := range t {
+			t[k] = walkJSON(fn(k, val), fn)
+		}
+	case []interface{}:
+		for i, val := range t {
+			t[i] = walkJSON(val, fn)
+		}
+	}
+	return v
+}
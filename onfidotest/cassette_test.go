@@ -0,0 +1,104 @@
+package onfidotest
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/mbowman100/go-onfido"
+	"github.com/stretchr/testify/assert"
+)
+
+// cassetteFlow creates an applicant, uploads a document and lists the applicants.
+func cassetteFlow(t *testing.T, c onfido.OnfidoClient) (*onfido.Applicant, *onfido.Document, []*onfido.Applicant) {
+	ctx := context.Background()
+	a, err := c.CreateApplicant(ctx, onfido.Applicant{FirstName: "Jane", LastName: "Doe", Email: "jane@example.com"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	doc, err := c.UploadDocument(ctx, onfido.DocumentRequest{
+		ApplicantID: a.ID,
+		File:        testFile(),
+		Type:        onfido.DocumentTypePassport,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var applicants []*onfido.Applicant
+	it := c.ListApplicants()
+	for it.Next(ctx) {
+		applicants = append(applicants, it.Applicant())
+	}
+	if err := it.Err(); err != nil {
+		t.Fatal(err)
+	}
+	return a, doc, applicants
+}
+
+func TestRecorder_RecordReplay(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cassette")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "testdata", "flow.json")
+
+	srv := NewServer()
+	// Someone else's applicant, whose personal data must not be recorded.
+	if _, err := srv.Client().CreateApplicant(context.Background(), onfido.Applicant{FirstName: "Real", LastName: "Person"}); err != nil {
+		t.Fatal(err)
+	}
+	rec, err := NewRecorder(path, ModeRecord, WithTransport(srv.Server.Client()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	recorded, recordedDoc, _ := cassetteFlow(t, srv.Client(onfido.WithHTTPClient(rec)))
+	assert.NoError(t, rec.Save())
+	endpoint := srv.Endpoint()
+	srv.Close()
+
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.NotContains(t, string(b), "Real")
+	assert.NotContains(t, string(b), Token)
+	assert.Contains(t, string(b), "jane@example.com", "values sent by the test are kept")
+
+	replay, err := NewRecorder(path, ModeReplay)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := onfido.NewClient("api_sandbox.replay", onfido.WithEndpoint(endpoint), onfido.WithHTTPClient(replay))
+	a, doc, applicants := cassetteFlow(t, c)
+	assert.Equal(t, recorded, a)
+	assert.Equal(t, recordedDoc, doc)
+	if assert.Len(t, applicants, 2) {
+		assert.Equal(t, "Doe", applicants[0].LastName)
+		assert.Equal(t, Scrubbed, applicants[1].FirstName)
+	}
+	assert.Empty(t, replay.Unused())
+
+	_, err = c.GetApplicant(context.Background(), a.ID)
+	assert.True(t, errors.Is(err, ErrInteractionNotFound), "got %v", err)
+}
+
+func TestRecorder_ReplayMissingCassette(t *testing.T) {
+	_, err := NewRecorder(filepath.Join("testdata", "missing.json"), ModeReplay)
+	assert.True(t, os.IsNotExist(err), "got %v", err)
+}
+
+func TestBody_JSON(t *testing.T) {
+	for _, b := range []Body{Body(`{"id":"1"}`), Body{0xff, 0xd8, 0xff}} {
+		data, err := b.MarshalJSON()
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got Body
+		assert.NoError(t, got.UnmarshalJSON(data))
+		assert.Equal(t, b, got)
+	}
+}
@@ -0,0 +1,284 @@
+package onfidotest
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/mbowman100/go-onfido"
+)
+
+// Report statuses used by the fake
+const (
+	reportStatusAwaitingData = "awaiting_data"
+	reportStatusComplete     = "complete"
+	reportStatusPaused       = "paused"
+	reportStatusCancelled    = "cancelled"
+)
+
+type report struct {
+	onfido.Report
+	outcome Outcome
+}
+
+// knownReports are the report names accepted by the fake.
+var knownReports = map[onfido.ReportName]bool{
+	onfido.ReportNameDocument:                       true,
+	onfido.ReportNameDocumentWithAddress:            true,
+	onfido.ReportNameDocumentWithDrivingLicense:     true,
+	onfido.ReportNameFacialSimilarityPhoto:          true,
+	onfido.ReportNameFacialSimilarityPhotoFullyAuto: true,
+	onfido.ReportNameFacialSimilarityVideo:          true,
+	onfido.ReportNameFacialSimilarityMotion:         true,
+	onfido.ReportNameKnownFaces:                     true,
+	onfido.ReportNameIdentityEnhanced:               true,
+	onfido.ReportNameWatchlistEnhanced:              true,
+	onfido.ReportNameWatchlistStandard:              true,
+	onfido.ReportNameWatchlistPepsOnly:              true,
+	onfido.ReportNameWatchlistSanctionsOnly:         true,
+	onfido.ReportNameWatchlistAML:                   true,
+	onfido.ReportNameProofOfAddress:                 true,
+	onfido.ReportNameRightToWork:                    true,
+}
+
+// isDocumentReport reports whether the report is run against the applicant's documents.
+func isDocumentReport(name onfido.ReportName) bool {
+	switch name {
+	case onfido.ReportNameDocument,
+		onfido.ReportNameDocumentWithAddress,
+		onfido.ReportNameDocumentWithDrivingLicense:
+		return true
+	}
+	return false
+}
+
+func (s *Server) createCheck(w http.ResponseWriter, r *http.Request) {
+	var cr onfido.CheckRequest
+	if err := decodeJSON(r, &cr); err != nil {
+		writeBadRequest(w, err)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	fe := make(fieldErrors)
+	applicant := s.findApplicant(cr.ApplicantID)
+	if cr.ApplicantID == "" {
+		fe.add("applicant_id", "can't be blank")
+	} else if applicant == nil {
+		fe.add("applicant_id", "applicant not found")
+	}
+	if len(cr.ReportNames) == 0 {
+		fe.add("report_names", "can't be blank")
+	}
+	needsDocument := false
+	for _, name := range cr.ReportNames {
+		if !knownReports[name] {
+			fe.add("report_names", "contains unknown report "+string(name))
+		}
+		needsDocument = needsDocument || isDocumentReport(name)
+	}
+	for _, id := range cr.DocumentIDs {
+		if d := s.findDocument(id); d == nil || d.ApplicantID != cr.ApplicantID {
+			fe.add("document_ids", "document "+id+" not found")
+		}
+	}
+	documentIDs := cr.DocumentIDs
+	if needsDocument && len(documentIDs) == 0 && !cr.ApplicantProvidesData {
+		// Onfido defaults to the most recently uploaded document.
+		for i := len(s.documents) - 1; i >= 0; i-- {
+			if s.documents[i].ApplicantID == cr.ApplicantID {
+				documentIDs = []string{s.documents[i].ID}
+				break
+			}
+		}
+		if len(documentIDs) == 0 {
+			fe.add("document_ids", "a document report requires an uploaded document")
+		}
+	}
+	if len(fe) > 0 {
+		writeValidationError(w, fe)
+		return
+	}
+
+	id := newID()
+	chk := &onfido.CheckRetrieved{
+		ID:                             id,
+		CreatedAt:                      s.timestamp(),
+		Href:                           s.href("checks", id),
+		RedirectURI:                    cr.RedirectURI,
+		ResultsURI:                     "https://dashboard.onfido.com/checks/" + id,
+		Tags:                           cr.Tags,
+		ApplicantID:                    cr.ApplicantID,
+		ApplicantProvidesData:          cr.ApplicantProvidesData,
+		WebhookIDs:                     cr.WebhookIDs,
+		PrivacyNoticesReadConsentGiven: cr.PrivacyNoticesReadConsentGiven,
+		Sandbox:                        true,
+		Version:                        APIVersion[1:],
+	}
+	if cr.ApplicantProvidesData {
+		chk.FormURI = "https://onfido.com/information/" + id
+	}
+
+	for _, name := range cr.ReportNames {
+		rep := &report{
+			Report: onfido.Report{
+				ID:        newID(),
+				Name:      name,
+				CreatedAt: chk.CreatedAt,
+				CheckID:   id,
+			},
+			outcome: s.outcome(applicant, cr.Consider, name),
+		}
+		rep.Href = s.href("reports", rep.ID)
+		if isDocumentReport(name) {
+			for _, docID := range documentIDs {
+				rep.Documents = append(rep.Documents, onfido.DocumentProcessed{"id": docID})
+			}
+		}
+		s.reports = append(s.reports, rep)
+		chk.Reports = append(chk.Reports, rep.ID)
+	}
+	s.checks = append(s.checks, chk)
+	s.startCheck(chk)
+
+	writeJSON(w, http.StatusCreated, chk)
+}
+
+func (s *Server) findCheck(id string) *onfido.CheckRetrieved {
+	for _, c := range s.checks {
+		if c.ID == id {
+			return c
+		}
+	}
+	return nil
+}
+
+func (s *Server) getCheck(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	c := s.findCheck(mux.Vars(r)["id"])
+	if c == nil {
+		writeNotFound(w)
+		return
+	}
+	writeJSON(w, http.StatusOK, c)
+}
+
+// resumeCheck resumes a paused check.
+func (s *Server) resumeCheck(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	c := s.findCheck(mux.Vars(r)["id"])
+	if c == nil {
+		writeNotFound(w)
+		return
+	}
+	if !c.Paused {
+		writeError(w, http.StatusUnprocessableEntity, "validation_error", "Check is not paused", nil)
+		return
+	}
+	c.Paused = false
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) listChecks(w http.ResponseWriter, r *http.Request) {
+	applicantID := r.URL.Query().Get("applicant_id")
+
+	s.mu.Lock()
+	if s.findApplicant(applicantID) == nil {
+		s.mu.Unlock()
+		writeNotFound(w)
+		return
+	}
+	var items []interface{}
+	for i := len(s.checks) - 1; i >= 0; i-- {
+		if c := s.checks[i]; c.ApplicantID == applicantID {
+			items = append(items, *c)
+		}
+	}
+	s.mu.Unlock()
+
+	writePage(w, r, "checks", items)
+}
+
+func (s *Server) checkReports(checkID string) []*report {
+	var reports []*report
+	for _, rep := range s.reports {
+		if rep.CheckID == checkID {
+			reports = append(reports, rep)
+		}
+	}
+	return reports
+}
+
+func (s *Server) findReport(id string) *report {
+	for _, rep := range s.reports {
+		if rep.ID == id {
+			return rep
+		}
+	}
+	return nil
+}
+
+func (s *Server) getReport(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	rep := s.findReport(mux.Vars(r)["id"])
+	if rep == nil {
+		writeNotFound(w)
+		return
+	}
+	writeJSON(w, http.StatusOK, rep.Report)
+}
+
+func (s *Server) resumeReport(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	rep := s.findReport(mux.Vars(r)["id"])
+	if rep == nil {
+		writeNotFound(w)
+		return
+	}
+	if rep.Status != reportStatusPaused {
+		writeError(w, http.StatusUnprocessableEntity, "validation_error", "Report is not paused", nil)
+		return
+	}
+	rep.Status = reportStatusComplete
+	rep.Result, rep.SubResult = rep.outcome.Result, rep.outcome.SubResult
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) cancelReport(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	rep := s.findReport(mux.Vars(r)["id"])
+	if rep == nil {
+		writeNotFound(w)
+		return
+	}
+	if rep.Status == reportStatusComplete || rep.Status == reportStatusCancelled {
+		writeError(w, http.StatusUnprocessableEntity, "validation_error", "Report cannot be cancelled", nil)
+		return
+	}
+	rep.Status = reportStatusCancelled
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) listReports(w http.ResponseWriter, r *http.Request) {
+	checkID := r.URL.Query().Get("check_id")
+
+	s.mu.Lock()
+	if s.findCheck(checkID) == nil {
+		s.mu.Unlock()
+		writeNotFound(w)
+		return
+	}
+	var items []interface{}
+	for _, rep := range s.checkReports(checkID) {
+		items = append(items, rep.Report)
+	}
+	s.mu.Unlock()
+
+	writePage(w, r, "reports", items)
+}
@@ -0,0 +1,173 @@
+package onfidotest
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/mbowman100/go-onfido"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServer_CheckFlow(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	ctx := context.Background()
+	c := srv.Client()
+
+	a, err := c.CreateApplicant(ctx, onfido.Applicant{FirstName: "Jane", LastName: "Doe"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	doc, err := c.UploadDocument(ctx, onfido.DocumentRequest{
+		ApplicantID: a.ID,
+		File:        bytes.NewReader([]byte("passport image")),
+		Type:        onfido.DocumentTypePassport,
+		Side:        onfido.DocumentSideFront,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, a.ID, doc.ApplicantID)
+	assert.Equal(t, len("passport image"), doc.FileSize)
+
+	dl, err := c.DownloadDocument(ctx, doc.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []byte("passport image"), dl.Data)
+
+	cr, err := onfido.NewCheckBuilder(a.ID).
+		Reports(onfido.ReportNameDocument, onfido.ReportNameWatchlistStandard).
+		Tags("test").
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.CreateCheck(ctx, cr); err != nil {
+		t.Fatal(err)
+	}
+
+	checks := c.ListChecks(a.ID)
+	if !checks.Next(ctx) {
+		t.Fatalf("expected a check, got %v", checks.Err())
+	}
+	chk, err := c.GetCheckExpanded(ctx, checks.Check().ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, onfido.CheckStatusComplete, chk.Status)
+	assert.Equal(t, onfido.CheckResultClear, chk.Result)
+	assert.Equal(t, []string{"test"}, chk.Tags)
+	if assert.Len(t, chk.Reports, 2) {
+		assert.Equal(t, onfido.ReportNameDocument, chk.Reports[0].Name)
+		assert.Equal(t, onfido.ReportResultClear, chk.Reports[0].Result)
+		assert.Equal(t, doc.ID, chk.Reports[0].Documents[0]["id"])
+		assert.Equal(t, onfido.ReportNameWatchlistStandard, chk.Reports[1].Name)
+	}
+
+	var fetched onfido.Report
+	if err := c.GetResource(ctx, chk.Reports[1].Href, &fetched); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, chk.Reports[1].ID, fetched.ID)
+}
+
+func testFile() *bytes.Reader {
+	return bytes.NewReader([]byte("passport image"))
+}
+
+func TestServer_CreateCheck_DocumentRequired(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	ctx := context.Background()
+	c := srv.Client()
+	a, err := c.CreateApplicant(ctx, onfido.Applicant{FirstName: "Jane", LastName: "Doe"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = c.CreateCheck(ctx, onfido.CheckRequest{
+		ApplicantID: a.ID,
+		ReportNames: []onfido.ReportName{onfido.ReportNameDocument},
+	})
+
+	var onfidoErr *onfido.Error
+	if !errors.As(err, &onfidoErr) {
+		t.Fatalf("expected an *onfido.Error, got %v", err)
+	}
+	assert.Equal(t, http.StatusUnprocessableEntity, onfidoErr.Resp.StatusCode)
+	assert.Contains(t, onfidoErr.Err.Fields, "document_ids")
+}
+
+func TestServer_ResumeCheck(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	ctx := context.Background()
+	c := srv.Client()
+	a, err := c.CreateApplicant(ctx, onfido.Applicant{FirstName: "Jane", LastName: "Doe"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	chk, err := c.CreateCheck(ctx, onfido.CheckRequest{
+		ApplicantID: a.ID,
+		ReportNames: []onfido.ReportName{onfido.ReportNameWatchlistStandard},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = c.ResumeCheck(ctx, chk.ID)
+	assert.Error(t, err, "only paused checks can be resumed")
+
+	srv.mu.Lock()
+	srv.findCheck(chk.ID).Paused = true
+	srv.mu.Unlock()
+	resumed, err := c.ResumeCheck(ctx, chk.ID)
+	if assert.NoError(t, err) {
+		assert.False(t, resumed.Paused)
+		assert.Len(t, resumed.Reports, 1)
+	}
+}
+
+func TestServer_Webhooks(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	ctx := context.Background()
+	c := srv.Client()
+
+	created, err := c.CreateWebhook(ctx, onfido.WebhookRefRequest{
+		URL:     "https://example.com/webhook",
+		Enabled: true,
+		Events:  []onfido.WebhookEvent{onfido.WebhookEventCheckCompleted},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.NotEmpty(t, created.Token)
+
+	updated, err := c.UpdateWebhook(ctx, created.ID, onfido.WebhookRefRequest{URL: "https://example.com/other"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "https://example.com/other", updated.URL)
+	assert.False(t, updated.Enabled)
+
+	it := c.ListWebhooks()
+	assert.True(t, it.Next(ctx))
+	assert.Equal(t, created.ID, it.WebhookRef().ID)
+
+	if err := c.DeleteWebhook(ctx, created.ID); err != nil {
+		t.Fatal(err)
+	}
+	it = c.ListWebhooks()
+	assert.False(t, it.Next(ctx))
+	assert.NoError(t, it.Err())
+}
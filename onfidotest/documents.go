@@ -0,0 +1,149 @@
+package onfidotest
+
+import (
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/mbowman100/go-onfido"
+)
+
+// maxUploadSize is the largest file accepted by uploads, like Onfido's 10MB limit.
+const maxUploadSize = 10 << 20
+
+type document struct {
+	onfido.Document
+	contentType string
+	data        []byte
+}
+
+func (s *Server) uploadDocument(w http.ResponseWriter, r *http.Request) {
+	r.Body = http.MaxBytesReader(w, r.Body, maxUploadSize+1<<20)
+	if err := r.ParseMultipartForm(maxUploadSize); err != nil {
+		writeBadRequest(w, err)
+		return
+	}
+
+	fe := make(fieldErrors)
+	applicantID := r.FormValue("applicant_id")
+	if applicantID == "" {
+		fe.add("applicant_id", "can't be blank")
+	}
+	docType := onfido.DocumentType(r.FormValue("type"))
+	if docType == "" {
+		fe.add("type", "can't be blank")
+	}
+	side := onfido.DocumentSide(r.FormValue("side"))
+	if side != "" && side != onfido.DocumentSideFront && side != onfido.DocumentSideBack {
+		fe.add("side", "is not included in the list")
+	}
+	file, header, err := r.FormFile("file")
+	var data []byte
+	if err != nil {
+		fe.add("file", "can't be blank")
+	} else {
+		defer file.Close()
+		if data, err = ioutil.ReadAll(file); err != nil {
+			writeBadRequest(w, err)
+			return
+		}
+		if len(data) == 0 {
+			fe.add("file", "can't be empty")
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if applicantID != "" && s.findApplicant(applicantID) == nil {
+		fe.add("applicant_id", "applicant not found")
+	}
+	if len(fe) > 0 {
+		writeValidationError(w, fe)
+		return
+	}
+
+	contentType := header.Header.Get("Content-Type")
+	fileType := strings.TrimPrefix(path.Ext(header.Filename), ".")
+	if fileType == "" {
+		if exts, _ := mime.ExtensionsByType(contentType); len(exts) > 0 {
+			fileType = strings.TrimPrefix(exts[0], ".")
+		}
+	}
+
+	id := newID()
+	d := &document{
+		Document: onfido.Document{
+			ID:           id,
+			CreatedAt:    s.timestamp(),
+			Href:         s.href("documents", id),
+			DownloadHref: s.href("documents", id, "download"),
+			FileName:     header.Filename,
+			FileType:     fileType,
+			FileSize:     len(data),
+			Type:         docType,
+			Side:         side,
+			ApplicantID:  applicantID,
+		},
+		contentType: contentType,
+		data:        data,
+	}
+	s.documents = append(s.documents, d)
+	writeJSON(w, http.StatusCreated, d.Document)
+}
+
+func (s *Server) findDocument(id string) *document {
+	for _, d := range s.documents {
+		if d.ID == id {
+			return d
+		}
+	}
+	return nil
+}
+
+func (s *Server) getDocument(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	d := s.findDocument(mux.Vars(r)["id"])
+	if d == nil {
+		writeNotFound(w)
+		return
+	}
+	writeJSON(w, http.StatusOK, d.Document)
+}
+
+func (s *Server) downloadDocument(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	d := s.findDocument(mux.Vars(r)["id"])
+	if d == nil {
+		writeNotFound(w)
+		return
+	}
+	if d.contentType != "" {
+		w.Header().Set("Content-Type", d.contentType)
+	}
+	_, _ = w.Write(d.data)
+}
+
+func (s *Server) listDocuments(w http.ResponseWriter, r *http.Request) {
+	applicantID := r.URL.Query().Get("applicant_id")
+
+	s.mu.Lock()
+	if s.findApplicant(applicantID) == nil {
+		s.mu.Unlock()
+		writeNotFound(w)
+		return
+	}
+	var items []interface{}
+	for i := len(s.documents) - 1; i >= 0; i-- {
+		if d := s.documents[i]; d.ApplicantID == applicantID {
+			items = append(items, d.Document)
+		}
+	}
+	s.mu.Unlock()
+
+	writePage(w, r, "documents", items)
+}
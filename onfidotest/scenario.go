@@ -0,0 +1,143 @@
+package onfidotest
+
+import (
+	"time"
+
+	"github.com/mbowman100/go-onfido"
+)
+
+// Outcome is the scripted result of a report
+type Outcome struct {
+	Result onfido.ReportResult
+	// SubResult only applies to document reports. When empty it's derived from
+	// the result: clear for clear, suspected otherwise.
+	SubResult onfido.ReportSubResult
+}
+
+// Scenario scripts the outcome of the checks of applicants with a given last name,
+// like the special applicant names of Onfido's sandbox.
+type Scenario struct {
+	// Reports sets the outcome of individual reports.
+	Reports map[onfido.ReportName]Outcome
+	// Default is the outcome of the reports which aren't in Reports, clear when empty.
+	Default Outcome
+}
+
+// DefaultScenarios are the scenarios every Server starts with: reports of
+// applicants with the last name "Consider" return consider.
+var DefaultScenarios = map[string]Scenario{
+	"Consider": {Default: Outcome{Result: onfido.ReportResultConsider}},
+}
+
+// WithScenario scripts the outcome of the checks of applicants with the given last name.
+func WithScenario(lastName string, sc Scenario) Option {
+	return func(s *Server) {
+		s.scenarios[lastName] = sc
+	}
+}
+
+// WithCheckDelay makes checks progress asynchronously: they are created
+// in_progress and complete after the delay, or when CompletePendingChecks is called.
+// A negative delay leaves checks in progress until CompletePendingChecks is called.
+func WithCheckDelay(delay time.Duration) Option {
+	return func(s *Server) {
+		s.checkDelay = delay
+	}
+}
+
+// outcome returns the outcome of the report of a check created for the applicant.
+// Reports listed in the check's Consider field return consider, like in the sandbox.
+func (s *Server) outcome(a *onfido.Applicant, consider []onfido.ReportName, name onfido.ReportName) Outcome {
+	var o Outcome
+	if sc, ok := s.scenarios[a.LastName]; ok {
+		o = sc.Default
+		if ro, ok := sc.Reports[name]; ok {
+			o = ro
+		}
+	}
+	for _, c := range consider {
+		if c == name && o.Result != onfido.ReportResultConsider {
+			o = Outcome{Result: onfido.ReportResultConsider}
+		}
+	}
+
+	if o.Result == "" {
+		o.Result = onfido.ReportResultClear
+	}
+	if !isDocumentReport(name) {
+		o.SubResult = ""
+	} else if o.SubResult == "" {
+		o.SubResult = onfido.ReportSubResultSuspected
+		if o.Result == onfido.ReportResultClear {
+			o.SubResult = onfido.ReportSubResultClear
+		}
+	}
+	return o
+}
+
+// startCheck completes the check, or schedules its completion when checks are asynchronous.
+// It must be called with s.mu held.
+func (s *Server) startCheck(chk *onfido.CheckRetrieved) {
+	if s.checkDelay == 0 {
+		s.completeCheck(chk)
+		return
+	}
+
+	chk.Status = onfido.CheckStatusInProgress
+	chk.Result = ""
+	for _, rep := range s.checkReports(chk.ID) {
+		rep.Status = reportStatusAwaitingData
+		rep.Result, rep.SubResult = "", ""
+	}
+	s.emit(chk, webhookEvent{onfido.WebhookResourceTypeCheck, onfido.WebhookEventCheckStarted, chk.ID, string(chk.Status), chk.Href})
+
+	if s.checkDelay > 0 {
+		id := chk.ID
+		s.timers[id] = time.AfterFunc(s.checkDelay, func() {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			if _, ok := s.timers[id]; !ok {
+				return
+			}
+			delete(s.timers, id)
+			if c := s.findCheck(id); c != nil && c.Status == onfido.CheckStatusInProgress {
+				s.completeCheck(c)
+			}
+		})
+	}
+}
+
+// completeCheck sets the scripted outcome of the check's reports and completes it.
+// It must be called with s.mu held.
+func (s *Server) completeCheck(chk *onfido.CheckRetrieved) {
+	chk.Status = onfido.CheckStatusComplete
+	chk.Result = onfido.CheckResultClear
+	for _, rep := range s.checkReports(chk.ID) {
+		if rep.Status != reportStatusCancelled {
+			rep.Status = reportStatusComplete
+			rep.Result, rep.SubResult = rep.outcome.Result, rep.outcome.SubResult
+			s.emit(chk, webhookEvent{onfido.WebhookResourceTypeReport, onfido.WebhookEventReportCompleted, rep.ID, rep.Status, rep.Href})
+		}
+		if rep.Result == onfido.ReportResultConsider {
+			chk.Result = onfido.CheckResultConsider
+		}
+	}
+	s.emit(chk, webhookEvent{onfido.WebhookResourceTypeCheck, onfido.WebhookEventCheckCompleted, chk.ID, string(chk.Status), chk.Href})
+}
+
+// CompletePendingChecks completes the checks which are still in progress,
+// so that tests of asynchronous checks don't have to wait for the delay.
+func (s *Server) CompletePendingChecks() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, chk := range s.checks {
+		if chk.Status != onfido.CheckStatusInProgress {
+			continue
+		}
+		if t, ok := s.timers[chk.ID]; ok {
+			t.Stop()
+			delete(s.timers, chk.ID)
+		}
+		s.completeCheck(chk)
+	}
+}
@@ -0,0 +1,130 @@
+package onfidotest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/mbowman100/go-onfido"
+	"github.com/stretchr/testify/assert"
+)
+
+func createCheck(t *testing.T, c onfido.OnfidoClient, lastName string, b func(*onfido.CheckBuilder)) *onfido.Check {
+	ctx := context.Background()
+	a, err := c.CreateApplicant(ctx, onfido.Applicant{FirstName: "Jane", LastName: lastName})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cb := onfido.NewCheckBuilder(a.ID)
+	b(cb)
+	cr, err := cb.Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	chk, err := c.CreateCheck(ctx, cr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expanded, err := c.GetCheckExpanded(ctx, chk.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return expanded
+}
+
+func TestServer_Consider(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	chk := createCheck(t, srv.Client(), "Doe", func(b *onfido.CheckBuilder) {
+		b.Reports(onfido.ReportNameIdentityEnhanced, onfido.ReportNameWatchlistStandard).
+			Consider(onfido.ReportNameWatchlistStandard)
+	})
+
+	assert.Equal(t, onfido.CheckResultConsider, chk.Result)
+	assert.Equal(t, onfido.ReportResultClear, chk.Reports[0].Result)
+	assert.Equal(t, onfido.ReportResultConsider, chk.Reports[1].Result)
+}
+
+func TestServer_Scenario(t *testing.T) {
+	srv := NewServer(WithScenario("Rejected", Scenario{
+		Reports: map[onfido.ReportName]Outcome{
+			onfido.ReportNameWatchlistStandard: {Result: onfido.ReportResultConsider},
+		},
+		Default: Outcome{Result: onfido.ReportResultConsider, SubResult: onfido.ReportSubResultRejected},
+	}))
+	defer srv.Close()
+
+	ctx := context.Background()
+	c := srv.Client()
+
+	chk := createCheck(t, c, "Consider", func(b *onfido.CheckBuilder) {
+		b.Reports(onfido.ReportNameIdentityEnhanced)
+	})
+	assert.Equal(t, onfido.CheckResultConsider, chk.Result, "default Consider scenario")
+
+	a, err := c.CreateApplicant(ctx, onfido.Applicant{FirstName: "Jane", LastName: "Rejected"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.UploadDocument(ctx, onfido.DocumentRequest{
+		ApplicantID: a.ID,
+		File:        testFile(),
+		Type:        onfido.DocumentTypePassport,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	created, err := c.CreateCheck(ctx, onfido.CheckRequest{
+		ApplicantID: a.ID,
+		ReportNames: []onfido.ReportName{onfido.ReportNameDocument, onfido.ReportNameWatchlistStandard},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	chk, err = c.GetCheckExpanded(ctx, created.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, onfido.ReportSubResultRejected, chk.Reports[0].SubResult)
+	assert.Equal(t, onfido.ReportResultConsider, chk.Reports[1].Result)
+	assert.Empty(t, chk.Reports[1].SubResult)
+}
+
+func TestServer_CheckDelay(t *testing.T) {
+	srv := NewServer(WithCheckDelay(-1))
+	defer srv.Close()
+
+	c := srv.Client()
+	chk := createCheck(t, c, "Doe", func(b *onfido.CheckBuilder) {
+		b.Reports(onfido.ReportNameIdentityEnhanced)
+	})
+	assert.Equal(t, onfido.CheckStatusInProgress, chk.Status)
+	assert.Empty(t, chk.Result)
+	assert.Equal(t, reportStatusAwaitingData, chk.Reports[0].Status)
+
+	srv.CompletePendingChecks()
+
+	chk, err := c.GetCheckExpanded(context.Background(), chk.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, onfido.CheckStatusComplete, chk.Status)
+	assert.Equal(t, onfido.CheckResultClear, chk.Result)
+	assert.Equal(t, reportStatusComplete, chk.Reports[0].Status)
+}
+
+func TestServer_CheckDelay_Timer(t *testing.T) {
+	srv := NewServer(WithCheckDelay(10 * time.Millisecond))
+	defer srv.Close()
+
+	c := srv.Client()
+	chk := createCheck(t, c, "Doe", func(b *onfido.CheckBuilder) {
+		b.Reports(onfido.ReportNameIdentityEnhanced)
+	})
+	assert.Equal(t, onfido.CheckStatusInProgress, chk.Status)
+
+	assert.Eventually(t, func() bool {
+		got, err := c.GetCheck(context.Background(), chk.ID)
+		return err == nil && got.Status == onfido.CheckStatusComplete
+	}, time.Second, 5*time.Millisecond)
+}
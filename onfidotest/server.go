@@ -0,0 +1,296 @@
+// Package onfidotest provides an in-memory fake of the Onfido API for tests.
+//
+// The fake keeps applicants, documents, checks, reports and webhooks in memory,
+// paginates lists with Link headers and returns validation errors in Onfido's
+// error format, so flows such as create applicant → upload document → create
+// check can be tested offline with the real client:
+//
+//	srv := onfidotest.NewServer()
+//	defer srv.Close()
+//
+//	c := srv.Client()
+//	a, err := c.CreateApplicant(ctx, onfido.Applicant{FirstName: "Jane", LastName: "Doe"})
+//
+// Like the sandbox, checks honour CheckRequest.Consider, and the outcome of
+// reports can be scripted per applicant last name with WithScenario. Checks can
+// progress asynchronously (WithCheckDelay) and signed webhook events can be
+// delivered to the registered webhooks (WithWebhookDelivery).
+package onfidotest
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/mbowman100/go-onfido"
+)
+
+// Constants
+const (
+	// APIVersion is the version prefix of the fake's routes and hrefs.
+	APIVersion = "v3.5"
+	// Token is the sandbox token used by Server.Client.
+	Token = "api_sandbox.onfidotest"
+	// DefaultPageSize is the number of items per page when per_page isn't set.
+	DefaultPageSize = 20
+)
+
+// Server is an in-memory fake of the Onfido API.
+// Its state is changed through the API, so tests use Client to set it up.
+type Server struct {
+	*httptest.Server
+
+	mu         sync.Mutex
+	now        func() time.Time
+	closed     bool
+	applicants []*onfido.Applicant
+	// deleted are the applicants scheduled for deletion, which can be restored.
+	deleted   map[string]*onfido.Applicant
+	documents []*document
+	checks    []*onfido.CheckRetrieved
+	reports   []*report
+	webhooks  []*onfido.WebhookRef
+
+	checkDelay time.Duration
+	timers     map[string]*time.Timer
+	scenarios  map[string]Scenario
+
+	delivery *webhookDelivery
+}
+
+// Option configures a Server
+type Option func(*Server)
+
+// NewServer starts a new fake Onfido API server. The caller must Close it.
+func NewServer(opts ...Option) *Server {
+	s := &Server{
+		now:       time.Now,
+		deleted:   make(map[string]*onfido.Applicant),
+		timers:    make(map[string]*time.Timer),
+		scenarios: make(map[string]Scenario),
+	}
+	for name, sc := range DefaultScenarios {
+		s.scenarios[name] = sc
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	s.Server = httptest.NewServer(s.routes())
+	return s
+}
+
+// Close stops pending check progressions, waits for queued webhooks
+// to be delivered and shuts down the server.
+func (s *Server) Close() {
+	s.mu.Lock()
+	s.closed = true
+	for id, t := range s.timers {
+		t.Stop()
+		delete(s.timers, id)
+	}
+	s.mu.Unlock()
+
+	if s.delivery != nil {
+		s.delivery.close()
+	}
+	s.Server.Close()
+}
+
+// Endpoint returns the API endpoint of the server, including the version prefix.
+func (s *Server) Endpoint() string {
+	return s.URL + "/" + APIVersion
+}
+
+// Client returns an Onfido client pointed at the server.
+// The options are applied after the endpoint and HTTP client are set.
+func (s *Server) Client(opts ...onfido.ClientOption) onfido.OnfidoClient {
+	opts = append([]onfido.ClientOption{
+		onfido.WithEndpoint(s.Endpoint()),
+		onfido.WithHTTPClient(s.Server.Client()),
+	}, opts...)
+	return onfido.NewClient(Token, opts...)
+}
+
+func (s *Server) routes() http.Handler {
+	m := mux.NewRouter()
+	r := m.PathPrefix("/" + APIVersion).Subrouter()
+	r.Use(authenticate)
+
+	r.HandleFunc("/applicants", s.createApplicant).Methods(http.MethodPost)
+	r.HandleFunc("/applicants", s.listApplicants).Methods(http.MethodGet)
+	r.HandleFunc("/applicants/{id}", s.getApplicant).Methods(http.MethodGet)
+	r.HandleFunc("/applicants/{id}", s.updateApplicant).Methods(http.MethodPut)
+	r.HandleFunc("/applicants/{id}", s.deleteApplicant).Methods(http.MethodDelete)
+	r.HandleFunc("/applicants/{id}/restore", s.restoreApplicant).Methods(http.MethodPost)
+	r.HandleFunc("/sdk_token", s.createSdkToken).Methods(http.MethodPost)
+
+	r.HandleFunc("/documents", s.uploadDocument).Methods(http.MethodPost)
+	r.HandleFunc("/documents", s.listDocuments).Methods(http.MethodGet)
+	r.HandleFunc("/documents/{id}", s.getDocument).Methods(http.MethodGet)
+	r.HandleFunc("/documents/{id}/download", s.downloadDocument).Methods(http.MethodGet)
+
+	r.HandleFunc("/checks", s.createCheck).Methods(http.MethodPost)
+	r.HandleFunc("/checks", s.listChecks).Methods(http.MethodGet)
+	r.HandleFunc("/checks/{id}", s.getCheck).Methods(http.MethodGet)
+	r.HandleFunc("/checks/{id}/resume", s.resumeCheck).Methods(http.MethodPost)
+
+	r.HandleFunc("/reports", s.listReports).Methods(http.MethodGet)
+	r.HandleFunc("/reports/{id}", s.getReport).Methods(http.MethodGet)
+	r.HandleFunc("/reports/{id}/resume", s.resumeReport).Methods(http.MethodPost)
+	r.HandleFunc("/reports/{id}/cancel", s.cancelReport).Methods(http.MethodPost)
+
+	r.HandleFunc("/webhooks", s.createWebhook).Methods(http.MethodPost)
+	r.HandleFunc("/webhooks", s.listWebhooks).Methods(http.MethodGet)
+	r.HandleFunc("/webhooks/", s.listWebhooks).Methods(http.MethodGet)
+	r.HandleFunc("/webhooks/{id}", s.getWebhook).Methods(http.MethodGet)
+	r.HandleFunc("/webhooks/{id}", s.updateWebhook).Methods(http.MethodPut)
+	r.HandleFunc("/webhooks/{id}", s.deleteWebhook).Methods(http.MethodDelete)
+
+	m.NotFoundHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, http.StatusNotFound, "resource_not_found", "The requested resource was not found", nil)
+	})
+	return m
+}
+
+// authenticate rejects requests without an Authorization token, any token is accepted.
+func authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth := r.Header.Get("Authorization")
+		if token := strings.TrimPrefix(auth, "Token token="); token == auth || token == "" {
+			writeError(w, http.StatusUnauthorized, "authorization_error", "Please provide a valid API token", nil)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (s *Server) href(parts ...string) string {
+	return "/" + APIVersion + "/" + strings.Join(parts, "/")
+}
+
+func (s *Server) timestamp() *time.Time {
+	t := s.now().UTC().Truncate(time.Second)
+	return &t
+}
+
+// newID returns a random UUID (version 4).
+func newID() string {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		panic(err)
+	}
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	h := hex.EncodeToString(b[:])
+	return h[:8] + "-" + h[8:12] + "-" + h[12:16] + "-" + h[16:20] + "-" + h[20:]
+}
+
+// fieldErrors collects validation errors by field, as returned by Onfido.
+type fieldErrors map[string][]string
+
+func (fe fieldErrors) add(field, msg string) {
+	fe[field] = append(fe[field], msg)
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// writeError writes an error in Onfido's format.
+// see https://documentation.onfido.com/#error-object
+func writeError(w http.ResponseWriter, status int, typ, msg string, fields fieldErrors) {
+	body := map[string]interface{}{
+		"id":      newID(),
+		"type":    typ,
+		"message": msg,
+	}
+	if len(fields) > 0 {
+		body["fields"] = fields
+	}
+	writeJSON(w, status, map[string]interface{}{"error": body})
+}
+
+func writeNotFound(w http.ResponseWriter) {
+	writeError(w, http.StatusNotFound, "resource_not_found", "The requested resource was not found", nil)
+}
+
+func writeValidationError(w http.ResponseWriter, fields fieldErrors) {
+	writeError(w, http.StatusUnprocessableEntity, "validation_error", "There was a validation error on this request", fields)
+}
+
+func writeBadRequest(w http.ResponseWriter, err error) {
+	writeError(w, http.StatusBadRequest, "bad_request", "Invalid request: "+err.Error(), nil)
+}
+
+// writePage writes the page of items requested by the page and per_page query
+// parameters under key, with Link and X-Total-Count headers like Onfido.
+func writePage(w http.ResponseWriter, r *http.Request, key string, items []interface{}) {
+	q := r.URL.Query()
+	pageNum, err := strconv.Atoi(q.Get("page"))
+	if err != nil || pageNum < 1 {
+		pageNum = 1
+	}
+	perPage, err := strconv.Atoi(q.Get("per_page"))
+	if err != nil || perPage < 1 {
+		perPage = DefaultPageSize
+	}
+
+	last := (len(items) + perPage - 1) / perPage
+	if last < 1 {
+		last = 1
+	}
+	start := (pageNum - 1) * perPage
+	if start > len(items) {
+		start = len(items)
+	}
+	end := start + perPage
+	if end > len(items) {
+		end = len(items)
+	}
+
+	pageURL := func(p int) string {
+		u := url.URL{Scheme: "http", Host: r.Host, Path: r.URL.Path}
+		v := r.URL.Query()
+		v.Set("page", strconv.Itoa(p))
+		v.Set("per_page", strconv.Itoa(perPage))
+		u.RawQuery = v.Encode()
+		return u.String()
+	}
+	var links []string
+	if pageNum > 1 {
+		links = append(links, fmt.Sprintf(`<%s>; rel="first"`, pageURL(1)))
+		links = append(links, fmt.Sprintf(`<%s>; rel="prev"`, pageURL(pageNum-1)))
+	}
+	if pageNum < last {
+		links = append(links, fmt.Sprintf(`<%s>; rel="next"`, pageURL(pageNum+1)))
+		links = append(links, fmt.Sprintf(`<%s>; rel="last"`, pageURL(last)))
+	}
+	if len(links) > 0 {
+		w.Header().Set("Link", strings.Join(links, ", "))
+	}
+	w.Header().Set("X-Total-Count", strconv.Itoa(len(items)))
+
+	page := items[start:end]
+	if page == nil {
+		page = []interface{}{}
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{key: page})
+}
+
+func decodeJSON(r *http.Request, v interface{}) error {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		return fmt.Errorf("malformed JSON body: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,160 @@
+package onfidotest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/mbowman100/go-onfido"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServer_Unauthorized(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	resp, err := http.Get(srv.Endpoint() + "/applicants")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+}
+
+func TestServer_NotFound(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	_, err := srv.Client().GetApplicant(context.Background(), "unknown")
+
+	var onfidoErr *onfido.Error
+	if !errors.As(err, &onfidoErr) {
+		t.Fatalf("expected an *onfido.Error, got %v", err)
+	}
+	assert.Equal(t, http.StatusNotFound, onfidoErr.Resp.StatusCode)
+	assert.Equal(t, "resource_not_found", onfidoErr.Err.Type)
+}
+
+func TestServer_ValidationError(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	_, err := srv.Client().CreateApplicant(context.Background(), onfido.Applicant{FirstName: "Jane"})
+
+	var onfidoErr *onfido.Error
+	if !errors.As(err, &onfidoErr) {
+		t.Fatalf("expected an *onfido.Error, got %v", err)
+	}
+	assert.Equal(t, http.StatusUnprocessableEntity, onfidoErr.Resp.StatusCode)
+	assert.Equal(t, "validation_error", onfidoErr.Err.Type)
+	assert.Equal(t, []interface{}{"can't be blank"}, onfidoErr.Err.Fields["last_name"])
+	assert.NotContains(t, onfidoErr.Err.Fields, "first_name")
+}
+
+func TestServer_Pagination(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	ctx := context.Background()
+	c := srv.Client()
+	n := DefaultPageSize*2 + 5
+	for i := 0; i < n; i++ {
+		_, err := c.CreateApplicant(ctx, onfido.Applicant{FirstName: "Jane", LastName: fmt.Sprint(i)})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var names []string
+	it := c.ListApplicants()
+	for it.Next(ctx) {
+		names = append(names, it.Applicant().LastName)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatal(err)
+	}
+	assert.Len(t, names, n)
+	assert.Equal(t, fmt.Sprint(n-1), names[0], "newest applicant should be listed first")
+
+	resp, err := srv.Server.Client().Do(authorized(t, srv.Endpoint()+"/applicants?page=2&per_page=10"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	assert.Equal(t, fmt.Sprint(n), resp.Header.Get("X-Total-Count"))
+	assert.Contains(t, resp.Header.Get("Link"), `page=3&per_page=10>; rel="next"`)
+	assert.Contains(t, resp.Header.Get("Link"), `page=1&per_page=10>; rel="prev"`)
+}
+
+func authorized(t *testing.T, url string) *http.Request {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Authorization", "Token token="+Token)
+	return req
+}
+
+func TestServer_RestoreApplicant(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	ctx := context.Background()
+	c := srv.Client()
+
+	a, err := c.CreateApplicant(ctx, onfido.Applicant{FirstName: "Jane", LastName: "Doe"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Error(t, c.RestoreApplicant(ctx, a.ID), "only deleted applicants can be restored")
+
+	assert.NoError(t, c.DeleteApplicant(ctx, a.ID))
+	_, err = c.GetApplicant(ctx, a.ID)
+	assert.Error(t, err)
+
+	assert.NoError(t, c.RestoreApplicant(ctx, a.ID))
+	restored, err := c.GetApplicant(ctx, a.ID)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "Jane", restored.FirstName)
+	}
+}
+
+func TestServer_UpdateApplicant(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	ctx := context.Background()
+	c := srv.Client()
+
+	a, err := c.CreateApplicant(ctx, onfido.Applicant{FirstName: "Jane", LastName: "Doe", Email: "jane@example.com"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	updated, err := c.UpdateApplicant(ctx, onfido.Applicant{ID: a.ID, LastName: "Smith"})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "Smith", updated.LastName)
+		assert.Equal(t, "Jane", updated.FirstName, "fields which aren't sent are kept")
+		assert.Equal(t, "jane@example.com", updated.Email)
+	}
+}
+
+func TestServer_SdkToken(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	ctx := context.Background()
+	c := srv.Client()
+
+	a, err := c.CreateApplicant(ctx, onfido.Applicant{FirstName: "Jane", LastName: "Doe"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tok, err := c.NewSdkTokenWeb(ctx, a.ID, "https://*.example.com/*")
+	if assert.NoError(t, err) {
+		assert.NotEmpty(t, tok.Token)
+		assert.Equal(t, a.ID, tok.ApplicantID)
+	}
+
+	_, err = c.NewSdkTokenMobile(ctx, "unknown", "com.example.app")
+	assert.Error(t, err)
+}
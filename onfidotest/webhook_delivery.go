@@ -0,0 +1,224 @@
+package onfidotest
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/mbowman100/go-onfido"
+)
+
+// Delivery records the delivery of a webhook event to a registered webhook
+type Delivery struct {
+	WebhookID  string
+	Action     onfido.WebhookEvent
+	ObjectID   string
+	StatusCode int
+	Err        error
+}
+
+// WithWebhookDelivery makes the server deliver signed webhook events
+// (check.started, report.completed and check.completed) to the registered webhooks
+// which are enabled for the sandbox environment and subscribed to the event,
+// using httpClient or http.DefaultClient if nil.
+// Events are delivered in order, in the background.
+func WithWebhookDelivery(httpClient *http.Client) Option {
+	return func(s *Server) {
+		if httpClient == nil {
+			httpClient = http.DefaultClient
+		}
+		s.delivery = newWebhookDelivery(httpClient)
+	}
+}
+
+// Deliveries returns the webhook deliveries attempted so far.
+func (s *Server) Deliveries() []Delivery {
+	if s.delivery == nil {
+		return nil
+	}
+	s.delivery.mu.Lock()
+	defer s.delivery.mu.Unlock()
+	return append([]Delivery(nil), s.delivery.log...)
+}
+
+type webhookEvent struct {
+	resourceType string
+	action       onfido.WebhookEvent
+	id           string
+	status       string
+	href         string
+}
+
+type webhookMessage struct {
+	ref   onfido.WebhookRef
+	event onfido.WebhookEvent
+	id    string
+	body  []byte
+}
+
+type webhookDelivery struct {
+	client *http.Client
+	// wake signals run that messages were queued or that the delivery is closed.
+	wake chan struct{}
+	done chan struct{}
+
+	mu     sync.Mutex
+	queue  []webhookMessage
+	closed bool
+	log    []Delivery
+}
+
+func newWebhookDelivery(client *http.Client) *webhookDelivery {
+	d := &webhookDelivery{
+		client: client,
+		wake:   make(chan struct{}, 1),
+		done:   make(chan struct{}),
+	}
+	go d.run()
+	return d
+}
+
+// enqueue queues the message for delivery. It never blocks, since it's called
+// with s.mu held and webhook receivers may be calling the server meanwhile.
+func (d *webhookDelivery) enqueue(msg webhookMessage) {
+	d.mu.Lock()
+	d.queue = append(d.queue, msg)
+	d.mu.Unlock()
+	d.signal()
+}
+
+func (d *webhookDelivery) signal() {
+	select {
+	case d.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (d *webhookDelivery) run() {
+	defer close(d.done)
+	for {
+		d.mu.Lock()
+		if len(d.queue) == 0 {
+			closed := d.closed
+			d.mu.Unlock()
+			if closed {
+				return
+			}
+			<-d.wake
+			continue
+		}
+		msg := d.queue[0]
+		d.queue = d.queue[1:]
+		d.mu.Unlock()
+
+		status, err := d.send(msg)
+		d.mu.Lock()
+		d.log = append(d.log, Delivery{
+			WebhookID:  msg.ref.ID,
+			Action:     msg.event,
+			ObjectID:   msg.id,
+			StatusCode: status,
+			Err:        err,
+		})
+		d.mu.Unlock()
+	}
+}
+
+func (d *webhookDelivery) send(msg webhookMessage) (int, error) {
+	mac := hmac.New(sha256.New, []byte(msg.ref.Token))
+	mac.Write(msg.body)
+
+	req, err := http.NewRequest(http.MethodPost, msg.ref.URL, bytes.NewReader(msg.body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(onfido.WebhookSignatureHeader, hex.EncodeToString(mac.Sum(nil)))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("webhook %s responded with status code %d", msg.ref.ID, resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
+
+// close waits for the queued events to be delivered.
+func (d *webhookDelivery) close() {
+	d.mu.Lock()
+	d.closed = true
+	d.mu.Unlock()
+	d.signal()
+	<-d.done
+}
+
+// emit queues the event for delivery to the webhooks subscribed to it.
+// It must be called with s.mu held.
+func (s *Server) emit(chk *onfido.CheckRetrieved, e webhookEvent) {
+	if s.delivery == nil || s.closed {
+		return
+	}
+
+	var wr onfido.WebhookRequest
+	wr.Payload.ResourceType = e.resourceType
+	wr.Payload.Action = string(e.action)
+	wr.Payload.Object.ID = e.id
+	wr.Payload.Object.Status = e.status
+	wr.Payload.Object.CompletedAt = s.now().UTC().Format(time.RFC3339)
+	wr.Payload.Object.Href = s.URL + e.href
+	body, err := json.Marshal(wr)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, ref := range s.webhooks {
+		if subscribed(ref, chk, e.action) {
+			s.delivery.enqueue(webhookMessage{ref: *ref, event: e.action, id: e.id, body: body})
+		}
+	}
+}
+
+func subscribed(ref *onfido.WebhookRef, chk *onfido.CheckRetrieved, event onfido.WebhookEvent) bool {
+	if !ref.Enabled {
+		return false
+	}
+	if len(chk.WebhookIDs) > 0 && !contains(chk.WebhookIDs, ref.ID) {
+		return false
+	}
+	if len(ref.Environments) > 0 {
+		sandbox := false
+		for _, env := range ref.Environments {
+			sandbox = sandbox || env == onfido.WebhookEnvironmentSandbox
+		}
+		if !sandbox {
+			return false
+		}
+	}
+	if len(ref.Events) == 0 {
+		return true
+	}
+	for _, ev := range ref.Events {
+		if ev == event {
+			return true
+		}
+	}
+	return false
+}
+
+func contains(values []string, v string) bool {
+	for _, s := range values {
+		if s == v {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,122 @@
+package onfidotest
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/mbowman100/go-onfido"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServer_WebhookDelivery(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		received []*onfido.WebhookRequest
+		wh       onfido.Webhook
+	)
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		wr, err := wh.ParseFromRequest(r)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		received = append(received, wr)
+	}))
+	defer receiver.Close()
+
+	srv := NewServer(WithCheckDelay(-1), WithWebhookDelivery(nil))
+	ctx := context.Background()
+	c := srv.Client()
+
+	ref, err := c.CreateWebhook(ctx, onfido.WebhookRefRequest{URL: receiver.URL, Enabled: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	mu.Lock()
+	wh = onfido.NewWebhook(ref.Token)
+	mu.Unlock()
+	// Not subscribed to check events, so it never receives any.
+	if _, err := c.CreateWebhook(ctx, onfido.WebhookRefRequest{
+		URL:     receiver.URL,
+		Enabled: true,
+		Events:  []onfido.WebhookEvent{onfido.WebhookEventWorkflowRunCompleted},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	chk := createCheck(t, c, "Doe", func(b *onfido.CheckBuilder) {
+		b.Reports(onfido.ReportNameIdentityEnhanced)
+	})
+	srv.CompletePendingChecks()
+	srv.Close()
+
+	mu.Lock()
+	defer mu.Unlock()
+	if assert.Len(t, received, 3) {
+		assert.Equal(t, string(onfido.WebhookEventCheckStarted), received[0].Payload.Action)
+		assert.Equal(t, string(onfido.WebhookEventReportCompleted), received[1].Payload.Action)
+		assert.Equal(t, chk.Reports[0].ID, received[1].Payload.Object.ID)
+		assert.Equal(t, string(onfido.WebhookEventCheckCompleted), received[2].Payload.Action)
+		assert.Equal(t, chk.ID, received[2].Payload.Object.ID)
+	}
+
+	deliveries := srv.Deliveries()
+	assert.Len(t, deliveries, 3)
+	for _, d := range deliveries {
+		assert.NoError(t, d.Err)
+		assert.Equal(t, ref.ID, d.WebhookID)
+	}
+}
+
+func TestServer_WebhookDeliveryDoesntBlock(t *testing.T) {
+	release := make(chan struct{})
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer receiver.Close()
+
+	srv := NewServer(WithWebhookDelivery(nil))
+	ctx := context.Background()
+	c := srv.Client()
+	if _, err := c.CreateWebhook(ctx, onfido.WebhookRefRequest{URL: receiver.URL, Enabled: true}); err != nil {
+		t.Fatal(err)
+	}
+	a, err := c.CreateApplicant(ctx, onfido.Applicant{FirstName: "Jane", LastName: "Doe"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The receiver is stuck on the first event while the server keeps
+	// emitting more, each check completes with two.
+	const checks = 600
+	created := make(chan error, 1)
+	go func() {
+		for i := 0; i < checks; i++ {
+			if _, err := c.CreateCheck(ctx, onfido.CheckRequest{
+				ApplicantID: a.ID,
+				ReportNames: []onfido.ReportName{onfido.ReportNameWatchlistStandard},
+			}); err != nil {
+				created <- err
+				return
+			}
+		}
+		created <- nil
+	}()
+	select {
+	case err := <-created:
+		assert.NoError(t, err)
+	case <-time.After(30 * time.Second):
+		close(release)
+		t.Fatal("creating checks blocked on webhook delivery")
+	}
+
+	close(release)
+	srv.Close()
+	assert.Len(t, srv.Deliveries(), 2*checks)
+}
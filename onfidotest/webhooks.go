@@ -0,0 +1,116 @@
+package onfidotest
+
+import (
+	"net/http"
+	"net/url"
+
+	"github.com/gorilla/mux"
+	"github.com/mbowman100/go-onfido"
+)
+
+func validateWebhook(wr *onfido.WebhookRefRequest) fieldErrors {
+	fe := make(fieldErrors)
+	if wr.URL == "" {
+		fe.add("url", "can't be blank")
+	} else if u, err := url.Parse(wr.URL); err != nil || u.Host == "" {
+		fe.add("url", "is invalid")
+	}
+	return fe
+}
+
+func (s *Server) createWebhook(w http.ResponseWriter, r *http.Request) {
+	var wr onfido.WebhookRefRequest
+	if err := decodeJSON(r, &wr); err != nil {
+		writeBadRequest(w, err)
+		return
+	}
+	if fe := validateWebhook(&wr); len(fe) > 0 {
+		writeValidationError(w, fe)
+		return
+	}
+
+	id := newID()
+	ref := &onfido.WebhookRef{
+		ID:           id,
+		URL:          wr.URL,
+		Enabled:      wr.Enabled,
+		Href:         s.href("webhooks", id),
+		Token:        newID(),
+		Environments: wr.Environments,
+		Events:       wr.Events,
+	}
+
+	s.mu.Lock()
+	s.webhooks = append(s.webhooks, ref)
+	resp := *ref
+	s.mu.Unlock()
+
+	writeJSON(w, http.StatusCreated, resp)
+}
+
+func (s *Server) findWebhook(id string) *onfido.WebhookRef {
+	for _, ref := range s.webhooks {
+		if ref.ID == id {
+			return ref
+		}
+	}
+	return nil
+}
+
+func (s *Server) getWebhook(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ref := s.findWebhook(mux.Vars(r)["id"])
+	if ref == nil {
+		writeNotFound(w)
+		return
+	}
+	writeJSON(w, http.StatusOK, ref)
+}
+
+func (s *Server) updateWebhook(w http.ResponseWriter, r *http.Request) {
+	var wr onfido.WebhookRefRequest
+	if err := decodeJSON(r, &wr); err != nil {
+		writeBadRequest(w, err)
+		return
+	}
+	if fe := validateWebhook(&wr); len(fe) > 0 {
+		writeValidationError(w, fe)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ref := s.findWebhook(mux.Vars(r)["id"])
+	if ref == nil {
+		writeNotFound(w)
+		return
+	}
+	ref.URL, ref.Enabled, ref.Environments, ref.Events = wr.URL, wr.Enabled, wr.Environments, wr.Events
+	writeJSON(w, http.StatusOK, ref)
+}
+
+func (s *Server) deleteWebhook(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	id := mux.Vars(r)["id"]
+	for i, ref := range s.webhooks {
+		if ref.ID == id {
+			s.webhooks = append(s.webhooks[:i], s.webhooks[i+1:]...)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+	}
+	writeNotFound(w)
+}
+
+func (s *Server) listWebhooks(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	items := make([]interface{}, 0, len(s.webhooks))
+	for _, ref := range s.webhooks {
+		items = append(items, *ref)
+	}
+	s.mu.Unlock()
+
+	writePage(w, r, "webhooks", items)
+}
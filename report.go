@@ -14,12 +14,14 @@ const (
 	ReportNameFacialSimilarityPhoto          ReportName = "facial_similarity_photo"
 	ReportNameFacialSimilarityPhotoFullyAuto ReportName = "facial_similarity_photo_fully_auto"
 	ReportNameFacialSimilarityVideo          ReportName = "facial_similarity_video"
+	ReportNameFacialSimilarityMotion         ReportName = "facial_similarity_motion"
 	ReportNameKnownFaces                     ReportName = "known_faces"
 	ReportNameIdentityEnhanced               ReportName = "identity_enhanced"
 	ReportNameWatchlistEnhanced              ReportName = "watchlist_enhanced"
 	ReportNameWatchlistStandard              ReportName = "watchlist_standard"
 	ReportNameWatchlistPepsOnly              ReportName = "watchlist_peps_only"
 	ReportNameWatchlistSanctionsOnly         ReportName = "watchlist_sanctions_only"
+	ReportNameWatchlistAML                   ReportName = "watchlist_aml"
 	ReportNameProofOfAddress                 ReportName = "proof_of_address"
 	ReportNameRightToWork                    ReportName = "right_to_work"
 
@@ -105,7 +107,7 @@ func (c *client) CancelReport(ctx context.Context, id string) error {
 
 // ReportIter represents a document iterator
 type ReportIter struct {
-	*iter
+	Iter
 }
 
 // Report returns the current item in the iterator as a Report.
@@ -2,14 +2,77 @@ package onfido
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
 )
 
+var apiVersionPrefix = regexp.MustCompile(`^/v\d+(\.\d+)?(/|$)`)
+
+// ErrUnexpectedResourceHost means that an href points at a host which isn't
+// the client's endpoint or an Onfido API region
+var ErrUnexpectedResourceHost = errors.New("unexpected resource host")
+
+// GetResource retrieves the resource referenced by href into v.
+// The href may be relative or absolute, and may include the API version prefix,
+// which is replaced by the client's. Relative hrefs are resolved against the
+// client's endpoint, absolute ones are sent to their own host if it's the
+// endpoint's or another Onfido API region (e.g. https://api.us.onfido.com),
+// any other host is rejected with ErrUnexpectedResourceHost.
 func (c *client) GetResource(ctx context.Context, href string, v interface{}) error {
-	req, err := c.newRequest(http.MethodGet, href, nil)
+	uri, err := resourceURL(href, c.endpoint)
+	if err != nil {
+		return err
+	}
+	req, err := c.newRequest(http.MethodGet, uri, nil)
 	if err != nil {
 		return err
 	}
 	_, err = c.do(ctx, req, v)
 	return err
 }
+
+// resourceURL returns the URI to request an Onfido API href with: its path
+// relative to the API version if it's relative, or else its URL on the same
+// host with the endpoint's API version.
+func resourceURL(href, endpoint string) (string, error) {
+	u, err := url.Parse(href)
+	if err != nil {
+		return "", err
+	}
+	path, err := resourcePath(href)
+	if err != nil {
+		return "", err
+	}
+	if !u.IsAbs() {
+		return path, nil
+	}
+	e, err := url.Parse(endpoint)
+	if err != nil {
+		return "", err
+	}
+	if !sameAPIHost(u, e) {
+		return "", fmt.Errorf("%w: %s://%s", ErrUnexpectedResourceHost, u.Scheme, u.Host)
+	}
+	return u.Scheme + "://" + u.Host + strings.TrimSuffix(e.Path, "/") + path, nil
+}
+
+// resourcePath returns the path of an Onfido API href relative to the API version,
+// e.g. both https://api.us.onfido.com/v3.5/checks/123 and /v3.5/checks/123 become /checks/123.
+func resourcePath(href string) (string, error) {
+	u, err := url.Parse(href)
+	if err != nil {
+		return "", err
+	}
+	path := u.Path
+	if loc := apiVersionPrefix.FindStringIndex(path); loc != nil {
+		path = "/" + path[loc[1]:]
+	}
+	if u.RawQuery != "" {
+		path += "?" + u.RawQuery
+	}
+	return path, nil
+}
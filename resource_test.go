@@ -0,0 +1,88 @@
+package onfido
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourcePath(t *testing.T) {
+	cases := map[string]string{
+		"/checks/123":      "/checks/123",
+		"/v3.5/checks/123": "/checks/123",
+		"/v3/reports/123":  "/reports/123",
+		"https://api.us.onfido.com/v3.5/checks/123":         "/checks/123",
+		"https://api.onfido.com/v3.5/checks?applicant_id=1": "/checks?applicant_id=1",
+		"/v35nope/checks": "/v35nope/checks",
+	}
+	for href, expected := range cases {
+		p, err := resourcePath(href)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, expected, p, href)
+	}
+}
+
+func TestResourceURL(t *testing.T) {
+	cases := map[string]string{
+		"/v3.5/checks/123":                                     "/checks/123",
+		"https://api.eu.onfido.com/v3/checks/123":              "https://api.eu.onfido.com/v3.5/checks/123",
+		"https://api.us.onfido.com/v3.5/checks/123":            "https://api.us.onfido.com/v3.5/checks/123",
+		"https://api.ca.onfido.com/v3.5/checks?applicant_id=1": "https://api.ca.onfido.com/v3.5/checks?applicant_id=1",
+	}
+	for href, expected := range cases {
+		u, err := resourceURL(href, DefaultEndpoint)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, expected, u, href)
+	}
+
+	for _, href := range []string{
+		"https://evil.example.com/v3.5/checks/123",
+		"http://api.us.onfido.com/v3.5/checks/123",
+		"https://api.us.onfido.com:8443/v3.5/checks/123",
+	} {
+		_, err := resourceURL(href, DefaultEndpoint)
+		assert.True(t, errors.Is(err, ErrUnexpectedResourceHost), href)
+	}
+}
+
+func TestGetResource_OtherRegion(t *testing.T) {
+	requester := &handlerRequester{h: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v3.5/checks/123", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		_, wErr := w.Write([]byte(`{"id": "123"}`))
+		assert.NoError(t, wErr)
+	})}
+	client := NewClient("123", WithHTTPClient(requester))
+
+	var c Check
+	if err := client.GetResource(context.Background(), "https://api.us.onfido.com/v3.5/checks/123", &c); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "123", c.ID)
+	assert.Equal(t, []string{"api.us.onfido.com"}, requester.hosts)
+
+	err := client.GetResource(context.Background(), "https://evil.example.com/v3.5/checks/123", &c)
+	assert.True(t, errors.Is(err, ErrUnexpectedResourceHost))
+	assert.Len(t, requester.hosts, 1)
+}
+
+// handlerRequester serves requests with a handler, recording their hosts.
+type handlerRequester struct {
+	h     http.Handler
+	hosts []string
+}
+
+func (r *handlerRequester) Do(req *http.Request) (*http.Response, error) {
+	r.hosts = append(r.hosts, req.URL.Host)
+	w := httptest.NewRecorder()
+	r.h.ServeHTTP(w, req)
+	return w.Result(), nil
+}
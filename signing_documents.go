@@ -0,0 +1,115 @@
+package onfido
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// SigningDocument represents a document to be signed in a workflow run
+// see https://documentation.onfido.com/#signing-document-object
+type SigningDocument struct {
+	ID           string     `json:"id,omitempty"`
+	ApplicantID  string     `json:"applicant_id,omitempty"`
+	CreatedAt    *time.Time `json:"created_at,omitempty"`
+	Href         string     `json:"href,omitempty"`
+	DownloadHref string     `json:"download_href,omitempty"`
+	FileName     string     `json:"file_name,omitempty"`
+	FileType     string     `json:"file_type,omitempty"`
+	FileSize     int        `json:"file_size,omitempty"`
+}
+
+// SigningDocumentRequest represents a signing document upload request to Onfido API
+type SigningDocumentRequest struct {
+	ApplicantID string
+	// File is the document to be signed, usually a PDF.
+	File io.ReadSeeker
+}
+
+// UploadSigningDocument uploads a document to be signed by the provided applicant.
+// see https://documentation.onfido.com/#upload-signing-document
+func (c *client) UploadSigningDocument(ctx context.Context, sr SigningDocumentRequest) (*SigningDocument, error) {
+	req, err := c.newMultipartRequest("/signing_documents", sr.File,
+		formField{"applicant_id", sr.ApplicantID},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp SigningDocument
+	_, err = c.do(ctx, req, &resp)
+	return &resp, err
+}
+
+// GetSigningDocument retrieves a single signing document by its ID.
+// see https://documentation.onfido.com/#retrieve-signing-document
+func (c *client) GetSigningDocument(ctx context.Context, id string) (*SigningDocument, error) {
+	req, err := c.newRequest(http.MethodGet, "/signing_documents/"+id, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp SigningDocument
+	_, err = c.do(ctx, req, &resp)
+	return &resp, err
+}
+
+// DownloadSigningDocument streams the binary data of the signing document into w.
+// see https://documentation.onfido.com/#download-signing-document
+func (c *client) DownloadSigningDocument(ctx context.Context, id string, w io.Writer) error {
+	return c.download(ctx, "/signing_documents/"+id+"/download", w, "signing document")
+}
+
+// SigningDocumentIter represents a signing document iterator
+type SigningDocumentIter struct {
+	Iter
+}
+
+// SigningDocument returns the current item in the iterator as a SigningDocument.
+func (i *SigningDocumentIter) SigningDocument() *SigningDocument {
+	return i.Current().(*SigningDocument)
+}
+
+// ListSigningDocuments retrieves the list of signing documents for the provided applicant.
+// see https://documentation.onfido.com/#list-signing-documents
+func (c *client) ListSigningDocuments(applicantID string) *SigningDocumentIter {
+	return &SigningDocumentIter{&iter{
+		c:       c,
+		nextURL: "/signing_documents?applicant_id=" + applicantID,
+		handler: func(body []byte) ([]interface{}, error) {
+			var r struct {
+				SigningDocuments []*SigningDocument `json:"signing_documents"`
+			}
+
+			if err := json.Unmarshal(body, &r); err != nil {
+				return nil, err
+			}
+
+			values := make([]interface{}, len(r.SigningDocuments))
+			for i, v := range r.SigningDocuments {
+				values[i] = v
+			}
+			return values, nil
+		},
+	}}
+}
+
+// QESDocument references a document signed with a qualified electronic signature
+// during a workflow run. The file ID is found in the workflow run's output.
+type QESDocument struct {
+	WorkflowRunID string `json:"workflow_run_id"`
+	FileID        string `json:"file_id"`
+}
+
+// DownloadQESDocument streams a document signed with a qualified electronic
+// signature during a workflow run into w.
+// see https://documentation.onfido.com/#retrieve-qes-documents
+func (c *client) DownloadQESDocument(ctx context.Context, doc QESDocument, w io.Writer) error {
+	params := make(url.Values)
+	params.Set("workflow_run_id", doc.WorkflowRunID)
+	params.Set("file_id", doc.FileID)
+	return c.download(ctx, "/qualified_electronic_signature/documents?"+params.Encode(), w, "qes document")
+}
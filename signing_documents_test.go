@@ -0,0 +1,116 @@
+package onfido
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSigningDocuments_Upload(t *testing.T) {
+	applicantID := "541d040b-89f8-444b-8921-16b1333bf1c6"
+	m := mux.NewRouter()
+	m.HandleFunc("/signing_documents", func(w http.ResponseWriter, r *http.Request) {
+		assert.NoError(t, r.ParseMultipartForm(1<<20))
+		assert.Equal(t, applicantID, r.FormValue("applicant_id"))
+		_, fh, err := r.FormFile("file")
+		if assert.NoError(t, err) {
+			assert.Equal(t, "application/pdf", fh.Header.Get("Content-Type"))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, wErr := w.Write([]byte(`{"id": "sd-1", "applicant_id": "` + applicantID + `", "file_type": "pdf"}`))
+		assert.NoError(t, wErr)
+	}).Methods("POST")
+	srv := httptest.NewServer(m)
+	defer srv.Close()
+
+	client := NewClient("123").(*client)
+	client.endpoint = srv.URL
+
+	doc, err := client.UploadSigningDocument(context.Background(), SigningDocumentRequest{
+		ApplicantID: applicantID,
+		File:        bytes.NewReader([]byte("%PDF-1.4 contract")),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "sd-1", doc.ID)
+	assert.Equal(t, applicantID, doc.ApplicantID)
+}
+
+func TestSigningDocuments_ListGetAndDownload(t *testing.T) {
+	m := mux.NewRouter()
+	m.HandleFunc("/signing_documents", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "applicant-1", r.URL.Query().Get("applicant_id"))
+		w.Header().Set("Content-Type", "application/json")
+		_, wErr := w.Write([]byte(`{"signing_documents": [{"id": "sd-1"}]}`))
+		assert.NoError(t, wErr)
+	}).Methods("GET")
+	m.HandleFunc("/signing_documents/{id}", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, wErr := w.Write([]byte(`{"id": "` + mux.Vars(r)["id"] + `", "file_name": "contract.pdf"}`))
+		assert.NoError(t, wErr)
+	}).Methods("GET")
+	m.HandleFunc("/signing_documents/{id}/download", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/pdf")
+		_, wErr := w.Write([]byte("%PDF"))
+		assert.NoError(t, wErr)
+	}).Methods("GET")
+	srv := httptest.NewServer(m)
+	defer srv.Close()
+
+	client := NewClient("123").(*client)
+	client.endpoint = srv.URL
+	ctx := context.Background()
+
+	var ids []string
+	it := client.ListSigningDocuments("applicant-1")
+	for it.Next(ctx) {
+		ids = append(ids, it.SigningDocument().ID)
+	}
+	if it.Err() != nil {
+		t.Fatal(it.Err())
+	}
+	assert.Equal(t, []string{"sd-1"}, ids)
+
+	doc, err := client.GetSigningDocument(ctx, "sd-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "contract.pdf", doc.FileName)
+
+	var buf bytes.Buffer
+	if err := client.DownloadSigningDocument(ctx, "sd-1", &buf); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "%PDF", buf.String())
+}
+
+func TestDownloadQESDocument(t *testing.T) {
+	m := mux.NewRouter()
+	m.HandleFunc("/qualified_electronic_signature/documents", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "run-1", r.URL.Query().Get("workflow_run_id"))
+		assert.Equal(t, "file-1", r.URL.Query().Get("file_id"))
+		w.Header().Set("Content-Type", "application/pdf")
+		_, wErr := w.Write([]byte("%PDF signed"))
+		assert.NoError(t, wErr)
+	}).Methods("GET")
+	srv := httptest.NewServer(m)
+	defer srv.Close()
+
+	client := NewClient("123").(*client)
+	client.endpoint = srv.URL
+
+	var buf bytes.Buffer
+	err := client.DownloadQESDocument(context.Background(), QESDocument{WorkflowRunID: "run-1", FileID: "file-1"}, &buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "%PDF signed", buf.String())
+}
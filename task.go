@@ -0,0 +1,90 @@
+package onfido
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+)
+
+// Task represents a task of a workflow run in Onfido API
+// see https://documentation.onfido.com/#task-object
+type Task struct {
+	ID             string                 `json:"id,omitempty"`
+	WorkflowRunID  string                 `json:"workflow_run_id,omitempty"`
+	TaskDefID      string                 `json:"task_def_id,omitempty"`
+	TaskDefVersion string                 `json:"task_def_version,omitempty"`
+	Input          map[string]interface{} `json:"input,omitempty"`
+	Output         map[string]interface{} `json:"output,omitempty"`
+	CreatedAt      *time.Time             `json:"created_at,omitempty"`
+	UpdatedAt      *time.Time             `json:"updated_at,omitempty"`
+}
+
+// TaskIter represents a task iterator
+type TaskIter struct {
+	Iter
+}
+
+// Task returns the current item in the iterator as a Task.
+func (i *TaskIter) Task() *Task {
+	return i.Current().(*Task)
+}
+
+// ListTasks retrieves the list of tasks of the provided workflow run.
+// The listed tasks only contain their ID, definition and timestamps.
+// see https://documentation.onfido.com/#list-tasks
+func (c *client) ListTasks(workflowRunID string) *TaskIter {
+	handler := func(body []byte) ([]interface{}, error) {
+		var r []*Task
+		if err := json.Unmarshal(body, &r); err != nil {
+			return nil, err
+		}
+
+		values := make([]interface{}, len(r))
+		for i, v := range r {
+			v.WorkflowRunID = workflowRunID
+			values[i] = v
+		}
+		return values, nil
+	}
+
+	return &TaskIter{&iter{
+		c:       c,
+		nextURL: "/workflow_runs/" + workflowRunID + "/tasks",
+		handler: handler,
+	}}
+}
+
+// GetTask retrieves a task of a workflow run, including its input and output.
+// see https://documentation.onfido.com/#retrieve-task
+func (c *client) GetTask(ctx context.Context, workflowRunID, taskID string) (*Task, error) {
+	req, err := c.newRequest(http.MethodGet, "/workflow_runs/"+workflowRunID+"/tasks/"+taskID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp Task
+	_, err = c.do(ctx, req, &resp)
+	return &resp, err
+}
+
+// CompleteTask completes a manual task of a workflow run with the provided data,
+// which must match the task definition's expected output.
+// see https://documentation.onfido.com/#complete-task
+func (c *client) CompleteTask(ctx context.Context, workflowRunID, taskID string, data interface{}) error {
+	jsonStr, err := json.Marshal(struct {
+		Data interface{} `json:"data"`
+	}{data})
+	if err != nil {
+		return err
+	}
+
+	req, err := c.newRequest(http.MethodPost, "/workflow_runs/"+workflowRunID+"/tasks/"+taskID+"/complete", bytes.NewBuffer(jsonStr))
+	if err != nil {
+		return err
+	}
+
+	_, err = c.do(ctx, req, nil)
+	return err
+}
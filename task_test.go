@@ -0,0 +1,107 @@
+package onfido
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListTasks_TasksRetrieved(t *testing.T) {
+	m := mux.NewRouter()
+	m.HandleFunc("/workflow_runs/{id}/tasks", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "run-1", mux.Vars(r)["id"])
+		w.Header().Set("Content-Type", "application/json")
+		_, wErr := w.Write([]byte(`[
+			{"id": "profile_data_1", "task_def_id": "profile_data", "task_def_version": "1"},
+			{"id": "manual_review_1", "task_def_id": "manual_review"}
+		]`))
+		assert.NoError(t, wErr)
+	}).Methods("GET")
+	srv := httptest.NewServer(m)
+	defer srv.Close()
+
+	client := NewClient("123").(*client)
+	client.endpoint = srv.URL
+
+	var tasks []*Task
+	it := client.ListTasks("run-1")
+	for it.Next(context.Background()) {
+		tasks = append(tasks, it.Task())
+	}
+	if it.Err() != nil {
+		t.Fatal(it.Err())
+	}
+
+	if assert.Len(t, tasks, 2) {
+		assert.Equal(t, "profile_data", tasks[0].TaskDefID)
+		assert.Equal(t, "1", tasks[0].TaskDefVersion)
+		assert.Equal(t, "run-1", tasks[1].WorkflowRunID)
+	}
+}
+
+func TestGetTask_NonOKResponse(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	client := NewClient("123").(*client)
+	client.endpoint = srv.URL
+
+	_, err := client.GetTask(context.Background(), "run-1", "task-1")
+	if err == nil {
+		t.Fatal("expected server to return non ok response, got successful response")
+	}
+}
+
+func TestGetTask_TaskRetrieved(t *testing.T) {
+	m := mux.NewRouter()
+	m.HandleFunc("/workflow_runs/{id}/tasks/{taskId}", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "manual_review_1", mux.Vars(r)["taskId"])
+		w.Header().Set("Content-Type", "application/json")
+		_, wErr := w.Write([]byte(`{
+			"id": "manual_review_1",
+			"workflow_run_id": "run-1",
+			"task_def_id": "manual_review",
+			"input": {"document_id": "doc-1"}
+		}`))
+		assert.NoError(t, wErr)
+	}).Methods("GET")
+	srv := httptest.NewServer(m)
+	defer srv.Close()
+
+	client := NewClient("123").(*client)
+	client.endpoint = srv.URL
+
+	task, err := client.GetTask(context.Background(), "run-1", "manual_review_1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "manual_review", task.TaskDefID)
+	assert.Equal(t, "doc-1", task.Input["document_id"])
+}
+
+func TestCompleteTask_TaskCompleted(t *testing.T) {
+	m := mux.NewRouter()
+	m.HandleFunc("/workflow_runs/{id}/tasks/{taskId}/complete", func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]map[string]string
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "approve", body["data"]["decision"])
+		w.WriteHeader(http.StatusOK)
+	}).Methods("POST")
+	srv := httptest.NewServer(m)
+	defer srv.Close()
+
+	client := NewClient("123").(*client)
+	client.endpoint = srv.URL
+
+	err := client.CompleteTask(context.Background(), "run-1", "manual_review_1", map[string]string{"decision": "approve"})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,183 @@
+package onfido
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+)
+
+// WatchlistMonitorMatchStatus represents the status of a watchlist monitor match
+type WatchlistMonitorMatchStatus string
+
+// Supported watchlist monitor match statuses
+const (
+	WatchlistMonitorMatchStatusEnabled  WatchlistMonitorMatchStatus = "enabled"
+	WatchlistMonitorMatchStatusDisabled WatchlistMonitorMatchStatus = "disabled"
+)
+
+// WatchlistMonitorRequest represents a watchlist monitor request to Onfido API
+type WatchlistMonitorRequest struct {
+	ApplicantID string `json:"applicant_id"`
+	// ReportName is either ReportNameWatchlistStandard or ReportNameWatchlistAML.
+	ReportName ReportName `json:"report_name"`
+	Tags       []string   `json:"tags,omitempty"`
+}
+
+// WatchlistMonitor represents a watchlist monitor in Onfido API
+// see https://documentation.onfido.com/#watchlist-monitor-object
+type WatchlistMonitor struct {
+	ID          string     `json:"id,omitempty"`
+	ApplicantID string     `json:"applicant_id,omitempty"`
+	ReportName  ReportName `json:"report_name,omitempty"`
+	Tags        []string   `json:"tags,omitempty"`
+	IsSandbox   bool       `json:"is_sandbox,omitempty"`
+	CreatedAt   *time.Time `json:"created_at,omitempty"`
+	DeletedAt   *time.Time `json:"deleted_at,omitempty"`
+}
+
+// WatchlistMonitors represents a list of watchlist monitors in Onfido API
+type WatchlistMonitors struct {
+	WatchlistMonitors []*WatchlistMonitor `json:"monitors"`
+}
+
+// WatchlistMonitorMatch represents a match found by a watchlist monitor: the
+// watchlist record matching the applicant, as in the monitor's watchlist
+// reports (see Report.Watchlist), and whether it's reported.
+type WatchlistMonitorMatch struct {
+	ID     string                      `json:"id,omitempty"`
+	Status WatchlistMonitorMatchStatus `json:"status,omitempty"`
+	WatchlistRecord
+}
+
+// WatchlistMonitorMatches represents a list of watchlist monitor matches in Onfido API
+type WatchlistMonitorMatches struct {
+	Matches []*WatchlistMonitorMatch `json:"matches"`
+}
+
+// WatchlistMonitorMatchesUpdate enables and disables watchlist monitor matches by their IDs
+type WatchlistMonitorMatchesUpdate struct {
+	Enable  []string `json:"enable,omitempty"`
+	Disable []string `json:"disable,omitempty"`
+}
+
+// CreateWatchlistMonitor starts ongoing watchlist monitoring of the provided applicant.
+// see https://documentation.onfido.com/#create-monitor
+func (c *client) CreateWatchlistMonitor(ctx context.Context, wr WatchlistMonitorRequest) (*WatchlistMonitor, error) {
+	jsonStr, err := json.Marshal(wr)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := c.newRequest(http.MethodPost, "/watchlist_monitors", bytes.NewBuffer(jsonStr))
+	if err != nil {
+		return nil, err
+	}
+
+	var resp WatchlistMonitor
+	_, err = c.do(ctx, req, &resp)
+	return &resp, err
+}
+
+// GetWatchlistMonitor retrieves a watchlist monitor by its ID.
+// see https://documentation.onfido.com/#retrieve-monitor
+func (c *client) GetWatchlistMonitor(ctx context.Context, id string) (*WatchlistMonitor, error) {
+	req, err := c.newRequest(http.MethodGet, "/watchlist_monitors/"+id, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp WatchlistMonitor
+	_, err = c.do(ctx, req, &resp)
+	return &resp, err
+}
+
+// DeleteWatchlistMonitor stops and deletes a watchlist monitor by its ID.
+// see https://documentation.onfido.com/#delete-monitor
+func (c *client) DeleteWatchlistMonitor(ctx context.Context, id string) error {
+	req, err := c.newRequest(http.MethodDelete, "/watchlist_monitors/"+id, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.do(ctx, req, nil)
+	return err
+}
+
+// WatchlistMonitorIter represents a watchlist monitor iterator
+type WatchlistMonitorIter struct {
+	Iter
+}
+
+// WatchlistMonitor returns the current item in the iterator as a WatchlistMonitor.
+func (i *WatchlistMonitorIter) WatchlistMonitor() *WatchlistMonitor {
+	return i.Current().(*WatchlistMonitor)
+}
+
+// ListWatchlistMonitors retrieves the list of watchlist monitors for the provided applicant.
+// see https://documentation.onfido.com/#list-monitors
+func (c *client) ListWatchlistMonitors(applicantID string) *WatchlistMonitorIter {
+	handler := func(body []byte) ([]interface{}, error) {
+		var r WatchlistMonitors
+		if err := json.Unmarshal(body, &r); err != nil {
+			return nil, err
+		}
+
+		values := make([]interface{}, len(r.WatchlistMonitors))
+		for i, v := range r.WatchlistMonitors {
+			values[i] = v
+		}
+		return values, nil
+	}
+
+	return &WatchlistMonitorIter{&iter{
+		c:       c,
+		nextURL: "/watchlist_monitors?applicant_id=" + applicantID,
+		handler: handler,
+	}}
+}
+
+// ListMonitorMatches retrieves the matches found by a watchlist monitor.
+// see https://documentation.onfido.com/#list-matches
+func (c *client) ListMonitorMatches(ctx context.Context, monitorID string) ([]*WatchlistMonitorMatch, error) {
+	req, err := c.newRequest(http.MethodGet, "/watchlist_monitors/"+monitorID+"/matches", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp WatchlistMonitorMatches
+	_, err = c.do(ctx, req, &resp)
+	return resp.Matches, err
+}
+
+// UpdateMonitorMatches enables and disables matches of a watchlist monitor.
+// see https://documentation.onfido.com/#set-match-status
+func (c *client) UpdateMonitorMatches(ctx context.Context, monitorID string, mu WatchlistMonitorMatchesUpdate) ([]*WatchlistMonitorMatch, error) {
+	jsonStr, err := json.Marshal(mu)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := c.newRequest(http.MethodPatch, "/watchlist_monitors/"+monitorID+"/matches", bytes.NewBuffer(jsonStr))
+	if err != nil {
+		return nil, err
+	}
+
+	var resp WatchlistMonitorMatches
+	_, err = c.do(ctx, req, &resp)
+	return resp.Matches, err
+}
+
+// ForceReportCreation creates a new report for a watchlist monitor, without
+// waiting for the next scheduled run.
+// see https://documentation.onfido.com/#force-new-report-creation-from-monitor
+func (c *client) ForceReportCreation(ctx context.Context, monitorID string) error {
+	req, err := c.newRequest(http.MethodPost, "/watchlist_monitors/"+monitorID+"/new_report", nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.do(ctx, req, nil)
+	return err
+}
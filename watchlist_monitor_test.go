@@ -0,0 +1,182 @@
+package onfido
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateWatchlistMonitor_NonOKResponse(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		_, wErr := w.Write([]byte("{\"error\": \"things went bad\"}"))
+		assert.NoError(t, wErr)
+	}))
+	defer srv.Close()
+
+	client := NewClient("123").(*client)
+	client.endpoint = srv.URL
+
+	_, err := client.CreateWatchlistMonitor(context.Background(), WatchlistMonitorRequest{})
+	if err == nil {
+		t.Fatal("expected server to return non ok response, got successful response")
+	}
+}
+
+func TestCreateWatchlistMonitor_MonitorCreated(t *testing.T) {
+	applicantID := "541d040b-89f8-444b-8921-16b1333bf1c6"
+	m := mux.NewRouter()
+	m.HandleFunc("/watchlist_monitors", func(w http.ResponseWriter, r *http.Request) {
+		var wr WatchlistMonitorRequest
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&wr))
+		assert.Equal(t, applicantID, wr.ApplicantID)
+		assert.Equal(t, ReportNameWatchlistAML, wr.ReportName)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		assert.NoError(t, json.NewEncoder(w).Encode(WatchlistMonitor{
+			ID:          "monitor-1",
+			ApplicantID: wr.ApplicantID,
+			ReportName:  wr.ReportName,
+		}))
+	}).Methods("POST")
+	srv := httptest.NewServer(m)
+	defer srv.Close()
+
+	client := NewClient("123").(*client)
+	client.endpoint = srv.URL
+
+	monitor, err := client.CreateWatchlistMonitor(context.Background(), WatchlistMonitorRequest{
+		ApplicantID: applicantID,
+		ReportName:  ReportNameWatchlistAML,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "monitor-1", monitor.ID)
+	assert.Equal(t, ReportNameWatchlistAML, monitor.ReportName)
+}
+
+func TestListWatchlistMonitors_MonitorsRetrieved(t *testing.T) {
+	applicantID := "541d040b-89f8-444b-8921-16b1333bf1c6"
+	m := mux.NewRouter()
+	m.HandleFunc("/watchlist_monitors", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, applicantID, r.URL.Query().Get("applicant_id"))
+		w.Header().Set("Content-Type", "application/json")
+		_, wErr := w.Write([]byte(`{"monitors": [{"id": "monitor-1", "report_name": "watchlist_standard"}]}`))
+		assert.NoError(t, wErr)
+	}).Methods("GET")
+	srv := httptest.NewServer(m)
+	defer srv.Close()
+
+	client := NewClient("123").(*client)
+	client.endpoint = srv.URL
+
+	var monitors []*WatchlistMonitor
+	it := client.ListWatchlistMonitors(applicantID)
+	for it.Next(context.Background()) {
+		monitors = append(monitors, it.WatchlistMonitor())
+	}
+	if it.Err() != nil {
+		t.Fatal(it.Err())
+	}
+	if assert.Len(t, monitors, 1) {
+		assert.Equal(t, ReportNameWatchlistStandard, monitors[0].ReportName)
+	}
+}
+
+func TestWatchlistMonitorMatches(t *testing.T) {
+	m := mux.NewRouter()
+	m.HandleFunc("/watchlist_monitors/{id}/matches", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "monitor-1", mux.Vars(r)["id"])
+		w.Header().Set("Content-Type", "application/json")
+		_, wErr := w.Write([]byte(`{"matches": [{"id": "match-1", "status": "enabled", "full_name": "John Doe", "sources": [{"source_name": "OFAC"}]}]}`))
+		assert.NoError(t, wErr)
+	}).Methods("GET")
+	m.HandleFunc("/watchlist_monitors/{id}/matches", func(w http.ResponseWriter, r *http.Request) {
+		var mu WatchlistMonitorMatchesUpdate
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&mu))
+		assert.Equal(t, []string{"match-1"}, mu.Disable)
+		assert.Empty(t, mu.Enable)
+
+		w.Header().Set("Content-Type", "application/json")
+		_, wErr := w.Write([]byte(`{"matches": [{"id": "match-1", "status": "disabled"}]}`))
+		assert.NoError(t, wErr)
+	}).Methods("PATCH")
+	srv := httptest.NewServer(m)
+	defer srv.Close()
+
+	client := NewClient("123").(*client)
+	client.endpoint = srv.URL
+	ctx := context.Background()
+
+	matches, err := client.ListMonitorMatches(ctx, "monitor-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if assert.Len(t, matches, 1) {
+		assert.Equal(t, WatchlistMonitorMatchStatusEnabled, matches[0].Status)
+		assert.Equal(t, "John Doe", matches[0].FullName)
+		assert.Equal(t, []WatchlistSource{{SourceName: "OFAC"}}, matches[0].Sources)
+	}
+
+	matches, err = client.UpdateMonitorMatches(ctx, "monitor-1", WatchlistMonitorMatchesUpdate{Disable: []string{"match-1"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if assert.Len(t, matches, 1) {
+		assert.Equal(t, WatchlistMonitorMatchStatusDisabled, matches[0].Status)
+	}
+}
+
+func TestWatchlistMonitor_ForceReportCreationAndDelete(t *testing.T) {
+	var calls []string
+	m := mux.NewRouter()
+	m.HandleFunc("/watchlist_monitors/{id}/new_report", func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, "new_report "+mux.Vars(r)["id"])
+		w.WriteHeader(http.StatusOK)
+	}).Methods("POST")
+	m.HandleFunc("/watchlist_monitors/{id}", func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, "delete "+mux.Vars(r)["id"])
+		w.WriteHeader(http.StatusNoContent)
+	}).Methods("DELETE")
+	srv := httptest.NewServer(m)
+	defer srv.Close()
+
+	client := NewClient("123").(*client)
+	client.endpoint = srv.URL
+	ctx := context.Background()
+
+	assert.NoError(t, client.ForceReportCreation(ctx, "monitor-1"))
+	assert.NoError(t, client.DeleteWatchlistMonitor(ctx, "monitor-1"))
+	assert.Equal(t, []string{"new_report monitor-1", "delete monitor-1"}, calls)
+}
+
+func TestWebhookRequestFetch_WatchlistMonitor(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/watchlist_monitors/monitor-1", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		_, wErr := w.Write([]byte(`{"id": "monitor-1"}`))
+		assert.NoError(t, wErr)
+	}))
+	defer srv.Close()
+
+	client := NewClient("123").(*client)
+	client.endpoint = srv.URL
+
+	var wr WebhookRequest
+	wr.Payload.ResourceType = WebhookResourceTypeWatchlistMonitor
+	wr.Payload.Action = string(WebhookEventWatchlistMonitorMatchesUpdated)
+	wr.Payload.Object.ID = "monitor-1"
+
+	v, err := wr.Fetch(context.Background(), client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "monitor-1", v.(*WatchlistMonitor).ID)
+}
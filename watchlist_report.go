@@ -0,0 +1,121 @@
+package onfido
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrNotWatchlistReport means that a report isn't a watchlist report
+var ErrNotWatchlistReport = errors.New("not a watchlist report")
+
+// Watchlist report breakdowns
+const (
+	WatchlistBreakdownSanction                   = "sanction"
+	WatchlistBreakdownPoliticallyExposedPerson   = "politically_exposed_person"
+	WatchlistBreakdownLegalAndRegulatoryWarnings = "legal_and_regulatory_warnings"
+	WatchlistBreakdownAdverseMedia               = "adverse_media"
+)
+
+// WatchlistReport is the typed view of a watchlist report (standard, enhanced,
+// AML, PEPs only or sanctions only), see Report.Watchlist.
+// see https://documentation.onfido.com/#watchlist-report
+type WatchlistReport struct {
+	*Report
+	// Sanction, PoliticallyExposedPerson, LegalAndRegulatoryWarnings and
+	// AdverseMedia are the results of the breakdowns, nil if not screened.
+	Sanction                   *BreakdownResult
+	PoliticallyExposedPerson   *BreakdownResult
+	LegalAndRegulatoryWarnings *BreakdownResult
+	AdverseMedia               *BreakdownResult
+	// Records are the watchlist entries matching the applicant.
+	Records []WatchlistRecord
+}
+
+// WatchlistRecord is a watchlist entry matching an applicant
+type WatchlistRecord struct {
+	FullName    string               `json:"full_name,omitempty"`
+	Position    string               `json:"position,omitempty"`
+	DateOfBirth []string             `json:"date_of_birth,omitempty"`
+	Address     []WatchlistAddress   `json:"address,omitempty"`
+	Aliases     []WatchlistAlias     `json:"aliases,omitempty"`
+	Associates  []WatchlistAssociate `json:"associates,omitempty"`
+	Attributes  []WatchlistAttribute `json:"attributes,omitempty"`
+	Events      []WatchlistEvent     `json:"events,omitempty"`
+	Sources     []WatchlistSource    `json:"sources,omitempty"`
+}
+
+// WatchlistAddress is an address of a watchlist record
+type WatchlistAddress struct {
+	AddressLine1  string `json:"address_line1,omitempty"`
+	Town          string `json:"town,omitempty"`
+	StateProvince string `json:"state_province,omitempty"`
+	PostalCode    string `json:"postal_code,omitempty"`
+	Country       string `json:"country,omitempty"`
+	LocatorType   string `json:"locator_type,omitempty"`
+}
+
+// WatchlistAlias is another name of a watchlist record
+type WatchlistAlias struct {
+	AliasName string `json:"alias_name,omitempty"`
+	AliasType string `json:"alias_type,omitempty"`
+}
+
+// WatchlistAssociate is a person or organisation associated with a watchlist record
+type WatchlistAssociate struct {
+	EntityName            string `json:"entity_name,omitempty"`
+	AssociationType       string `json:"association_type,omitempty"`
+	RelationshipType      string `json:"relationship_type,omitempty"`
+	RelationshipDirection string `json:"relationship_direction,omitempty"`
+}
+
+// WatchlistAttribute is an attribute of a watchlist record, e.g. its nationality
+type WatchlistAttribute struct {
+	AttributeName  string `json:"attribute_name,omitempty"`
+	AttributeValue string `json:"attribute_value,omitempty"`
+}
+
+// WatchlistEvent is an event which got a watchlist record listed, e.g. a sanction
+type WatchlistEvent struct {
+	Category         string           `json:"category,omitempty"`
+	SubCategory      string           `json:"sub_category,omitempty"`
+	EventDate        string           `json:"event_date,omitempty"`
+	EventDescription string           `json:"event_description,omitempty"`
+	Source           *WatchlistSource `json:"source,omitempty"`
+}
+
+// WatchlistSource is a source of a watchlist record, e.g. a sanctions list or an article
+type WatchlistSource struct {
+	SourceName     string `json:"source_name,omitempty"`
+	SourceHeadline string `json:"source_headline,omitempty"`
+	SourceURL      string `json:"source_url,omitempty"`
+	SourceFormat   string `json:"source_format,omitempty"`
+	SourceDate     string `json:"source_date,omitempty"`
+}
+
+// Watchlist returns the typed view of a watchlist report, or
+// ErrNotWatchlistReport if the report has another name.
+func (r *Report) Watchlist() (*WatchlistReport, error) {
+	if !strings.HasPrefix(string(r.Name), "watchlist_") {
+		return nil, fmt.Errorf("%w: %s", ErrNotWatchlistReport, r.Name)
+	}
+
+	wr := &WatchlistReport{
+		Report:                     r,
+		Sanction:                   r.Breakdown[WatchlistBreakdownSanction].Result,
+		PoliticallyExposedPerson:   r.Breakdown[WatchlistBreakdownPoliticallyExposedPerson].Result,
+		LegalAndRegulatoryWarnings: r.Breakdown[WatchlistBreakdownLegalAndRegulatoryWarnings].Result,
+		AdverseMedia:               r.Breakdown[WatchlistBreakdownAdverseMedia].Result,
+	}
+	if records, ok := r.Properties["records"]; ok {
+		b, err := json.Marshal(records)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(b, &wr.Records); err != nil {
+			return nil, fmt.Errorf("invalid watchlist records: %w", err)
+		}
+	}
+	return wr, nil
+}
@@ -0,0 +1,61 @@
+package onfido
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReport_Watchlist(t *testing.T) {
+	var r Report
+	err := json.Unmarshal([]byte(`{
+		"id": "report-1",
+		"name": "watchlist_standard",
+		"result": "consider",
+		"breakdown": {
+			"sanction": {"result": "consider"},
+			"politically_exposed_person": {"result": "clear"}
+		},
+		"properties": {
+			"records": [{
+				"full_name": "John Doe",
+				"date_of_birth": ["1970-01-01"],
+				"aliases": [{"alias_name": "Johnny Doe", "alias_type": "AKA"}],
+				"events": [{"category": "SAN", "source": {"source_name": "OFAC"}}]
+			}]
+		}
+	}`), &r)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wr, err := r.Watchlist()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "report-1", wr.ID)
+	if assert.NotNil(t, wr.Sanction) {
+		assert.Equal(t, BreakdownConsider, *wr.Sanction)
+	}
+	if assert.NotNil(t, wr.PoliticallyExposedPerson) {
+		assert.Equal(t, BreakdownClear, *wr.PoliticallyExposedPerson)
+	}
+	assert.Nil(t, wr.AdverseMedia, "not screened")
+	if assert.Len(t, wr.Records, 1) {
+		rec := wr.Records[0]
+		assert.Equal(t, "John Doe", rec.FullName)
+		assert.Equal(t, []string{"1970-01-01"}, rec.DateOfBirth)
+		assert.Equal(t, []WatchlistAlias{{AliasName: "Johnny Doe", AliasType: "AKA"}}, rec.Aliases)
+		if assert.Len(t, rec.Events, 1) {
+			assert.Equal(t, "OFAC", rec.Events[0].Source.SourceName)
+		}
+	}
+}
+
+func TestReport_Watchlist_OtherReport(t *testing.T) {
+	r := Report{Name: ReportNameDocument}
+	_, err := r.Watchlist()
+	assert.True(t, errors.Is(err, ErrNotWatchlistReport))
+}
@@ -1,13 +1,17 @@
 package onfido
 
 import (
+	"context"
 	"crypto/hmac"
 	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
+	"net/url"
 	"os"
 )
 
@@ -22,18 +26,29 @@ var _ Webhook = &webhook{}
 const (
 	WebhookSignatureHeader = "X-Sha2-Signature"
 	WebhookTokenEnv        = "ONFIDO_WEBHOOK_TOKEN"
+	// DefaultWebhookMaxBodySize is the default limit of webhook request bodies, see WithMaxBodySize.
+	DefaultWebhookMaxBodySize = 1 << 20
+
+	WebhookResourceTypeCheck            = "check"
+	WebhookResourceTypeReport           = "report"
+	WebhookResourceTypeWorkflowRun      = "workflow_run"
+	WebhookResourceTypeWatchlistMonitor = "watchlist_monitor"
 )
 
 // Webhook errors
 var (
-	ErrInvalidWebhookSignature = errors.New("invalid request, payload hash doesn't match signature")
-	ErrMissingWebhookToken     = errors.New("webhook token not found in environmental variable")
+	ErrInvalidWebhookSignature    = errors.New("invalid request, payload hash doesn't match signature")
+	ErrMissingWebhookToken        = errors.New("webhook token not found in environmental variable")
+	ErrUnsupportedWebhookResource = errors.New("unsupported webhook resource type")
+	ErrWebhookBodyTooLarge        = errors.New("invalid request, body too large")
 )
 
 // Webhook represents a webhook handler
 type webhook struct {
 	Token                   string
 	SkipSignatureValidation bool
+	replay                  *replayGuard
+	maxBodySize             int64
 }
 
 // WebhookRequest represents an incoming webhook request from Onfido
@@ -48,22 +63,38 @@ type WebhookRequest struct {
 			Href        string `json:"href"`
 		} `json:"object"`
 	} `json:"payload"`
+
+	// nonce identifies the request in the replay guard's cache.
+	nonce string
 }
 
 // NewWebhookFromEnv creates a new webhook handler using
 // configuration from environment variables.
-func NewWebhookFromEnv() (Webhook, error) {
+func NewWebhookFromEnv(opts ...WebhookOption) (Webhook, error) {
 	token := os.Getenv(WebhookTokenEnv)
 	if token == "" {
 		return nil, ErrMissingWebhookToken
 	}
-	return NewWebhook(token), nil
+	return NewWebhook(token, opts...), nil
 }
 
 // NewWebhook creates a new webhook handler
-func NewWebhook(token string) Webhook {
-	return &webhook{
-		Token: token,
+func NewWebhook(token string, opts ...WebhookOption) Webhook {
+	wh := &webhook{
+		Token:       token,
+		maxBodySize: DefaultWebhookMaxBodySize,
+	}
+	for _, opt := range opts {
+		opt(wh)
+	}
+	return wh
+}
+
+// WithMaxBodySize limits the size of the webhook request bodies read by
+// ParseFromRequest, larger requests are rejected with ErrWebhookBodyTooLarge.
+func WithMaxBodySize(n int64) WebhookOption {
+	return func(wh *webhook) {
+		wh.maxBodySize = n
 	}
 }
 
@@ -85,12 +116,19 @@ func (wh *webhook) ValidateSignature(body []byte, signature string) error {
 // ParseFromRequest parses the webhook request body and returns
 // it as WebhookRequest if the request signature is valid.
 func (wh *webhook) ParseFromRequest(req *http.Request) (*WebhookRequest, error) {
-	body, err := ioutil.ReadAll(req.Body)
+	limit := wh.maxBodySize
+	if limit <= 0 {
+		limit = DefaultWebhookMaxBodySize
+	}
+	body, err := ioutil.ReadAll(io.LimitReader(req.Body, limit+1))
 	defer req.Body.Close()
 
 	if err != nil {
 		return nil, err
 	}
+	if int64(len(body)) > limit {
+		return nil, ErrWebhookBodyTooLarge
+	}
 
 	if !wh.SkipSignatureValidation {
 		signature := req.Header.Get(WebhookSignatureHeader)
@@ -108,5 +146,75 @@ func (wh *webhook) ParseFromRequest(req *http.Request) (*WebhookRequest, error)
 		return nil, err
 	}
 
+	if wh.replay != nil {
+		if err := wh.replay.check(body, &wr); err != nil {
+			return nil, err
+		}
+	}
+
 	return &wr, nil
 }
+
+// Fetch retrieves the resource referenced by the webhook as its typed value:
+// a *Check (with expanded reports), a *Report, a *WorkflowRun or a *WatchlistMonitor.
+// The resource is retrieved from its href with GetResource, so in its own
+// region, or else by ID against the client's endpoint.
+func (wr *WebhookRequest) Fetch(ctx context.Context, c OnfidoClient) (interface{}, error) {
+	href := wr.Payload.Object.Href
+	if href == "" {
+		return wr.fetchByID(ctx, c)
+	}
+
+	switch wr.Payload.ResourceType {
+	case WebhookResourceTypeCheck:
+		var chkRetrieved CheckRetrieved
+		if err := c.GetResource(ctx, href, &chkRetrieved); err != nil {
+			return nil, err
+		}
+		base, err := url.Parse(href)
+		if err != nil {
+			return nil, err
+		}
+		check := unexpandedCheck(&chkRetrieved)
+		for i, reportID := range chkRetrieved.Reports {
+			// Reports are siblings of checks, e.g. /v3.5/checks/1 and /v3.5/reports/2.
+			reportHref := base.ResolveReference(&url.URL{Path: "../reports/" + reportID})
+			var rep Report
+			if err := c.GetResource(ctx, reportHref.String(), &rep); err != nil {
+				return nil, err
+			}
+			check.Reports[i] = &rep
+		}
+		return check, nil
+	case WebhookResourceTypeReport:
+		var rep Report
+		return &rep, c.GetResource(ctx, href, &rep)
+	case WebhookResourceTypeWorkflowRun:
+		var run WorkflowRun
+		return &run, c.GetResource(ctx, href, &run)
+	case WebhookResourceTypeWatchlistMonitor:
+		var monitor WatchlistMonitor
+		return &monitor, c.GetResource(ctx, href, &monitor)
+	}
+	return nil, fmt.Errorf("%w: %s", ErrUnsupportedWebhookResource, wr.Payload.ResourceType)
+}
+
+// fetchByID retrieves the resource of a webhook object which has no href by its ID.
+func (wr *WebhookRequest) fetchByID(ctx context.Context, c OnfidoClient) (interface{}, error) {
+	id := wr.Payload.Object.ID
+	if id == "" {
+		return nil, errors.New("webhook object has no id or href")
+	}
+
+	switch wr.Payload.ResourceType {
+	case WebhookResourceTypeCheck:
+		return c.GetCheckExpanded(ctx, id)
+	case WebhookResourceTypeReport:
+		return c.GetReport(ctx, id)
+	case WebhookResourceTypeWorkflowRun:
+		return c.GetWorkflowRun(ctx, id)
+	case WebhookResourceTypeWatchlistMonitor:
+		return c.GetWatchlistMonitor(ctx, id)
+	}
+	return nil, fmt.Errorf("%w: %s", ErrUnsupportedWebhookResource, wr.Payload.ResourceType)
+}
@@ -0,0 +1,72 @@
+package onfido
+
+import (
+	"context"
+	"sync"
+)
+
+// DedupStore records which webhook events have been handled, so that
+// redelivered and recovered events are only processed once.
+type DedupStore interface {
+	// Seen reports whether the event with the given key has been handled.
+	Seen(ctx context.Context, key string) (bool, error)
+	// Mark records the event with the given key as handled.
+	Mark(ctx context.Context, key string) error
+}
+
+// WebhookEventKey returns the deduplication key of a webhook event,
+// e.g. "check.completed:<check id>".
+func WebhookEventKey(action, objectID string) string {
+	return action + ":" + objectID
+}
+
+// Key returns the deduplication key of the webhook event.
+func (wr *WebhookRequest) Key() string {
+	return WebhookEventKey(wr.Payload.Action, wr.Payload.Object.ID)
+}
+
+// MemoryDedupStore is an in-memory DedupStore, mostly useful for tests
+// and single instance deployments.
+type MemoryDedupStore struct {
+	mu   sync.Mutex
+	seen map[string]struct{}
+}
+
+var _ DedupStore = &MemoryDedupStore{}
+
+// NewMemoryDedupStore creates an empty in-memory DedupStore.
+func NewMemoryDedupStore() *MemoryDedupStore {
+	return &MemoryDedupStore{seen: make(map[string]struct{})}
+}
+
+// Seen reports whether the event with the given key has been handled.
+func (s *MemoryDedupStore) Seen(_ context.Context, key string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, ok := s.seen[key]
+	return ok, nil
+}
+
+// Mark records the event with the given key as handled.
+func (s *MemoryDedupStore) Mark(_ context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.seen[key] = struct{}{}
+	return nil
+}
+
+// DedupWebhookProcessFunc wraps fn so that events already marked in the store are skipped
+// and events are marked once fn succeeds.
+func DedupWebhookProcessFunc(store DedupStore, fn WebhookProcessFunc) WebhookProcessFunc {
+	return func(ctx context.Context, wr *WebhookRequest) error {
+		key := wr.Key()
+		seen, err := store.Seen(ctx, key)
+		if err != nil || seen {
+			return err
+		}
+		if err := fn(ctx, wr); err != nil {
+			return err
+		}
+		return store.Mark(ctx, key)
+	}
+}
@@ -0,0 +1,36 @@
+package onfido
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDedupWebhookProcessFunc(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryDedupStore()
+
+	calls := 0
+	fail := true
+	fn := DedupWebhookProcessFunc(store, func(ctx context.Context, wr *WebhookRequest) error {
+		calls++
+		if fail {
+			return errors.New("failed")
+		}
+		return nil
+	})
+
+	wr := newRecoveredWebhook(WebhookResourceTypeCheck, WebhookEventCheckCompleted, "check-1", "complete", "")
+	assert.Error(t, fn(ctx, wr))
+
+	fail = false
+	assert.NoError(t, fn(ctx, wr))
+	assert.NoError(t, fn(ctx, wr))
+	assert.Equal(t, 2, calls)
+
+	seen, err := store.Seen(ctx, "check.completed:check-1")
+	assert.NoError(t, err)
+	assert.True(t, seen)
+}
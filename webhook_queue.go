@@ -0,0 +1,499 @@
+package onfido
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// Webhook queue errors
+var (
+	ErrWebhookQueueClosed = errors.New("webhook queue is closed")
+)
+
+// Webhook queue defaults
+const (
+	DefaultWebhookWorkers         = 4
+	DefaultWebhookMaxAttempts     = 5
+	DefaultWebhookShutdownTimeout = 30 * time.Second
+)
+
+// QueuedWebhook represents a verified webhook event stored in a WebhookQueue
+type QueuedWebhook struct {
+	ID         string          `json:"id"`
+	Body       json.RawMessage `json:"body"`
+	ReceivedAt time.Time       `json:"received_at"`
+	Attempts   int             `json:"attempts"`
+	LastError  string          `json:"last_error,omitempty"`
+}
+
+// WebhookQueue represents a durable queue of verified webhook events.
+// Items which are dequeued but never acked, retried or dead-lettered
+// must be delivered again once the queue is reopened.
+type WebhookQueue interface {
+	// Enqueue durably stores a verified webhook request body.
+	Enqueue(ctx context.Context, body []byte) error
+	// Dequeue blocks until an item is available or the context is done.
+	Dequeue(ctx context.Context) (*QueuedWebhook, error)
+	// Ack removes a successfully processed item from the queue.
+	Ack(item *QueuedWebhook) error
+	// Retry records a failed attempt and makes the item available again after delay.
+	Retry(item *QueuedWebhook, delay time.Duration) error
+	// DeadLetter moves an item which can't be processed out of the queue.
+	DeadLetter(item *QueuedWebhook) error
+	Close() error
+}
+
+var _ WebhookQueue = &FileWebhookQueue{}
+
+type walRecord struct {
+	Op   string         `json:"op"`
+	Item *QueuedWebhook `json:"item"`
+}
+
+const (
+	walOpPut   = "put"
+	walOpAck   = "ack"
+	walOpRetry = "retry"
+	walOpDead  = "dead"
+)
+
+// FileWebhookQueue is a WebhookQueue backed by an append-only log file.
+// Every change is synced to disk before the call returns.
+type FileWebhookQueue struct {
+	mu      sync.Mutex
+	f       *os.File
+	pending map[string]*QueuedWebhook
+	ready   []*QueuedWebhook
+	dead    []*QueuedWebhook
+	timers  map[string]*time.Timer
+	notify  chan struct{}
+	closed  bool
+}
+
+// OpenFileWebhookQueue opens (or creates) a file backed webhook queue in dir.
+// The log is compacted on open and every pending item is made available again.
+func OpenFileWebhookQueue(dir string) (*FileWebhookQueue, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+	path := filepath.Join(dir, "webhooks.wal")
+
+	q := &FileWebhookQueue{
+		pending: make(map[string]*QueuedWebhook),
+		timers:  make(map[string]*time.Timer),
+		notify:  make(chan struct{}, 1),
+	}
+	order, err := q.replay(path)
+	if err != nil {
+		return nil, err
+	}
+	if err := q.compact(path, order); err != nil {
+		return nil, err
+	}
+
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return nil, err
+	}
+	q.f = f
+	q.signal()
+	return q, nil
+}
+
+func (q *FileWebhookQueue) replay(path string) ([]string, error) {
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var order []string
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		var rec walRecord
+		if err := json.Unmarshal(scanner.Bytes(), &rec); err != nil || rec.Item == nil {
+			// A torn write at the end of the log, the item was never acknowledged.
+			continue
+		}
+		switch rec.Op {
+		case walOpPut:
+			q.pending[rec.Item.ID] = rec.Item
+			order = append(order, rec.Item.ID)
+		case walOpRetry:
+			if _, ok := q.pending[rec.Item.ID]; ok {
+				q.pending[rec.Item.ID] = rec.Item
+			}
+		case walOpAck:
+			delete(q.pending, rec.Item.ID)
+		case walOpDead:
+			delete(q.pending, rec.Item.ID)
+			q.dead = append(q.dead, rec.Item)
+		}
+	}
+	return order, scanner.Err()
+}
+
+func (q *FileWebhookQueue) compact(path string, order []string) error {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	for _, item := range q.dead {
+		if err := enc.Encode(walRecord{Op: walOpDead, Item: item}); err != nil {
+			return err
+		}
+	}
+	for _, id := range order {
+		item, ok := q.pending[id]
+		if !ok {
+			continue
+		}
+		if err := enc.Encode(walRecord{Op: walOpPut, Item: item}); err != nil {
+			return err
+		}
+		q.ready = append(q.ready, item)
+	}
+
+	tmp := path + ".tmp"
+	if err := ioutil.WriteFile(tmp, buf.Bytes(), 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+func (q *FileWebhookQueue) append(op string, item *QueuedWebhook) error {
+	if q.closed {
+		return ErrWebhookQueueClosed
+	}
+	line, err := json.Marshal(walRecord{Op: op, Item: item})
+	if err != nil {
+		return err
+	}
+	if _, err := q.f.Write(append(line, '\n')); err != nil {
+		return err
+	}
+	return q.f.Sync()
+}
+
+func (q *FileWebhookQueue) signal() {
+	select {
+	case q.notify <- struct{}{}:
+	default:
+	}
+}
+
+// Enqueue durably stores a verified webhook request body.
+func (q *FileWebhookQueue) Enqueue(ctx context.Context, body []byte) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	id, err := newQueueID()
+	if err != nil {
+		return err
+	}
+	item := &QueuedWebhook{
+		ID:         id,
+		Body:       append(json.RawMessage(nil), body...),
+		ReceivedAt: time.Now().UTC(),
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if err := q.append(walOpPut, item); err != nil {
+		return err
+	}
+	q.pending[item.ID] = item
+	q.ready = append(q.ready, item)
+	q.signal()
+	return nil
+}
+
+// Dequeue blocks until an item is available or the context is done.
+func (q *FileWebhookQueue) Dequeue(ctx context.Context) (*QueuedWebhook, error) {
+	for {
+		q.mu.Lock()
+		if q.closed {
+			q.mu.Unlock()
+			return nil, ErrWebhookQueueClosed
+		}
+		if len(q.ready) > 0 {
+			item := q.ready[0]
+			q.ready = q.ready[1:]
+			if len(q.ready) > 0 {
+				q.signal()
+			}
+			q.mu.Unlock()
+			return item, nil
+		}
+		q.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-q.notify:
+		}
+	}
+}
+
+// Ack removes a successfully processed item from the queue.
+func (q *FileWebhookQueue) Ack(item *QueuedWebhook) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if err := q.append(walOpAck, &QueuedWebhook{ID: item.ID}); err != nil {
+		return err
+	}
+	delete(q.pending, item.ID)
+	return nil
+}
+
+// Retry records a failed attempt and makes the item available again after delay.
+func (q *FileWebhookQueue) Retry(item *QueuedWebhook, delay time.Duration) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if err := q.append(walOpRetry, item); err != nil {
+		return err
+	}
+	q.pending[item.ID] = item
+	q.timers[item.ID] = time.AfterFunc(delay, func() {
+		q.mu.Lock()
+		defer q.mu.Unlock()
+		delete(q.timers, item.ID)
+		if q.closed {
+			return
+		}
+		q.ready = append(q.ready, item)
+		q.signal()
+	})
+	return nil
+}
+
+// DeadLetter moves an item which can't be processed out of the queue.
+func (q *FileWebhookQueue) DeadLetter(item *QueuedWebhook) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if err := q.append(walOpDead, item); err != nil {
+		return err
+	}
+	delete(q.pending, item.ID)
+	q.dead = append(q.dead, item)
+	return nil
+}
+
+// DeadLetters returns the items which have been dead-lettered.
+func (q *FileWebhookQueue) DeadLetters() []*QueuedWebhook {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return append([]*QueuedWebhook(nil), q.dead...)
+}
+
+// Len returns the number of items which haven't been acked or dead-lettered.
+func (q *FileWebhookQueue) Len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return len(q.pending)
+}
+
+// Close stops pending retries and closes the log file.
+// Unacknowledged items are delivered again when the queue is reopened.
+func (q *FileWebhookQueue) Close() error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.closed {
+		return nil
+	}
+	q.closed = true
+	for _, t := range q.timers {
+		t.Stop()
+	}
+	close(q.notify)
+	return q.f.Close()
+}
+
+func newQueueID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// NewQueuedWebhookHandler returns an http.Handler which verifies incoming webhook
+// requests, stores them in the queue and acknowledges them immediately.
+// The events are processed asynchronously by a WebhookProcessor. Bodies larger
+// than the webhook's limit, see WithMaxBodySize, are rejected with 413.
+func NewQueuedWebhookHandler(wh Webhook, q WebhookQueue) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		limit := int64(DefaultWebhookMaxBodySize)
+		if hw, ok := wh.(*webhook); ok && hw.maxBodySize > 0 {
+			limit = hw.maxBodySize
+		}
+		body, err := ioutil.ReadAll(io.LimitReader(req.Body, limit+1))
+		req.Body.Close()
+		if err != nil {
+			http.Error(w, "unable to read request body", http.StatusBadRequest)
+			return
+		}
+		if int64(len(body)) > limit {
+			http.Error(w, ErrWebhookBodyTooLarge.Error(), http.StatusRequestEntityTooLarge)
+			return
+		}
+
+		req.Body = ioutil.NopCloser(bytes.NewReader(body))
+		wr, err := wh.ParseFromRequest(req)
+		if err != nil {
+			if errors.Is(err, ErrWebhookReplay) {
+				// Already received, acknowledge so it isn't delivered again.
+				w.WriteHeader(http.StatusOK)
+				return
+			}
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		if err := q.Enqueue(req.Context(), body); err != nil {
+			// Let Onfido's retry through the replay guard.
+			if r, ok := wh.(WebhookReleaser); ok {
+				r.Release(wr)
+			}
+			http.Error(w, "unable to queue webhook", http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+}
+
+// WebhookProcessFunc processes a single webhook event.
+type WebhookProcessFunc func(ctx context.Context, wr *WebhookRequest) error
+
+// WebhookProcessor processes queued webhook events with a pool of workers.
+type WebhookProcessor struct {
+	Queue   WebhookQueue
+	Process WebhookProcessFunc
+	// Workers is the number of concurrent workers, defaults to DefaultWebhookWorkers.
+	Workers int
+	// MaxAttempts is the number of attempts before an event is dead-lettered,
+	// defaults to DefaultWebhookMaxAttempts.
+	MaxAttempts int
+	// Backoff returns the delay before the given attempt is retried,
+	// defaults to an exponential backoff starting at one second.
+	Backoff func(attempt int) time.Duration
+	// ShutdownTimeout bounds how long in-flight events may run once Run's
+	// context is done, defaults to DefaultWebhookShutdownTimeout.
+	ShutdownTimeout time.Duration
+	// OnError, if set, is called for each failed attempt.
+	OnError func(item *QueuedWebhook, err error)
+}
+
+// Run processes events until the context is done, then waits for in-flight
+// events to finish. Events which don't finish remain in the queue.
+func (p *WebhookProcessor) Run(ctx context.Context) error {
+	if p.Queue == nil || p.Process == nil {
+		return errors.New("webhook processor requires a queue and a process func")
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	workers := p.Workers
+	if workers <= 0 {
+		workers = DefaultWebhookWorkers
+	}
+	timeout := p.ShutdownTimeout
+	if timeout <= 0 {
+		timeout = DefaultWebhookShutdownTimeout
+	}
+
+	// In-flight events get their own context so they aren't interrupted as soon as
+	// shutdown starts, it is only cancelled once the shutdown timeout elapses.
+	workCtx, cancelWork := context.WithCancel(context.Background())
+	defer cancelWork()
+	go func() {
+		<-ctx.Done()
+		t := time.NewTimer(timeout)
+		defer t.Stop()
+		select {
+		case <-t.C:
+			cancelWork()
+		case <-workCtx.Done():
+		}
+	}()
+
+	var wg sync.WaitGroup
+	errs := make(chan error, workers)
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				item, err := p.Queue.Dequeue(ctx)
+				if err != nil {
+					if ctx.Err() == nil {
+						errs <- err
+						cancel()
+					}
+					return
+				}
+				if err := p.handle(workCtx, item); err != nil {
+					errs <- err
+					cancel()
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	return <-errs
+}
+
+func (p *WebhookProcessor) handle(ctx context.Context, item *QueuedWebhook) error {
+	var wr WebhookRequest
+	err := json.Unmarshal(item.Body, &wr)
+	permanent := err != nil // a body which can't be decoded will never succeed
+	if err == nil {
+		if err = p.Process(ctx, &wr); err == nil {
+			return p.Queue.Ack(item)
+		}
+	}
+
+	item.Attempts++
+	item.LastError = err.Error()
+	if p.OnError != nil {
+		p.OnError(item, err)
+	}
+	if permanent || item.Attempts >= p.maxAttempts() {
+		return p.Queue.DeadLetter(item)
+	}
+	return p.Queue.Retry(item, p.backoff(item.Attempts))
+}
+
+func (p *WebhookProcessor) maxAttempts() int {
+	if p.MaxAttempts <= 0 {
+		return DefaultWebhookMaxAttempts
+	}
+	return p.MaxAttempts
+}
+
+func (p *WebhookProcessor) backoff(attempt int) time.Duration {
+	if p.Backoff != nil {
+		return p.Backoff(attempt)
+	}
+	d := time.Second << uint(attempt-1)
+	if max := 5 * time.Minute; d > max || d <= 0 {
+		return max
+	}
+	return d
+}
@@ -0,0 +1,211 @@
+package onfido
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func signWebhookBody(token string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(token))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func newTestWebhookQueue(t *testing.T) (*FileWebhookQueue, string) {
+	dir, err := ioutil.TempDir("", "onfido-webhooks")
+	if err != nil {
+		t.Fatal(err)
+	}
+	q, err := OpenFileWebhookQueue(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return q, dir
+}
+
+func TestFileWebhookQueue_PendingItemsSurviveReopen(t *testing.T) {
+	q, dir := newTestWebhookQueue(t)
+	defer os.RemoveAll(dir)
+	ctx := context.Background()
+
+	assert.NoError(t, q.Enqueue(ctx, []byte(`{"n":1}`)))
+	assert.NoError(t, q.Enqueue(ctx, []byte(`{"n":2}`)))
+	assert.NoError(t, q.Enqueue(ctx, []byte(`{"n":3}`)))
+
+	first, err := q.Dequeue(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.NoError(t, q.Ack(first))
+	second, err := q.Dequeue(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.NoError(t, q.DeadLetter(second))
+	// The third item is dequeued but never acked.
+	_, err = q.Dequeue(ctx)
+	assert.NoError(t, err)
+	assert.NoError(t, q.Close())
+
+	q, err = OpenFileWebhookQueue(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer q.Close()
+
+	assert.Equal(t, 1, q.Len())
+	assert.Len(t, q.DeadLetters(), 1)
+	item, err := q.Dequeue(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.JSONEq(t, `{"n":3}`, string(item.Body))
+}
+
+func TestWebhookProcessor_RetriesAndDeadLetters(t *testing.T) {
+	q, dir := newTestWebhookQueue(t)
+	defer os.RemoveAll(dir)
+	defer q.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	assert.NoError(t, q.Enqueue(ctx, []byte(`{"payload":{"resource_type":"check","object":{"id":"ok"}}}`)))
+	assert.NoError(t, q.Enqueue(ctx, []byte(`{"payload":{"resource_type":"check","object":{"id":"fail"}}}`)))
+
+	var mu sync.Mutex
+	attempts := make(map[string]int)
+	p := &WebhookProcessor{
+		Queue:       q,
+		Workers:     2,
+		MaxAttempts: 3,
+		Backoff:     func(int) time.Duration { return time.Millisecond },
+		Process: func(ctx context.Context, wr *WebhookRequest) error {
+			mu.Lock()
+			defer mu.Unlock()
+			id := wr.Payload.Object.ID
+			attempts[id]++
+			if id == "ok" && attempts[id] > 1 {
+				return nil
+			}
+			return errors.New("temporary failure")
+		},
+	}
+
+	done := make(chan error)
+	go func() { done <- p.Run(ctx) }()
+	for deadline := time.Now().Add(5 * time.Second); q.Len() > 0 && time.Now().Before(deadline); {
+		time.Sleep(time.Millisecond)
+	}
+	cancel()
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, 0, q.Len())
+	assert.Equal(t, 2, attempts["ok"])
+	assert.Equal(t, 3, attempts["fail"])
+	dead := q.DeadLetters()
+	if assert.Len(t, dead, 1) {
+		assert.Equal(t, 3, dead[0].Attempts)
+		assert.Equal(t, "temporary failure", dead[0].LastError)
+	}
+}
+
+func TestQueuedWebhookHandler(t *testing.T) {
+	q, dir := newTestWebhookQueue(t)
+	defer os.RemoveAll(dir)
+	defer q.Close()
+
+	handler := NewQueuedWebhookHandler(NewWebhook("abc123"), q)
+	body := []byte(`{"payload":{"resource_type":"check"}}`)
+
+	req := httptest.NewRequest(http.MethodPost, "/webhook", bytes.NewReader(body))
+	req.Header.Set(WebhookSignatureHeader, "invalid")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Equal(t, 0, q.Len())
+
+	req = httptest.NewRequest(http.MethodPost, "/webhook", bytes.NewReader(body))
+	req.Header.Set(WebhookSignatureHeader, signWebhookBody("abc123", body))
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, 1, q.Len())
+}
+
+func TestQueuedWebhookHandler_BodyTooLarge(t *testing.T) {
+	q, dir := newTestWebhookQueue(t)
+	defer os.RemoveAll(dir)
+	defer q.Close()
+
+	handler := NewQueuedWebhookHandler(NewWebhook("abc123", WithMaxBodySize(64)), q)
+	body := []byte(`{"payload":{"resource_type":"check","object":{"id":"` + strings.Repeat("a", 64) + `"}}}`)
+
+	req := httptest.NewRequest(http.MethodPost, "/webhook", bytes.NewReader(body))
+	req.Header.Set(WebhookSignatureHeader, signWebhookBody("abc123", body))
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+	assert.Equal(t, 0, q.Len())
+}
+
+// failingWebhookQueue fails to enqueue the first failures bodies.
+type failingWebhookQueue struct {
+	WebhookQueue
+	failures int
+}
+
+func (q *failingWebhookQueue) Enqueue(ctx context.Context, body []byte) error {
+	if q.failures > 0 {
+		q.failures--
+		return errors.New("disk full")
+	}
+	return q.WebhookQueue.Enqueue(ctx, body)
+}
+
+func TestQueuedWebhookHandler_ReplayGuard(t *testing.T) {
+	fq, dir := newTestWebhookQueue(t)
+	defer os.RemoveAll(dir)
+	defer fq.Close()
+	q := &failingWebhookQueue{WebhookQueue: fq, failures: 1}
+
+	handler := NewQueuedWebhookHandler(NewWebhook("abc123", WithReplayGuard(time.Minute, nil)), q)
+	deliver := func(body []byte) int {
+		req := httptest.NewRequest(http.MethodPost, "/webhook", bytes.NewReader(body))
+		req.Header.Set(WebhookSignatureHeader, signWebhookBody("abc123", body))
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	// The retry of a delivery which failed to be queued isn't a replay.
+	body := timedWebhookBody("1")
+	assert.Equal(t, http.StatusInternalServerError, deliver(body))
+	assert.Equal(t, 0, fq.Len())
+	assert.Equal(t, http.StatusOK, deliver(body))
+	assert.Equal(t, 1, fq.Len())
+
+	// A replay is acknowledged without being queued again.
+	assert.Equal(t, http.StatusOK, deliver(body))
+	assert.Equal(t, 1, fq.Len())
+
+	// A stale delivery isn't acknowledged.
+	stale := []byte(`{"payload":{"resource_type":"check","object":{"id":"2","completed_at_iso8601":"2020-06-01T11:50:00Z"}}}`)
+	assert.Equal(t, http.StatusBadRequest, deliver(stale))
+	assert.Equal(t, 1, fq.Len())
+}
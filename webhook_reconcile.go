@@ -0,0 +1,245 @@
+package onfido
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// WebhookPlanAction represents an action taken by the webhook reconciler
+// (see `WebhookPlanAction*` constants for possible values)
+type WebhookPlanAction string
+
+// Supported webhook plan actions
+const (
+	WebhookPlanActionCreate WebhookPlanAction = "create"
+	WebhookPlanActionUpdate WebhookPlanAction = "update"
+	WebhookPlanActionDelete WebhookPlanAction = "delete"
+)
+
+// ReconcileWebhooksOptions configures ReconcileWebhooks.
+type ReconcileWebhooksOptions struct {
+	// DryRun computes the plan without applying it.
+	DryRun bool
+	// DeleteUnmanaged deletes existing webhooks whose URL is not in the desired list.
+	DeleteUnmanaged bool
+	// Out, if set, receives a human readable description of the plan.
+	Out io.Writer
+}
+
+// WebhookPlanItem represents a single change computed by ReconcileWebhooks
+type WebhookPlanItem struct {
+	Action WebhookPlanAction
+	// Current is the existing webhook, nil when creating.
+	Current *WebhookRef
+	// Desired is the requested webhook, nil when deleting.
+	Desired *WebhookRefRequest
+	// Result is the webhook returned by Onfido once the item is applied.
+	Result *WebhookRef
+}
+
+// URL returns the URL of the webhook the item applies to.
+func (i *WebhookPlanItem) URL() string {
+	if i.Desired != nil {
+		return i.Desired.URL
+	}
+	return i.Current.URL
+}
+
+// String returns a one line description of the item.
+func (i *WebhookPlanItem) String() string {
+	switch i.Action {
+	case WebhookPlanActionCreate:
+		return fmt.Sprintf("+ create %s enabled=%t environments=%v events=%v",
+			i.Desired.URL, i.Desired.Enabled, i.Desired.Environments, i.Desired.Events)
+	case WebhookPlanActionUpdate:
+		return fmt.Sprintf("~ update %s (%s) %s", i.Desired.URL, i.Current.ID, strings.Join(webhookDiff(i.Current, i.Desired), " "))
+	case WebhookPlanActionDelete:
+		return fmt.Sprintf("- delete %s (%s)", i.Current.URL, i.Current.ID)
+	}
+	return string(i.Action)
+}
+
+// WebhookPlan represents the changes needed to bring the registered
+// webhooks in line with the desired configuration.
+type WebhookPlan struct {
+	Items []*WebhookPlanItem
+	// Applied is false when the plan was computed in dry-run mode.
+	Applied bool
+}
+
+// String returns a human readable description of the plan.
+func (p *WebhookPlan) String() string {
+	if len(p.Items) == 0 {
+		return "webhooks are up to date\n"
+	}
+	var sb strings.Builder
+	for _, item := range p.Items {
+		sb.WriteString(item.String())
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
+
+// Tokens returns the signing tokens of the webhooks created by the plan, keyed by URL.
+// Onfido only returns a webhook token on creation, so these should be stored.
+func (p *WebhookPlan) Tokens() map[string]string {
+	tokens := make(map[string]string)
+	for _, item := range p.Items {
+		if item.Action == WebhookPlanActionCreate && item.Result != nil {
+			tokens[item.Result.URL] = item.Result.Token
+		}
+	}
+	return tokens
+}
+
+// ReconcileWebhooks lists the registered webhooks and creates, updates and (optionally)
+// deletes webhooks so that they match the desired list. Webhooks are matched by URL.
+// Empty Environments or Events on a desired webhook are not compared, as Onfido
+// defaults them to all values.
+func ReconcileWebhooks(ctx context.Context, c OnfidoClient, desired []WebhookRefRequest, opts ReconcileWebhooksOptions) (*WebhookPlan, error) {
+	plan, err := PlanWebhooks(ctx, c, desired, opts.DeleteUnmanaged)
+	if err != nil {
+		return nil, err
+	}
+	if opts.Out != nil {
+		if _, err := io.WriteString(opts.Out, plan.String()); err != nil {
+			return nil, err
+		}
+	}
+	if opts.DryRun {
+		return plan, nil
+	}
+	return plan, plan.Apply(ctx, c)
+}
+
+// PlanWebhooks computes the changes ReconcileWebhooks would apply without applying them.
+func PlanWebhooks(ctx context.Context, c OnfidoClient, desired []WebhookRefRequest, deleteUnmanaged bool) (*WebhookPlan, error) {
+	wanted := make(map[string]*WebhookRefRequest, len(desired))
+	for i := range desired {
+		d := &desired[i]
+		if d.URL == "" {
+			return nil, fmt.Errorf("desired webhook %d has no url", i)
+		}
+		if _, ok := wanted[d.URL]; ok {
+			return nil, fmt.Errorf("duplicate desired webhook url %s", d.URL)
+		}
+		wanted[d.URL] = d
+	}
+
+	existing := make(map[string]*WebhookRef)
+	plan := &WebhookPlan{}
+	it := c.ListWebhooks()
+	for it.Next(ctx) {
+		wh := it.WebhookRef()
+		d, ok := wanted[wh.URL]
+		if !ok || existing[wh.URL] != nil {
+			// Unknown URLs and duplicate registrations of a known URL are unmanaged.
+			if deleteUnmanaged {
+				plan.Items = append(plan.Items, &WebhookPlanItem{Action: WebhookPlanActionDelete, Current: wh})
+			}
+			continue
+		}
+		existing[wh.URL] = wh
+		if len(webhookDiff(wh, d)) > 0 {
+			plan.Items = append(plan.Items, &WebhookPlanItem{Action: WebhookPlanActionUpdate, Current: wh, Desired: d})
+		}
+	}
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+
+	for i := range desired {
+		d := &desired[i]
+		if existing[d.URL] == nil {
+			plan.Items = append(plan.Items, &WebhookPlanItem{Action: WebhookPlanActionCreate, Desired: d})
+		}
+	}
+
+	return plan, nil
+}
+
+// Apply applies every item of the plan in order, stopping at the first error.
+func (p *WebhookPlan) Apply(ctx context.Context, c OnfidoClient) error {
+	for _, item := range p.Items {
+		var err error
+		switch item.Action {
+		case WebhookPlanActionCreate:
+			item.Result, err = c.CreateWebhook(ctx, *item.Desired)
+		case WebhookPlanActionUpdate:
+			item.Result, err = c.UpdateWebhook(ctx, item.Current.ID, *item.Desired)
+		case WebhookPlanActionDelete:
+			err = c.DeleteWebhook(ctx, item.Current.ID)
+		default:
+			err = fmt.Errorf("unknown webhook plan action %q", item.Action)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to %s webhook %s: %w", item.Action, item.URL(), err)
+		}
+	}
+	p.Applied = true
+	return nil
+}
+
+// webhookDiff returns a description of each field that differs between
+// the existing and the desired webhook.
+func webhookDiff(cur *WebhookRef, d *WebhookRefRequest) []string {
+	var diff []string
+	if cur.Enabled != d.Enabled {
+		diff = append(diff, fmt.Sprintf("enabled: %t -> %t", cur.Enabled, d.Enabled))
+	}
+	if len(d.Environments) > 0 {
+		from, to := make([]string, len(cur.Environments)), make([]string, len(d.Environments))
+		for i, e := range cur.Environments {
+			from[i] = string(e)
+		}
+		for i, e := range d.Environments {
+			to[i] = string(e)
+		}
+		if !sameStringSet(from, to) {
+			diff = append(diff, fmt.Sprintf("environments: %v -> %v", cur.Environments, d.Environments))
+		}
+	}
+	if len(d.Events) > 0 {
+		from, to := make([]string, len(cur.Events)), make([]string, len(d.Events))
+		for i, e := range cur.Events {
+			from[i] = string(e)
+		}
+		for i, e := range d.Events {
+			to[i] = string(e)
+		}
+		if !sameStringSet(from, to) {
+			diff = append(diff, fmt.Sprintf("events: %v -> %v", cur.Events, d.Events))
+		}
+	}
+	return diff
+}
+
+func sameStringSet(a, b []string) bool {
+	a = dedupSorted(a)
+	b = dedupSorted(b)
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func dedupSorted(s []string) []string {
+	out := append([]string(nil), s...)
+	sort.Strings(out)
+	n := 0
+	for i, v := range out {
+		if i == 0 || v != out[n-1] {
+			out[n] = v
+			n++
+		}
+	}
+	return out[:n]
+}
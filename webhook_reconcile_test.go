@@ -0,0 +1,103 @@
+package onfido
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+func newReconcileTestServer(t *testing.T, existing []*WebhookRef, calls *[]string) *httptest.Server {
+	m := mux.NewRouter()
+	m.HandleFunc("/webhooks/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		assert.NoError(t, json.NewEncoder(w).Encode(WebhookRefs{WebhookRefs: existing}))
+	}).Methods("GET")
+	m.HandleFunc("/webhooks", func(w http.ResponseWriter, r *http.Request) {
+		var wr WebhookRefRequest
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&wr))
+		*calls = append(*calls, "create "+wr.URL)
+		w.Header().Set("Content-Type", "application/json")
+		assert.NoError(t, json.NewEncoder(w).Encode(WebhookRef{ID: "new", URL: wr.URL, Token: "tok-" + wr.URL}))
+	}).Methods("POST")
+	m.HandleFunc("/webhooks/{id}", func(w http.ResponseWriter, r *http.Request) {
+		*calls = append(*calls, "update "+mux.Vars(r)["id"])
+		w.Header().Set("Content-Type", "application/json")
+		assert.NoError(t, json.NewEncoder(w).Encode(WebhookRef{ID: mux.Vars(r)["id"]}))
+	}).Methods("PUT")
+	m.HandleFunc("/webhooks/{id}", func(w http.ResponseWriter, r *http.Request) {
+		*calls = append(*calls, "delete "+mux.Vars(r)["id"])
+		w.WriteHeader(http.StatusNoContent)
+	}).Methods("DELETE")
+	return httptest.NewServer(m)
+}
+
+func TestReconcileWebhooks_AppliesPlan(t *testing.T) {
+	existing := []*WebhookRef{
+		{ID: "1", URL: "https://a.example", Enabled: true, Events: []WebhookEvent{WebhookEventCheckCompleted}},
+		{ID: "2", URL: "https://b.example", Enabled: true, Events: []WebhookEvent{WebhookEventCheckCompleted}},
+		{ID: "3", URL: "https://old.example", Enabled: true},
+	}
+	var calls []string
+	srv := newReconcileTestServer(t, existing, &calls)
+	defer srv.Close()
+
+	client := NewClient("123").(*client)
+	client.endpoint = srv.URL
+
+	desired := []WebhookRefRequest{
+		{URL: "https://a.example", Enabled: true, Events: []WebhookEvent{WebhookEventCheckCompleted}},
+		{URL: "https://b.example", Enabled: true, Events: []WebhookEvent{WebhookEventCheckCompleted, WebhookEventReportCompleted}},
+		{URL: "https://c.example", Enabled: true},
+	}
+	plan, err := ReconcileWebhooks(context.Background(), client, desired, ReconcileWebhooksOptions{DeleteUnmanaged: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.True(t, plan.Applied)
+	assert.Equal(t, []string{"update 2", "delete 3", "create https://c.example"}, calls)
+	assert.Equal(t, map[string]string{"https://c.example": "tok-https://c.example"}, plan.Tokens())
+}
+
+func TestReconcileWebhooks_DryRun(t *testing.T) {
+	existing := []*WebhookRef{
+		{ID: "1", URL: "https://a.example", Enabled: true},
+	}
+	var calls []string
+	srv := newReconcileTestServer(t, existing, &calls)
+	defer srv.Close()
+
+	client := NewClient("123").(*client)
+	client.endpoint = srv.URL
+
+	var out bytes.Buffer
+	plan, err := ReconcileWebhooks(context.Background(), client, []WebhookRefRequest{
+		{URL: "https://a.example", Enabled: false},
+	}, ReconcileWebhooksOptions{DryRun: true, Out: &out})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.False(t, plan.Applied)
+	assert.Empty(t, calls)
+	assert.Len(t, plan.Items, 1)
+	assert.Equal(t, WebhookPlanActionUpdate, plan.Items[0].Action)
+	assert.Equal(t, "~ update https://a.example (1) enabled: true -> false\n", out.String())
+}
+
+func TestReconcileWebhooks_DuplicateDesired(t *testing.T) {
+	client := NewClient("123")
+	_, err := ReconcileWebhooks(context.Background(), client, []WebhookRefRequest{
+		{URL: "https://a.example"},
+		{URL: "https://a.example"},
+	}, ReconcileWebhooksOptions{})
+	if err == nil {
+		t.Fatal("expected duplicate desired webhooks to raise an error")
+	}
+}
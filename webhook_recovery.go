@@ -0,0 +1,152 @@
+package onfido
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// WebhookRecovery synthesizes webhook events which were missed, e.g. while the
+// webhook endpoint was down, by comparing the current state of checks and reports
+// with the events recorded in a DedupStore.
+type WebhookRecovery struct {
+	Client OnfidoClient
+	Dedup  DedupStore
+	// Process receives the synthesized events, usually the same func which
+	// handles live events (wrapped with DedupWebhookProcessFunc).
+	Process WebhookProcessFunc
+	// Reports enables recovery of report.completed events as well as check.completed.
+	Reports bool
+	// MaxCheckAge widens the window of Recover to the checks created up to
+	// MaxCheckAge before since, so that checks created before the window which
+	// completed within it are recovered too. The API doesn't expose when checks
+	// complete, so set it to how long checks may take to complete, and no longer
+	// than the DedupStore retains events: older completed checks whose events
+	// have expired from it would be processed again.
+	MaxCheckAge time.Duration
+}
+
+// WebhookRecoveryResult represents the outcome of a recovery run
+type WebhookRecoveryResult struct {
+	// Checks is the number of checks created within the window, widened by MaxCheckAge.
+	Checks int
+	// Recovered are the events which were missing and have been processed.
+	Recovered []*WebhookRequest
+}
+
+// Recover lists every applicant's checks created within [since-MaxCheckAge, until)
+// and processes a check.completed (and optionally report.completed) event for each
+// completed resource whose event hasn't been seen. Recovered events are marked
+// in the DedupStore. It stops at the first error.
+//
+// Checks are selected by creation time, as the API doesn't expose when they
+// complete: without MaxCheckAge, a check created before since which completed
+// within the window isn't recovered.
+func (r *WebhookRecovery) Recover(ctx context.Context, since, until time.Time) (*WebhookRecoveryResult, error) {
+	if r.Client == nil || r.Dedup == nil || r.Process == nil {
+		return nil, errors.New("webhook recovery requires a client, a dedup store and a process func")
+	}
+
+	from := since.Add(-r.MaxCheckAge)
+	res := &WebhookRecoveryResult{}
+	applicants := r.Client.ListApplicants()
+	for applicants.Next(ctx) {
+		a := applicants.Applicant()
+		if a.CreatedAt != nil && !a.CreatedAt.Before(until) {
+			// The applicant's checks can't have been created within the window.
+			continue
+		}
+
+		checks := r.Client.ListChecks(a.ID)
+		for checks.Next(ctx) {
+			chk := checks.Check()
+			if chk.CreatedAt == nil || chk.CreatedAt.Before(from) || !chk.CreatedAt.Before(until) {
+				continue
+			}
+			res.Checks++
+			if err := r.recoverCheck(ctx, chk, res); err != nil {
+				return res, err
+			}
+		}
+		if err := checks.Err(); err != nil {
+			return res, err
+		}
+	}
+	return res, applicants.Err()
+}
+
+func (r *WebhookRecovery) recoverCheck(ctx context.Context, chk *Check, res *WebhookRecoveryResult) error {
+	if r.Reports {
+		reports := r.Client.ListReports(chk.ID)
+		for reports.Next(ctx) {
+			rep := reports.Report()
+			if rep.Status != string(CheckStatusComplete) {
+				continue
+			}
+			wr := newRecoveredWebhook(WebhookResourceTypeReport, WebhookEventReportCompleted, rep.ID, rep.Status, rep.Href)
+			if err := r.recover(ctx, wr, res); err != nil {
+				return err
+			}
+		}
+		if err := reports.Err(); err != nil {
+			return err
+		}
+	}
+
+	if chk.Status != CheckStatusComplete {
+		return nil
+	}
+	wr := newRecoveredWebhook(WebhookResourceTypeCheck, WebhookEventCheckCompleted, chk.ID, string(chk.Status), chk.Href)
+	return r.recover(ctx, wr, res)
+}
+
+func (r *WebhookRecovery) recover(ctx context.Context, wr *WebhookRequest, res *WebhookRecoveryResult) error {
+	seen, err := r.Dedup.Seen(ctx, wr.Key())
+	if err != nil || seen {
+		return err
+	}
+	if err := r.Process(ctx, wr); err != nil {
+		return err
+	}
+	if err := r.Dedup.Mark(ctx, wr.Key()); err != nil {
+		return err
+	}
+	res.Recovered = append(res.Recovered, wr)
+	return nil
+}
+
+// Run recovers the events of the last lookback period every interval until the
+// context is done. onResult, if set, is called after each run. Only checks
+// created within lookback, widened by MaxCheckAge, are recovered: lookback
+// should cover the longest expected outage plus how long checks take to
+// complete, unless MaxCheckAge does.
+func (r *WebhookRecovery) Run(ctx context.Context, interval, lookback time.Duration, onResult func(*WebhookRecoveryResult, error)) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		now := time.Now()
+		res, err := r.Recover(ctx, now.Add(-lookback), now)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if onResult != nil {
+			onResult(res, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func newRecoveredWebhook(resourceType string, action WebhookEvent, id, status, href string) *WebhookRequest {
+	var wr WebhookRequest
+	wr.Payload.ResourceType = resourceType
+	wr.Payload.Action = string(action)
+	wr.Payload.Object.ID = id
+	wr.Payload.Object.Status = status
+	wr.Payload.Object.Href = href
+	return &wr
+}
@@ -0,0 +1,93 @@
+package onfido
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebhookRecovery_Recover(t *testing.T) {
+	now := time.Now().UTC()
+	old := now.Add(-48 * time.Hour)
+	recent := now.Add(-time.Hour)
+
+	checks := map[string][]*Check{
+		"applicant-1": {
+			{ID: "check-old", CreatedAt: &old, Status: CheckStatusComplete},
+			{ID: "check-seen", CreatedAt: &recent, Status: CheckStatusComplete},
+			{ID: "check-missed", CreatedAt: &recent, Status: CheckStatusComplete},
+			{ID: "check-running", CreatedAt: &recent, Status: CheckStatusInProgress},
+		},
+	}
+	reports := map[string][]*Report{
+		"check-missed":  {{ID: "report-missed", Status: "complete"}},
+		"check-running": {{ID: "report-running", Status: "awaiting_data"}},
+	}
+
+	m := mux.NewRouter()
+	m.HandleFunc("/applicants", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		assert.NoError(t, json.NewEncoder(w).Encode(Applicants{Applicants: []*Applicant{{ID: "applicant-1", CreatedAt: &old}}}))
+	}).Methods("GET")
+	m.HandleFunc("/checks", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		assert.NoError(t, json.NewEncoder(w).Encode(Checks{Checks: checks[r.URL.Query().Get("applicant_id")]}))
+	}).Methods("GET")
+	m.HandleFunc("/reports", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		assert.NoError(t, json.NewEncoder(w).Encode(Reports{Reports: reports[r.URL.Query().Get("check_id")]}))
+	}).Methods("GET")
+	srv := httptest.NewServer(m)
+	defer srv.Close()
+
+	client := NewClient("123").(*client)
+	client.endpoint = srv.URL
+
+	ctx := context.Background()
+	dedup := NewMemoryDedupStore()
+	assert.NoError(t, dedup.Mark(ctx, WebhookEventKey(string(WebhookEventCheckCompleted), "check-seen")))
+
+	var processed []string
+	recovery := &WebhookRecovery{
+		Client:  client,
+		Dedup:   dedup,
+		Reports: true,
+		Process: func(ctx context.Context, wr *WebhookRequest) error {
+			processed = append(processed, wr.Key())
+			return nil
+		},
+	}
+
+	res, err := recovery.Recover(ctx, now.Add(-24*time.Hour), now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 3, res.Checks)
+	assert.Equal(t, []string{"report.completed:report-missed", "check.completed:check-missed"}, processed)
+	assert.Len(t, res.Recovered, 2)
+
+	// A second run finds nothing missing.
+	processed = nil
+	res, err = recovery.Recover(ctx, now.Add(-24*time.Hour), now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Empty(t, processed)
+	assert.Empty(t, res.Recovered)
+
+	// The check created before the window is only recovered with MaxCheckAge.
+	recovery.Reports = false
+	recovery.MaxCheckAge = 72 * time.Hour
+	res, err = recovery.Recover(ctx, now.Add(-24*time.Hour), now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 4, res.Checks)
+	assert.Equal(t, []string{"check.completed:check-old"}, processed)
+}
@@ -0,0 +1,166 @@
+package onfido
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"sync"
+	"time"
+)
+
+// Replay guard errors
+var (
+	// ErrWebhookReplay is returned when a correctly signed webhook request has
+	// already been received. Onfido retries deliveries with the same payload,
+	// so it should be acknowledged with a successful status code.
+	ErrWebhookReplay = errors.New("invalid request, webhook has already been received")
+	// ErrWebhookStale is returned when a correctly signed webhook request is
+	// outside the replay tolerance window, or has no completion timestamp to
+	// check it against. It must not be acknowledged.
+	ErrWebhookStale = errors.New("invalid request, webhook is outside the replay tolerance")
+)
+
+// DefaultWebhookReplayTolerance is the replay tolerance used when none is provided.
+const DefaultWebhookReplayTolerance = 10 * time.Minute
+
+// WebhookOption configures a webhook handler
+type WebhookOption func(*webhook)
+
+// WebhookNonceCache records the signatures of received webhook requests.
+type WebhookNonceCache interface {
+	// Add records the nonce until expiry, reporting false if it was already recorded.
+	Add(nonce string, expiry time.Time) (bool, error)
+	// Remove forgets the nonce, so that it can be added again.
+	Remove(nonce string) error
+}
+
+// WithReplayGuard rejects webhook requests with ErrWebhookStale when the event's
+// completion timestamp is missing or further than tolerance from the current time,
+// and with ErrWebhookReplay when the same signed payload has already been received.
+// Payloads are cached until their timestamp leaves the tolerance window, after
+// which they're stale. If cache is nil an in-memory cache is used, which is not
+// shared between instances.
+//
+// ParseFromRequest records the payload as received. Handlers which fail to handle
+// the request must release it with WebhookReleaser, so that Onfido's retry of it
+// isn't rejected as a replay. Handlers should acknowledge ErrWebhookReplay with a
+// successful status code.
+func WithReplayGuard(tolerance time.Duration, cache WebhookNonceCache) WebhookOption {
+	if tolerance <= 0 {
+		tolerance = DefaultWebhookReplayTolerance
+	}
+	if cache == nil {
+		cache = NewMemoryNonceCache()
+	}
+	return func(wh *webhook) {
+		wh.replay = &replayGuard{
+			tolerance: tolerance,
+			cache:     cache,
+			now:       time.Now,
+		}
+	}
+}
+
+type replayGuard struct {
+	tolerance time.Duration
+	cache     WebhookNonceCache
+	now       func() time.Time
+}
+
+func (g *replayGuard) check(body []byte, wr *WebhookRequest) error {
+	// Without a timestamp a replay could only be detected by caching its
+	// payload forever.
+	ts := wr.Payload.Object.CompletedAt
+	if ts == "" {
+		return ErrWebhookStale
+	}
+	completedAt, err := time.Parse(time.RFC3339, ts)
+	if err != nil {
+		return err
+	}
+	if d := g.now().Sub(completedAt); d > g.tolerance || d < -g.tolerance {
+		return ErrWebhookStale
+	}
+
+	// The signature is an HMAC of the body, so the body hash identifies it
+	// regardless of how the hex signature is cased.
+	sum := sha256.Sum256(body)
+	nonce := hex.EncodeToString(sum[:])
+	// Once expired, a replay of the payload is stale.
+	added, err := g.cache.Add(nonce, completedAt.Add(g.tolerance))
+	if err != nil {
+		return err
+	}
+	if !added {
+		return ErrWebhookReplay
+	}
+	wr.nonce = nonce
+	return nil
+}
+
+// WebhookReleaser is implemented by the Webhook of NewWebhook, see WithReplayGuard.
+type WebhookReleaser interface {
+	// Release forgets that the request was received, so that its redelivery
+	// isn't rejected with ErrWebhookReplay.
+	Release(wr *WebhookRequest) error
+}
+
+var _ WebhookReleaser = &webhook{}
+
+// Release forgets that the request was received, so that its redelivery isn't
+// rejected with ErrWebhookReplay. It is a no-op without a replay guard.
+func (wh *webhook) Release(wr *WebhookRequest) error {
+	if wh.replay == nil || wr == nil || wr.nonce == "" {
+		return nil
+	}
+	if err := wh.replay.cache.Remove(wr.nonce); err != nil {
+		return err
+	}
+	wr.nonce = ""
+	return nil
+}
+
+// MemoryNonceCache is an in-memory WebhookNonceCache.
+type MemoryNonceCache struct {
+	mu     sync.Mutex
+	nonces map[string]time.Time
+	now    func() time.Time
+}
+
+var _ WebhookNonceCache = &MemoryNonceCache{}
+
+// NewMemoryNonceCache creates an empty in-memory WebhookNonceCache.
+func NewMemoryNonceCache() *MemoryNonceCache {
+	return &MemoryNonceCache{
+		nonces: make(map[string]time.Time),
+		now:    time.Now,
+	}
+}
+
+// Add records the nonce until expiry, reporting false if it was already recorded.
+// Expired nonces are pruned on each call.
+func (c *MemoryNonceCache) Add(nonce string, expiry time.Time) (bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	for n, exp := range c.nonces {
+		if !exp.After(now) {
+			delete(c.nonces, n)
+		}
+	}
+	if _, ok := c.nonces[nonce]; ok {
+		return false, nil
+	}
+	c.nonces[nonce] = expiry
+	return true, nil
+}
+
+// Remove forgets the nonce, so that it can be added again.
+func (c *MemoryNonceCache) Remove(nonce string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.nonces, nonce)
+	return nil
+}
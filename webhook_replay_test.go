@@ -0,0 +1,111 @@
+package onfido
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newSignedWebhookRequest(token string, body []byte) *http.Request {
+	req := &http.Request{
+		Header: make(map[string][]string),
+	}
+	req.Header.Add(WebhookSignatureHeader, signWebhookBody(token, body))
+	req.Body = ioutil.NopCloser(bytes.NewBuffer(body))
+	return req
+}
+
+func TestParseFromRequest_ReplayGuard(t *testing.T) {
+	now := time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC)
+	cache := NewMemoryNonceCache()
+	cache.now = func() time.Time { return now }
+	wh := NewWebhook("abc123", WithReplayGuard(5*time.Minute, cache)).(*webhook)
+	wh.replay.now = func() time.Time { return now }
+
+	fresh := []byte(`{"payload":{"object":{"id":"1","completed_at_iso8601":"2020-06-01T11:58:00Z"}}}`)
+	_, err := wh.ParseFromRequest(newSignedWebhookRequest("abc123", fresh))
+	assert.NoError(t, err)
+
+	_, err = wh.ParseFromRequest(newSignedWebhookRequest("abc123", fresh))
+	assert.Equal(t, ErrWebhookReplay, err)
+
+	stale := []byte(`{"payload":{"object":{"id":"2","completed_at_iso8601":"2020-06-01T11:50:00Z"}}}`)
+	_, err = wh.ParseFromRequest(newSignedWebhookRequest("abc123", stale))
+	assert.Equal(t, ErrWebhookStale, err)
+
+	future := []byte(`{"payload":{"object":{"id":"3","completed_at_iso8601":"2020-06-01T12:10:00Z"}}}`)
+	_, err = wh.ParseFromRequest(newSignedWebhookRequest("abc123", future))
+	assert.Equal(t, ErrWebhookStale, err)
+
+	untimed := []byte(`{"payload":{"object":{"id":"4"}}}`)
+	_, err = wh.ParseFromRequest(newSignedWebhookRequest("abc123", untimed))
+	assert.Equal(t, ErrWebhookStale, err)
+
+	// The payload is cached until it leaves the window, then its replay is stale.
+	assert.Len(t, cache.nonces, 1)
+	for _, expiry := range cache.nonces {
+		assert.Equal(t, time.Date(2020, 6, 1, 12, 3, 0, 0, time.UTC), expiry)
+	}
+	now = now.Add(4 * time.Minute)
+	_, err = wh.ParseFromRequest(newSignedWebhookRequest("abc123", fresh))
+	assert.Equal(t, ErrWebhookStale, err)
+}
+
+func TestParseFromRequest_ReplayGuardRelease(t *testing.T) {
+	wh := NewWebhook("abc123", WithReplayGuard(time.Minute, nil))
+
+	body := timedWebhookBody("1")
+	wr, err := wh.ParseFromRequest(newSignedWebhookRequest("abc123", body))
+	assert.NoError(t, err)
+
+	// Handling failed, the redelivery is accepted once released.
+	assert.NoError(t, wh.(WebhookReleaser).Release(wr))
+	wr, err = wh.ParseFromRequest(newSignedWebhookRequest("abc123", body))
+	assert.NoError(t, err)
+
+	_, err = wh.ParseFromRequest(newSignedWebhookRequest("abc123", body))
+	assert.Equal(t, ErrWebhookReplay, err)
+
+	// Release is a no-op without a replay guard.
+	assert.NoError(t, NewWebhook("abc123").(WebhookReleaser).Release(wr))
+}
+
+func TestParseFromRequest_ReplayGuardChecksSignatureFirst(t *testing.T) {
+	wh := NewWebhook("abc123", WithReplayGuard(time.Minute, nil))
+
+	body := timedWebhookBody("1")
+	forged := newSignedWebhookRequest("wrong", body)
+	_, err := wh.ParseFromRequest(forged)
+	assert.Equal(t, ErrInvalidWebhookSignature, err)
+
+	_, err = wh.ParseFromRequest(newSignedWebhookRequest("abc123", body))
+	assert.NoError(t, err)
+}
+
+// timedWebhookBody returns the body of a webhook request for the object id,
+// completed now.
+func timedWebhookBody(id string) []byte {
+	return []byte(`{"payload":{"resource_type":"check","object":{"id":"` + id +
+		`","completed_at_iso8601":"` + time.Now().UTC().Format(time.RFC3339) + `"}}}`)
+}
+
+func TestMemoryNonceCache_Expiry(t *testing.T) {
+	now := time.Now()
+	c := NewMemoryNonceCache()
+	c.now = func() time.Time { return now }
+
+	added, err := c.Add("a", now.Add(time.Minute))
+	assert.NoError(t, err)
+	assert.True(t, added)
+
+	added, _ = c.Add("a", now.Add(time.Minute))
+	assert.False(t, added)
+
+	now = now.Add(2 * time.Minute)
+	added, _ = c.Add("a", now.Add(time.Minute))
+	assert.True(t, added)
+}
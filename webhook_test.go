@@ -2,10 +2,19 @@ package onfido
 
 import (
 	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"io/ioutil"
 	"net/http"
+	"net/http/httptest"
 	"os"
 	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestNewWebhookFromEnv_MissingToken(t *testing.T) {
@@ -108,3 +117,133 @@ func TestParseFromRequest_ValidSignature(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestWebhookRequestFetch_Check(t *testing.T) {
+	m := mux.NewRouter()
+	m.HandleFunc("/v3.5/checks/{id}", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "check-1", mux.Vars(r)["id"])
+		w.Header().Set("Content-Type", "application/json")
+		_, wErr := w.Write([]byte(`{"id": "check-1", "report_ids": ["report-1"]}`))
+		assert.NoError(t, wErr)
+	}).Methods("GET")
+	m.HandleFunc("/v3.5/reports/{id}", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, wErr := w.Write([]byte(`{"id": "` + mux.Vars(r)["id"] + `", "name": "document"}`))
+		assert.NoError(t, wErr)
+	}).Methods("GET")
+	requester := &handlerRequester{h: m}
+	client := NewClient("123", WithHTTPClient(requester))
+
+	var wr WebhookRequest
+	wr.Payload.ResourceType = WebhookResourceTypeCheck
+	wr.Payload.Object.Href = "https://api.us.onfido.com/v3.5/checks/check-1"
+
+	v, err := wr.Fetch(context.Background(), client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	check, ok := v.(*Check)
+	if !ok {
+		t.Fatalf("expected *Check but got %T", v)
+	}
+	assert.Equal(t, "check-1", check.ID)
+	if assert.Len(t, check.Reports, 1) {
+		assert.Equal(t, ReportNameDocument, check.Reports[0].Name)
+	}
+	assert.Equal(t, []string{"api.us.onfido.com", "api.us.onfido.com"}, requester.hosts)
+
+	wr.Payload.ResourceType = WebhookResourceTypeReport
+	wr.Payload.Object.ID = "report-2"
+	wr.Payload.Object.Href = ""
+	v, err = wr.Fetch(context.Background(), client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "report-2", v.(*Report).ID)
+	assert.Equal(t, "api.eu.onfido.com", requester.hosts[2])
+
+	wr.Payload.Object.Href = "https://evil.example.com/v3.5/reports/report-2"
+	_, err = wr.Fetch(context.Background(), client)
+	assert.True(t, errors.Is(err, ErrUnexpectedResourceHost))
+}
+
+func TestWebhookRequestFetch_UnsupportedResource(t *testing.T) {
+	var wr WebhookRequest
+	wr.Payload.ResourceType = "audit_log"
+	wr.Payload.Object.ID = "123"
+
+	_, err := wr.Fetch(context.Background(), NewClient("123"))
+	if !errors.Is(err, ErrUnsupportedWebhookResource) {
+		t.Fatalf("expected ErrUnsupportedWebhookResource but got %v", err)
+	}
+}
+
+func TestParseFromRequest_BodyTooLarge(t *testing.T) {
+	wh := NewWebhook("abc123", WithMaxBodySize(8))
+	req := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader([]byte(`{"payload":{}}`)))
+	req.Header.Set(WebhookSignatureHeader, sign("abc123", []byte(`{"payload":{}}`)))
+
+	_, err := wh.ParseFromRequest(req)
+	assert.Equal(t, ErrWebhookBodyTooLarge, err)
+}
+
+func sign(token string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(token))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func FuzzValidateSignature(f *testing.F) {
+	f.Add([]byte("hello world"), "8c301acf7e955038b486de8f2a35f7f28bb5755fd1f77e1dbf9ef9e27713ad0d")
+	f.Add([]byte(""), "")
+	f.Add([]byte("{}"), "zz")
+	f.Fuzz(func(t *testing.T, body []byte, signature string) {
+		wh := webhook{Token: "abc123"}
+		if err := wh.ValidateSignature(body, sign(wh.Token, body)); err != nil {
+			t.Fatalf("valid signature rejected: %v", err)
+		}
+		if err := wh.ValidateSignature(body, signature); err == nil {
+			if sig, _ := hex.DecodeString(signature); !bytes.Equal(sig, mustDecodeHex(sign(wh.Token, body))) {
+				t.Fatalf("invalid signature %q accepted", signature)
+			}
+		}
+	})
+}
+
+func mustDecodeHex(s string) []byte {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+func FuzzParseFromRequest(f *testing.F) {
+	f.Add([]byte(`{"payload":{"resource_type":"check","action":"check.completed","object":{"id":"abc","status":"complete","completed_at_iso8601":"2020-01-01T00:00:00Z","href":"https://api.onfido.com/v3.5/checks/abc"}}}`), true)
+	f.Add([]byte(`{"payload":null}`), true)
+	f.Add([]byte(`{"payload":{"object":[]}}`), true)
+	f.Add([]byte(`not json`), false)
+	f.Fuzz(func(t *testing.T, body []byte, signed bool) {
+		wh := NewWebhook("abc123", WithMaxBodySize(4096))
+		req := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(body))
+		if signed {
+			req.Header.Set(WebhookSignatureHeader, sign("abc123", body))
+		} else {
+			req.Header.Set(WebhookSignatureHeader, "00")
+		}
+
+		wr, err := wh.ParseFromRequest(req)
+		switch {
+		case !signed && err == nil:
+			t.Fatal("unsigned request accepted")
+		case err == nil && wr == nil:
+			t.Fatal("no request nor error")
+		case err == nil && len(body) > 4096:
+			t.Fatal("body larger than the limit accepted")
+		}
+		if err == nil {
+			// Resolving the object's href mustn't panic either.
+			_, _ = resourceURL(wr.Payload.Object.Href, DefaultEndpoint)
+		}
+	})
+}
@@ -18,18 +18,20 @@ const (
 	WebhookEnvironmentSandbox WebhookEnvironment = "sandbox"
 	WebhookEnvironmentLive    WebhookEnvironment = "live"
 
-	WebhookEventReportWithdrawn        WebhookEvent = "report.withdrawn"
-	WebhookEventReportResumed          WebhookEvent = "report.resumed"
-	WebhookEventReportCancelled        WebhookEvent = "report.cancelled"
-	WebhookEventReportAwaitingApproval WebhookEvent = "report.awaiting_approval"
-	WebhookEventReportInitiated        WebhookEvent = "report.initiated"
-	WebhookEventReportCompleted        WebhookEvent = "report.completed"
-	WebhookEventCheckStarted           WebhookEvent = "check.started"
-	WebhookEventCheckReopened          WebhookEvent = "check.reopened"
-	WebhookEventCheckWithdrawn         WebhookEvent = "check.withdrawn"
-	WebhookEventCheckCompleted         WebhookEvent = "check.completed"
-	WebhookEventCheckFormOpened        WebhookEvent = "check.form_opened"
-	WebhookEventCheckFormCompleted     WebhookEvent = "check.form_completed"
+	WebhookEventReportWithdrawn                WebhookEvent = "report.withdrawn"
+	WebhookEventReportResumed                  WebhookEvent = "report.resumed"
+	WebhookEventReportCancelled                WebhookEvent = "report.cancelled"
+	WebhookEventReportAwaitingApproval         WebhookEvent = "report.awaiting_approval"
+	WebhookEventReportInitiated                WebhookEvent = "report.initiated"
+	WebhookEventReportCompleted                WebhookEvent = "report.completed"
+	WebhookEventCheckStarted                   WebhookEvent = "check.started"
+	WebhookEventCheckReopened                  WebhookEvent = "check.reopened"
+	WebhookEventCheckWithdrawn                 WebhookEvent = "check.withdrawn"
+	WebhookEventCheckCompleted                 WebhookEvent = "check.completed"
+	WebhookEventCheckFormOpened                WebhookEvent = "check.form_opened"
+	WebhookEventCheckFormCompleted             WebhookEvent = "check.form_completed"
+	WebhookEventWorkflowRunCompleted           WebhookEvent = "workflow_run.completed"
+	WebhookEventWatchlistMonitorMatchesUpdated WebhookEvent = "watchlist_monitor.matches_updated"
 )
 
 // WebhookRefRequest represents a webhook request to Onfido API
@@ -104,7 +106,7 @@ func (c *client) DeleteWebhook(ctx context.Context, id string) error {
 
 // WebhookRefIter represents a webhook iterator
 type WebhookRefIter struct {
-	*iter
+	Iter
 }
 
 // WebhookRef returns the current item in the iterator as a WebhookRef.
@@ -0,0 +1,209 @@
+package onfido
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// WorkflowRunStatus represents the status of a workflow run
+type WorkflowRunStatus string
+
+// Supported workflow run statuses
+const (
+	WorkflowRunStatusAwaitingInput WorkflowRunStatus = "awaiting_input"
+	WorkflowRunStatusProcessing    WorkflowRunStatus = "processing"
+	WorkflowRunStatusAbandoned     WorkflowRunStatus = "abandoned"
+	WorkflowRunStatusError         WorkflowRunStatus = "error"
+	WorkflowRunStatusApproved      WorkflowRunStatus = "approved"
+	WorkflowRunStatusReview        WorkflowRunStatus = "review"
+	WorkflowRunStatusDeclined      WorkflowRunStatus = "declined"
+)
+
+// WorkflowRunLink represents the link to a workflow run's interactive flow
+type WorkflowRunLink struct {
+	URL                  string     `json:"url,omitempty"`
+	CompletedRedirectURL string     `json:"completed_redirect_url,omitempty"`
+	ExpiredRedirectURL   string     `json:"expired_redirect_url,omitempty"`
+	ExpiresAt            *time.Time `json:"expires_at,omitempty"`
+	Language             string     `json:"language,omitempty"`
+}
+
+// WorkflowRunError represents the error which stopped a workflow run
+type WorkflowRunError struct {
+	Type    string `json:"type,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// WorkflowRunRequest represents a workflow run request to Onfido API
+type WorkflowRunRequest struct {
+	WorkflowID     string                 `json:"workflow_id"`
+	ApplicantID    string                 `json:"applicant_id"`
+	Tags           []string               `json:"tags,omitempty"`
+	CustomerUserID string                 `json:"customer_user_id,omitempty"`
+	Link           *WorkflowRunLink       `json:"link,omitempty"`
+	CustomData     map[string]interface{} `json:"custom_data,omitempty"`
+}
+
+// WorkflowRun represents a workflow run in Onfido API
+// see https://documentation.onfido.com/#workflow-run-object
+type WorkflowRun struct {
+	ID                string                 `json:"id,omitempty"`
+	ApplicantID       string                 `json:"applicant_id,omitempty"`
+	WorkflowID        string                 `json:"workflow_id,omitempty"`
+	WorkflowVersionID int                    `json:"workflow_version_id,omitempty"`
+	DashboardURL      string                 `json:"dashboard_url,omitempty"`
+	Status            WorkflowRunStatus      `json:"status,omitempty"`
+	Output            map[string]interface{} `json:"output,omitempty"`
+	Reasons           []string               `json:"reasons,omitempty"`
+	Error             *WorkflowRunError      `json:"error,omitempty"`
+	Link              *WorkflowRunLink       `json:"link,omitempty"`
+	Tags              []string               `json:"tags,omitempty"`
+	CustomerUserID    string                 `json:"customer_user_id,omitempty"`
+	SdkToken          string                 `json:"sdk_token,omitempty"`
+	CreatedAt         *time.Time             `json:"created_at,omitempty"`
+	UpdatedAt         *time.Time             `json:"updated_at,omitempty"`
+}
+
+// WorkflowRunFilter filters the workflow runs returned by ListWorkflowRuns
+type WorkflowRunFilter struct {
+	Status       []WorkflowRunStatus
+	CreatedAfter *time.Time
+	// CreatedBefore excludes runs created at or after the given time.
+	CreatedBefore *time.Time
+	// Sort is either "asc" or "desc" (by creation time), Onfido defaults to "desc".
+	Sort string
+}
+
+func (f WorkflowRunFilter) query() url.Values {
+	params := make(url.Values)
+	if len(f.Status) > 0 {
+		status := make([]string, len(f.Status))
+		for i, s := range f.Status {
+			status[i] = string(s)
+		}
+		params.Set("status", strings.Join(status, ","))
+	}
+	if f.CreatedAfter != nil {
+		params.Set("created_at_gt", f.CreatedAfter.UTC().Format(time.RFC3339))
+	}
+	if f.CreatedBefore != nil {
+		params.Set("created_at_lt", f.CreatedBefore.UTC().Format(time.RFC3339))
+	}
+	if f.Sort != "" {
+		params.Set("sort", f.Sort)
+	}
+	return params
+}
+
+// CreateWorkflowRun starts a new workflow run for the provided applicant.
+// see https://documentation.onfido.com/#create-workflow-run
+func (c *client) CreateWorkflowRun(ctx context.Context, wr WorkflowRunRequest) (*WorkflowRun, error) {
+	jsonStr, err := json.Marshal(wr)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := c.newRequest(http.MethodPost, "/workflow_runs", bytes.NewBuffer(jsonStr))
+	if err != nil {
+		return nil, err
+	}
+
+	var resp WorkflowRun
+	_, err = c.do(ctx, req, &resp)
+	return &resp, err
+}
+
+// GetWorkflowRun retrieves a workflow run by its ID.
+// see https://documentation.onfido.com/#retrieve-workflow-run
+func (c *client) GetWorkflowRun(ctx context.Context, id string) (*WorkflowRun, error) {
+	req, err := c.newRequest(http.MethodGet, "/workflow_runs/"+id, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp WorkflowRun
+	_, err = c.do(ctx, req, &resp)
+	return &resp, err
+}
+
+// WorkflowRunIter represents a workflow run iterator
+type WorkflowRunIter struct {
+	Iter
+}
+
+// WorkflowRun returns the current item in the iterator as a WorkflowRun.
+func (i *WorkflowRunIter) WorkflowRun() *WorkflowRun {
+	return i.Current().(*WorkflowRun)
+}
+
+// ListWorkflowRuns retrieves the list of workflow runs matching the filter.
+// see https://documentation.onfido.com/#list-workflow-runs
+func (c *client) ListWorkflowRuns(filter WorkflowRunFilter) *WorkflowRunIter {
+	handler := func(body []byte) ([]interface{}, error) {
+		// The endpoint returns a bare array of workflow runs.
+		var r []*WorkflowRun
+		if err := json.Unmarshal(body, &r); err != nil {
+			return nil, err
+		}
+
+		values := make([]interface{}, len(r))
+		for i, v := range r {
+			values[i] = v
+		}
+		return values, nil
+	}
+
+	nextURL := "/workflow_runs"
+	if params := filter.query(); len(params) > 0 {
+		nextURL += "?" + params.Encode()
+	}
+
+	return &WorkflowRunIter{&iter{
+		c:       c,
+		nextURL: nextURL,
+		handler: handler,
+	}}
+}
+
+// TimelineFileReference references a workflow run timeline file
+type TimelineFileReference struct {
+	ID   string `json:"workflow_timeline_file_id,omitempty"`
+	Href string `json:"href,omitempty"`
+}
+
+// DownloadSignedEvidenceFile streams the signed evidence PDF of a workflow run into w.
+// see https://documentation.onfido.com/#retrieve-workflow-run-evidence-summary-file
+func (c *client) DownloadSignedEvidenceFile(ctx context.Context, workflowRunID string, w io.Writer) error {
+	return c.download(ctx, "/workflow_runs/"+workflowRunID+"/signed_evidence_file", w, "workflow run evidence file")
+}
+
+// DownloadEvidenceFolder streams the evidence folder (a ZIP archive) of a workflow run into w.
+// see https://documentation.onfido.com/#retrieve-workflow-run-evidence-folder
+func (c *client) DownloadEvidenceFolder(ctx context.Context, workflowRunID string, w io.Writer) error {
+	return c.download(ctx, "/workflow_runs/"+workflowRunID+"/evidence_folder", w, "workflow run evidence folder")
+}
+
+// CreateTimelineFile triggers the generation of a workflow run's timeline file.
+// see https://documentation.onfido.com/#create-timeline-file-for-workflow-run
+func (c *client) CreateTimelineFile(ctx context.Context, workflowRunID string) (*TimelineFileReference, error) {
+	req, err := c.newRequest(http.MethodPost, "/workflow_runs/"+workflowRunID+"/timeline_file", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp TimelineFileReference
+	_, err = c.do(ctx, req, &resp)
+	return &resp, err
+}
+
+// DownloadTimelineFile streams a workflow run's timeline PDF into w.
+// see https://documentation.onfido.com/#retrieve-timeline-file-for-workflow-run
+func (c *client) DownloadTimelineFile(ctx context.Context, workflowRunID, timelineFileID string, w io.Writer) error {
+	return c.download(ctx, "/workflow_runs/"+workflowRunID+"/timeline_file/"+timelineFileID, w, "workflow run timeline file")
+}
@@ -0,0 +1,241 @@
+package onfido
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateWorkflowRun_NonOKResponse(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		_, wErr := w.Write([]byte("{\"error\": \"things went bad\"}"))
+		assert.NoError(t, wErr)
+	}))
+	defer srv.Close()
+
+	client := NewClient("123").(*client)
+	client.endpoint = srv.URL
+
+	_, err := client.CreateWorkflowRun(context.Background(), WorkflowRunRequest{})
+	if err == nil {
+		t.Fatal("expected server to return non ok response, got successful response")
+	}
+}
+
+func TestCreateWorkflowRun_WorkflowRunCreated(t *testing.T) {
+	expiresAt := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	expected := WorkflowRun{
+		ID:          "2b7a1b3c-1d3e-4f5a-8b9c-0d1e2f3a4b5c",
+		ApplicantID: "541d040b-89f8-444b-8921-16b1333bf1c6",
+		WorkflowID:  "8f1e2d3c-4b5a-6978-8a9b-0c1d2e3f4a5b",
+		Status:      WorkflowRunStatusAwaitingInput,
+		Link: &WorkflowRunLink{
+			URL:       "https://eu.onfido.app/l/123",
+			ExpiresAt: &expiresAt,
+			Language:  "en_US",
+		},
+	}
+
+	m := mux.NewRouter()
+	m.HandleFunc("/workflow_runs", func(w http.ResponseWriter, r *http.Request) {
+		var wr WorkflowRunRequest
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&wr))
+		assert.Equal(t, expected.WorkflowID, wr.WorkflowID)
+		assert.Equal(t, expected.ApplicantID, wr.ApplicantID)
+		assert.Equal(t, "en_US", wr.Link.Language)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		assert.NoError(t, json.NewEncoder(w).Encode(expected))
+	}).Methods("POST")
+	srv := httptest.NewServer(m)
+	defer srv.Close()
+
+	client := NewClient("123").(*client)
+	client.endpoint = srv.URL
+
+	run, err := client.CreateWorkflowRun(context.Background(), WorkflowRunRequest{
+		WorkflowID:  expected.WorkflowID,
+		ApplicantID: expected.ApplicantID,
+		Link:        &WorkflowRunLink{Language: "en_US"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, expected.ID, run.ID)
+	assert.Equal(t, expected.Status, run.Status)
+	assert.Equal(t, expected.Link.URL, run.Link.URL)
+	assert.True(t, expiresAt.Equal(*run.Link.ExpiresAt))
+}
+
+func TestGetWorkflowRun_WorkflowRunRetrieved(t *testing.T) {
+	m := mux.NewRouter()
+	m.HandleFunc("/workflow_runs/{id}", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "run-1", mux.Vars(r)["id"])
+		w.Header().Set("Content-Type", "application/json")
+		_, wErr := w.Write([]byte(`{
+			"id": "run-1",
+			"status": "declined",
+			"output": {"age": 42},
+			"reasons": ["document_expired"]
+		}`))
+		assert.NoError(t, wErr)
+	}).Methods("GET")
+	srv := httptest.NewServer(m)
+	defer srv.Close()
+
+	client := NewClient("123").(*client)
+	client.endpoint = srv.URL
+
+	run, err := client.GetWorkflowRun(context.Background(), "run-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, WorkflowRunStatusDeclined, run.Status)
+	assert.Equal(t, float64(42), run.Output["age"])
+	assert.Equal(t, []string{"document_expired"}, run.Reasons)
+}
+
+func TestListWorkflowRuns_WorkflowRunsRetrieved(t *testing.T) {
+	after := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	var srvURL string
+	m := mux.NewRouter()
+	m.HandleFunc("/workflow_runs", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("page") == "2" {
+			_, wErr := w.Write([]byte(`[{"id": "run-2"}]`))
+			assert.NoError(t, wErr)
+			return
+		}
+
+		assert.Equal(t, "approved,declined", r.URL.Query().Get("status"))
+		assert.Equal(t, "2023-01-02T03:04:05Z", r.URL.Query().Get("created_at_gt"))
+		w.Header().Set("Link", `<`+srvURL+`/workflow_runs?page=2>; rel="next"`)
+		_, wErr := w.Write([]byte(`[{"id": "run-1"}]`))
+		assert.NoError(t, wErr)
+	}).Methods("GET")
+	srv := httptest.NewServer(m)
+	defer srv.Close()
+	srvURL = srv.URL
+
+	client := NewClient("123").(*client)
+	client.endpoint = srv.URL
+
+	var ids []string
+	it := client.ListWorkflowRuns(WorkflowRunFilter{
+		Status:       []WorkflowRunStatus{WorkflowRunStatusApproved, WorkflowRunStatusDeclined},
+		CreatedAfter: &after,
+	})
+	for it.Next(context.Background()) {
+		ids = append(ids, it.WorkflowRun().ID)
+	}
+	if it.Err() != nil {
+		t.Fatal(it.Err())
+	}
+	assert.Equal(t, []string{"run-1", "run-2"}, ids)
+}
+
+func TestWebhookRequestFetch_WorkflowRun(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/workflow_runs/run-1", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		_, wErr := w.Write([]byte(`{"id": "run-1", "status": "approved"}`))
+		assert.NoError(t, wErr)
+	}))
+	defer srv.Close()
+
+	client := NewClient("123").(*client)
+	client.endpoint = srv.URL
+
+	var wr WebhookRequest
+	wr.Payload.ResourceType = WebhookResourceTypeWorkflowRun
+	wr.Payload.Action = string(WebhookEventWorkflowRunCompleted)
+	wr.Payload.Object.Href = "/v3.5/workflow_runs/run-1"
+
+	v, err := wr.Fetch(context.Background(), client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, WorkflowRunStatusApproved, v.(*WorkflowRun).Status)
+}
+
+func TestDownloadSignedEvidenceFile_Streamed(t *testing.T) {
+	m := mux.NewRouter()
+	m.HandleFunc("/workflow_runs/{id}/signed_evidence_file", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/pdf")
+		_, wErr := w.Write([]byte("%PDF-1.4"))
+		assert.NoError(t, wErr)
+	}).Methods("GET")
+	srv := httptest.NewServer(m)
+	defer srv.Close()
+
+	client := NewClient("123").(*client)
+	client.endpoint = srv.URL
+
+	var buf bytes.Buffer
+	if err := client.DownloadSignedEvidenceFile(context.Background(), "run-1", &buf); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "%PDF-1.4", buf.String())
+}
+
+func TestTimelineFile_CreatedAndDownloaded(t *testing.T) {
+	m := mux.NewRouter()
+	m.HandleFunc("/workflow_runs/{id}/timeline_file", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, wErr := w.Write([]byte(`{"workflow_timeline_file_id": "tl-1", "href": "/v3.5/workflow_runs/run-1/timeline_file/tl-1"}`))
+		assert.NoError(t, wErr)
+	}).Methods("POST")
+	m.HandleFunc("/workflow_runs/{id}/timeline_file/{fileId}", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "tl-1", mux.Vars(r)["fileId"])
+		w.Header().Set("Content-Type", "application/pdf")
+		_, wErr := w.Write([]byte("%PDF-1.7"))
+		assert.NoError(t, wErr)
+	}).Methods("GET")
+	srv := httptest.NewServer(m)
+	defer srv.Close()
+
+	client := NewClient("123").(*client)
+	client.endpoint = srv.URL
+
+	ref, err := client.CreateTimelineFile(context.Background(), "run-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "tl-1", ref.ID)
+
+	var buf bytes.Buffer
+	if err := client.DownloadTimelineFile(context.Background(), "run-1", ref.ID, &buf); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "%PDF-1.7", buf.String())
+}
+
+func TestDownloadEvidenceFolder_NonOKResponse(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	client := NewClient("123").(*client)
+	client.endpoint = srv.URL
+
+	var buf bytes.Buffer
+	err := client.DownloadEvidenceFolder(context.Background(), "run-1", &buf)
+	if err == nil {
+		t.Fatal("expected server to return non ok response, got successful response")
+	}
+	assert.Zero(t, buf.Len())
+}